@@ -2,16 +2,33 @@ package main
 
 import (
 	"log"
-	"net/http"
-	"os"
+	"strings"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	_ "technoprise-blog-backend/docs"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/config"
 	"technoprise-blog-backend/internal/database"
 	"technoprise-blog-backend/internal/handlers"
+	"technoprise-blog-backend/internal/mailer"
 	"technoprise-blog-backend/internal/middleware"
+	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/preview"
+	"technoprise-blog-backend/internal/scanner"
+	"technoprise-blog-backend/internal/webhooks"
+)
+
+// Build information, injected at build time with
+// -ldflags "-X main.commit=$(git rev-parse --short HEAD) -X main.buildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+var (
+	version   = "1.0.0"
+	commit    = "unknown"
+	buildTime = "unknown"
 )
 
 // @title TechnoPrise Blog API
@@ -28,105 +45,317 @@ import (
 
 // @host localhost:8080
 // @BasePath /api/v1
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
 func main() {
+	startedAt := time.Now()
+
 	// Load environment variables
 	if err := godotenv.Load(); err != nil {
 		log.Println("No .env file found, using system environment variables")
 	}
 
+	// Read and validate every setting up front so bad values fail before anything starts
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Reading speed used for reading-time estimates
+	if cfg.Content.ReadingWPM != 0 {
+		if err := models.SetReadingWPM(cfg.Content.ReadingWPM); err != nil {
+			log.Fatal("Invalid READING_WPM: ", err)
+		}
+	}
+
+	// Speaking rate used for text-to-speech listening estimates
+	if cfg.Content.SpeakingWPM != 0 {
+		if err := models.SetSpeakingWPM(cfg.Content.SpeakingWPM); err != nil {
+			log.Fatal("Invalid SPEAKING_WPM: ", err)
+		}
+	}
+
+	// Longest slug generated from a title
+	if cfg.Content.SlugMaxLength != 0 {
+		if err := models.SetSlugMaxLength(cfg.Content.SlugMaxLength); err != nil {
+			log.Fatal("Invalid SLUG_MAX_LENGTH: ", err)
+		}
+	}
+
+	// Length of excerpts generated when posts are saved
+	models.SetExcerptLength(cfg.Blog.ExcerptLength)
+
+	// Page sizes for paginated listings
+	defaultPageSize, maxPageSize := handlers.DefaultPageSize, handlers.DefaultMaxPageSize
+	if cfg.Content.DefaultPageSize != 0 {
+		defaultPageSize = cfg.Content.DefaultPageSize
+	}
+	if cfg.Content.MaxPageSize != 0 {
+		maxPageSize = cfg.Content.MaxPageSize
+	}
+	if err := handlers.SetPageSizes(defaultPageSize, maxPageSize); err != nil {
+		log.Fatal("Invalid page sizes: ", err)
+	}
+
+	// HTML allowlist applied to post content
+	policy, err := models.LoadSanitizerPolicy(cfg.Content.SanitizerPolicy)
+	if err != nil {
+		log.Fatal("Invalid SANITIZER_POLICY: ", err)
+	}
+	if err := models.SetSanitizerPolicy(policy); err != nil {
+		log.Fatal("Invalid SANITIZER_POLICY: ", err)
+	}
+	log.Printf("HTML sanitizer policy: %s", policy.Summary())
+
+	// Synonyms replaced by their canonical tag when posts are saved
+	synonyms, err := models.LoadTagSynonyms(cfg.Content.TagSynonyms)
+	if err != nil {
+		log.Fatal("Invalid TAG_SYNONYMS: ", err)
+	}
+	if err := models.SetTagSynonyms(synonyms); err != nil {
+		log.Fatal("Invalid TAG_SYNONYMS: ", err)
+	}
+
 	// Initialize database
-	db, err := database.Initialize()
+	db, err := database.Initialize(cfg.Database)
 	if err != nil {
 		log.Fatal("Failed to initialize database:", err)
 	}
 	defer db.Close()
 
 	// Set Gin mode
-	if os.Getenv("GIN_MODE") == "release" {
+	if cfg.Server.Release {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
+	// Absolute links in feeds, meta tags and emails are built from SITE_URL
+	log.Printf("Site URL: %s", cfg.Blog.SiteURL)
+
+	// Enforce the validate tags on request bodies
+	apierror.ConfigureValidator()
+
 	// Create Gin router
 	router := gin.New()
 
-	// Add middleware
-	router.Use(gin.Logger())
+	// Only honor X-Forwarded-For from trusted proxies so client IPs cannot be spoofed
+	trustedProxies := cfg.Server.TrustedProxies
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		log.Fatal("Invalid TRUSTED_PROXIES: ", err)
+	}
+	if len(trustedProxies) == 0 {
+		log.Printf("Trusted proxies: none, client IPs come from the connection")
+	} else {
+		log.Printf("Trusted proxies: %s", strings.Join(trustedProxies, ", "))
+	}
+
+	// Add middleware; set LOG_SKIP_PATHS empty to log health checks too
+	router.Use(middleware.RequestID())
+	router.Use(middleware.JSONLogger(cfg.Server.LogSkipPaths...))
 	router.Use(gin.Recovery())
-	router.Use(middleware.SecurityHeaders())
+	router.Use(middleware.SecurityHeadersWithConfig(cfg.Security.Headers))
 	router.Use(middleware.AccessibilityHeaders())
-	
+
 	// CORS configuration for frontend
 	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{
-			"http://localhost:4200", 
-			"https://localhost:4200", 
-			"http://localhost:4201", 
-			"http://localhost:4202", 
-			"http://localhost:4203",
-			"http://127.0.0.1:4200",
-			"http://127.0.0.1:39623", // Browser preview proxy
-		},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length", "X-Total-Count"},
+		AllowOrigins:     cfg.CORS.AllowOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "If-Match", middleware.RequestIDHeader, handlers.IdempotencyKeyHeader, middleware.APIKeyHeader},
+		ExposeHeaders:    []string{"Content-Length", "X-Total-Count", "Link", "Idempotent-Replayed", "X-Cache", "Retry-After", "ETag", "X-Meta-Title", "X-Meta-Description", "X-Reading-Time", middleware.RequestIDHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
+	// Localize error messages from Accept-Language
+	router.Use(middleware.Locale())
+
+	// Bound request handling time; slow queries are cancelled with a 503
+	router.Use(middleware.Timeout(cfg.Server.RequestTimeout))
+
+	// Cap request bodies on write routes
+	maxBodyBytes := cfg.Server.MaxBodyBytes
+
 	// Initialize handlers
-	blogHandler := handlers.NewBlogHandler(db)
+	dispatcher := webhooks.NewDispatcher(cfg.Webhooks.URLs, cfg.Webhooks.Secret)
+	previews := preview.NewSigner(cfg.Security.PreviewTokenSecret, cfg.Security.PreviewTokenTTL)
+	mail := mailer.New(cfg.Mail)
+
+	// Optionally flag personal data and profanity in submitted posts
+	var contentScanner *scanner.Scanner
+	if cfg.Content.ContentScan {
+		scanConfig, err := scanner.LoadConfig(cfg.Content.ContentScanConfig)
+		if err != nil {
+			log.Fatalf("Invalid CONTENT_SCAN_CONFIG: %v", err)
+		}
+		if contentScanner, err = scanner.New(scanConfig); err != nil {
+			log.Fatalf("Invalid CONTENT_SCAN_CONFIG: %v", err)
+		}
+	}
+	blogHandler := handlers.NewBlogHandler(db, cfg.Blog, dispatcher, previews, mail, contentScanner)
+	healthHandler := handlers.NewHealthHandler(db, handlers.BuildInfo{
+		Version:   version,
+		Commit:    commit,
+		BuildTime: buildTime,
+		StartedAt: startedAt,
+	})
+	cspReportHandler := handlers.NewCSPReportHandler(db, cfg.Security.CSPReportStore)
+	accessibilityHandler := handlers.NewAccessibilityHandler(db)
+
+	// Limit CSP reports per client IP to avoid log flooding
+	cspLimiter := middleware.NewRateLimiter(cfg.RateLimits.CSPReports, time.Minute)
+
+	// Searches are the most expensive read, so they get a tighter per-IP limit
+	searchLimiter := middleware.RateLimitIf(middleware.NewRateLimiter(cfg.RateLimits.Search, time.Minute), func(c *gin.Context) bool {
+		return strings.TrimSpace(c.Query("search")) != ""
+	})
+
+	// Each subscribe request sends an email, so limit them per IP
+	subscribeLimiter := middleware.NewRateLimiter(cfg.RateLimits.Subscribe, time.Minute)
+
+	// Admin endpoints require the API key
+	requireAPIKey := middleware.RequireAPIKey(cfg.Security.APIKey)
 
 	// API routes
 	v1 := router.Group("/api/v1")
+	v1.Use(middleware.MaxBodySize(maxBodyBytes))
 	{
 		// Blog routes
 		blogs := v1.Group("/blogs")
 		{
-			blogs.GET("", blogHandler.GetBlogs)           // GET /api/v1/blogs?page=1&limit=10&search=query
-			blogs.GET("/:slug", blogHandler.GetBlogBySlug) // GET /api/v1/blogs/my-blog-post
-			blogs.POST("", blogHandler.CreateBlog)         // POST /api/v1/blogs
-			blogs.PUT("/:id", blogHandler.UpdateBlog)      // PUT /api/v1/blogs/1
-			blogs.DELETE("/:id", blogHandler.DeleteBlog)   // DELETE /api/v1/blogs/1
+			blogs.GET("", searchLimiter, blogHandler.GetBlogs)                              // GET /api/v1/blogs?page=1&limit=10&search=query
+			blogs.GET("/trending", blogHandler.GetTrendingBlogs)                            // GET /api/v1/blogs/trending?days=7&limit=5
+			blogs.GET("/popular", blogHandler.GetPopularBlogs)                              // GET /api/v1/blogs/popular?page=1&limit=10
+			blogs.GET("/suggest", blogHandler.SuggestBlogs)                                 // GET /api/v1/blogs/suggest?q=acc&limit=5
+			blogs.GET("/slug-preview", blogHandler.PreviewSlug)                             // GET /api/v1/blogs/slug-preview?title=My+Post
+			blogs.GET("/random", blogHandler.GetRandomBlog)                                 // GET /api/v1/blogs/random?tag=react
+			blogs.GET("/batch", blogHandler.GetBlogsBySlugs)                                // GET /api/v1/blogs/batch?slugs=first-post,second-post
+			blogs.GET("/:slug", blogHandler.GetBlogBySlug)                                  // GET /api/v1/blogs/my-blog-post
+			blogs.HEAD("/:slug", blogHandler.HeadBlogBySlug)                                // HEAD /api/v1/blogs/my-blog-post
+			blogs.GET("/:slug/stats", blogHandler.GetBlogStats)                             // GET /api/v1/blogs/my-blog-post/stats
+			blogs.GET("/:slug/sections", blogHandler.GetBlogSections)                       // GET /api/v1/blogs/my-blog-post/sections
+			blogs.GET("/:slug/related", blogHandler.GetRelatedBlogs)                        // GET /api/v1/blogs/my-blog-post/related
+			blogs.POST("", blogHandler.CreateBlog)                                          // POST /api/v1/blogs
+			blogs.PUT("/:id", blogHandler.UpdateBlog)                                       // PUT /api/v1/blogs/1
+			blogs.DELETE("/:id", blogHandler.DeleteBlog)                                    // DELETE /api/v1/blogs/1
+			blogs.POST("/:id/preview-token", requireAPIKey, blogHandler.CreatePreviewToken) // POST /api/v1/blogs/1/preview-token
+			blogs.PATCH("/:id/featured", blogHandler.SetFeatured)                           // PATCH /api/v1/blogs/1/featured
+
+			// Bulk deletion for clean-ups
+			blogs.POST("/batch-delete", requireAPIKey, blogHandler.BatchDeleteBlogs) // POST /api/v1/blogs/batch-delete {"ids":[1,2]}
+
+			// Bulk publishing for content drops
+			blogs.POST("/batch-publish", requireAPIKey, blogHandler.BatchPublishBlogs) // POST /api/v1/blogs/batch-publish {"ids":[1,2],"published":true}
+
+			// Reader comments (GET routes share the :slug wildcard with GetBlogBySlug)
+			blogs.GET("/:slug/comments", blogHandler.GetComments)  // GET /api/v1/blogs/my-blog-post/comments?sort=oldest
+			blogs.POST("/:id/comments", blogHandler.CreateComment) // POST /api/v1/blogs/1/comments
+
+			// Outbound link validation
+			blogs.GET("/:slug/link-check", requireAPIKey, blogHandler.CheckBlogLinks) // GET /api/v1/blogs/1/link-check
+
+			// Manual view count adjustments, audited
+			blogs.POST("/:id/views/reset", requireAPIKey, blogHandler.ResetViewCount) // POST /api/v1/blogs/1/views/reset
+			blogs.POST("/:id/views/set", requireAPIKey, blogHandler.SetViewCount)     // POST /api/v1/blogs/1/views/set {"view_count":100}
+
+			// Revision history (GET routes share the :slug wildcard with GetBlogBySlug)
+			blogs.GET("/:slug/revisions", requireAPIKey, blogHandler.GetRevisions)                    // GET /api/v1/blogs/1/revisions
+			blogs.GET("/:slug/revisions/diff", requireAPIKey, blogHandler.GetRevisionDiff)            // GET /api/v1/blogs/1/revisions/diff?from=2&to=5
+			blogs.POST("/:id/revisions/:version/restore", requireAPIKey, blogHandler.RestoreRevision) // POST /api/v1/blogs/1/revisions/2/restore
+
+			// Sanitizer dry run for editors
+			blogs.POST("/sanitize-preview", requireAPIKey, blogHandler.PreviewSanitizedContent) // POST /api/v1/blogs/sanitize-preview {"content":"<p>Hi</p>"}
+
+			// Editor autosave, kept apart from the live post
+			blogs.PUT("/:id/autosave", requireAPIKey, blogHandler.AutosaveBlog) // PUT /api/v1/blogs/1/autosave {"title":"Work in progress"}
+			blogs.GET("/:slug/draft", requireAPIKey, blogHandler.GetBlogDraft)  // GET /api/v1/blogs/1/draft
+		}
+
+		// Admin routes
+		admin := v1.Group("/admin", requireAPIKey)
+		{
+			admin.GET("/stats", blogHandler.GetAdminStats)               // GET /api/v1/admin/stats
+			admin.POST("/recompute", blogHandler.RecomputeDerivedFields) // POST /api/v1/admin/recompute
+			admin.GET("/comments", blogHandler.GetModerationComments)    // GET /api/v1/admin/comments?status=pending
+			admin.PATCH("/comments/:id", blogHandler.ModerateComment)    // PATCH /api/v1/admin/comments/1 {"status":"approved"}
+
+			admin.GET("/broken-internal-links", blogHandler.GetBrokenInternalLinks) // GET /api/v1/admin/broken-internal-links
+
+			// Sample data for onboarding and test environments
+			admin.GET("/seed-status", blogHandler.GetSeedStatus) // GET /api/v1/admin/seed-status
+			admin.POST("/seed", blogHandler.SeedDatabase)        // POST /api/v1/admin/seed?force=true
+		}
+
+		// Series routes
+		series := v1.Group("/series")
+		{
+			series.GET("/:slug", blogHandler.GetSeries)              // GET /api/v1/series/accessibility-guide
+			series.POST("", requireAPIKey, blogHandler.CreateSeries) // POST /api/v1/series {"title":"Accessibility Guide"}
 		}
 
-		// Health check
-		v1.GET("/health", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{
-				"status":    "healthy",
-				"timestamp": time.Now().UTC(),
-				"version":   "1.0.0",
-				"service":   "TechnoPrise Blog API",
-			})
-		})
-
-		// Accessibility check endpoint
-		v1.GET("/accessibility", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{
-				"wcag_compliance": "AA",
-				"features": []string{
-					"Screen reader optimization",
-					"Keyboard navigation",
-					"High contrast support",
-					"Reduced motion support",
-					"Focus management",
-					"Semantic HTML",
-					"ARIA labels",
-				},
-				"last_audit": time.Now().UTC(),
-			})
-		})
+		// Author routes
+		authors := v1.Group("/authors")
+		{
+			authors.GET("", blogHandler.GetAuthors)                   // GET /api/v1/authors
+			authors.GET("/:slug/feed.rss", blogHandler.GetAuthorFeed) // GET /api/v1/authors/jane-doe/feed.rss
+		}
+
+		// Date archive
+		archive := v1.Group("/archive")
+		{
+			archive.GET("", blogHandler.GetArchive)                   // GET /api/v1/archive
+			archive.GET("/:year/:month", blogHandler.GetArchiveMonth) // GET /api/v1/archive/2024/3?page=1&limit=10
+		}
+
+		// Email subscriptions
+		subscribers := v1.Group("/subscribers")
+		{
+			subscribers.POST("", middleware.RateLimit(subscribeLimiter), blogHandler.Subscribe) // POST /api/v1/subscribers {"email":"reader@example.com"}
+			subscribers.GET("/confirm", blogHandler.ConfirmSubscription)                        // GET /api/v1/subscribers/confirm?token=...
+			subscribers.GET("/unsubscribe", blogHandler.Unsubscribe)                            // GET /api/v1/subscribers/unsubscribe?token=...
+			subscribers.POST("/unsubscribe", blogHandler.Unsubscribe)                           // One-click List-Unsubscribe
+		}
+
+		// Tag routes
+		tags := v1.Group("/tags")
+		{
+			tags.GET("/cloud", blogHandler.GetTagCloud)        // GET /api/v1/tags/cloud
+			tags.GET("/synonyms", blogHandler.GetTagSynonyms)  // GET /api/v1/tags/synonyms
+			tags.GET("/:tag/feed.rss", blogHandler.GetTagFeed) // GET /api/v1/tags/accessibility/feed.rss
+		}
+
+		// GraphQL alternative to the REST read endpoints
+		v1.GET("/graphql", blogHandler.GraphQL)  // GET /api/v1/graphql?query={tags{name}}
+		v1.POST("/graphql", blogHandler.GraphQL) // POST /api/v1/graphql {"query":"{ blog(slug: \"my-post\") { title } }"}
+
+		// CSP violation reports from browsers
+		v1.POST("/csp-report", middleware.RateLimit(cspLimiter), middleware.MaxBodySize(handlers.CSPReportMaxBytes), cspReportHandler.Collect)
+
+		// Health checks
+		v1.GET("/health", healthHandler.Health) // Liveness: no dependencies touched
+		v1.GET("/ready", healthHandler.Ready)   // Readiness: pings the database
+
+		// Accessibility statement, editable with the API key
+		v1.GET("/accessibility", accessibilityHandler.GetStatement)
+		v1.PUT("/accessibility", requireAPIKey, accessibilityHandler.UpdateStatement)
 	}
 
-	// Start server
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	// Swagger UI and the raw spec at /swagger/doc.json, never served in release mode.
+	// Regenerate the spec with: swag init -g cmd/main.go -o docs --parseInternal --parseDependency
+	swaggerEnabled := cfg.Server.EnableSwagger && !cfg.Server.Release
+	if swaggerEnabled {
+		router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 
+	// Start server
+	port := cfg.Server.Port
+
 	log.Printf("🚀 TechnoPrise Blog API starting on port %s", port)
 	log.Printf("📱 Frontend URL: http://localhost:4200")
-	log.Printf("🔗 API Documentation: http://localhost:%s/api/v1/health", port)
-	
+	if swaggerEnabled {
+		log.Printf("🔗 API Documentation: http://localhost:%s/swagger/index.html", port)
+	}
+
 	if err := router.Run(":" + port); err != nil {
 		log.Fatal("Failed to start server:", err)
 	}
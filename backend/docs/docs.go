@@ -0,0 +1,4865 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "termsOfService": "https://technopriseglobal.com/terms",
+        "contact": {
+            "name": "TechnoPrise Global Support",
+            "url": "https://technopriseglobal.com/contact",
+            "email": "support@technopriseglobal.com"
+        },
+        "license": {
+            "name": "MIT",
+            "url": "https://opensource.org/licenses/MIT"
+        },
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/accessibility": {
+            "get": {
+                "description": "Return the site's WCAG conformance level, accessibility features, last audit date and contact",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accessibility"
+                ],
+                "summary": "Get the accessibility statement",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.AccessibilityStatementResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the WCAG conformance level, features, last audit date and contact",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accessibility"
+                ],
+                "summary": "Replace the accessibility statement",
+                "parameters": [
+                    {
+                        "description": "Accessibility statement",
+                        "name": "statement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.UpdateAccessibilityStatementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.AccessibilityStatementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/broken-internal-links": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Scan published posts for links to other posts and report those whose slug has no published post, including case and trailing-slash variants that would redirect",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Find broken internal links",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BrokenInternalLinksResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/comments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve comments across all posts with pagination, filtered by status (default pending)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "List comments for moderation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "pending",
+                        "description": "pending, approved or rejected",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "newest",
+                        "description": "newest or oldest",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/comments/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Approve, reject or return a comment to pending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Moderate a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.ModerateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/recompute": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Recalculate reading time, heading count, rich excerpt and content hash for every post and regenerate empty excerpts, in batches",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Recompute derived post fields",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/seed": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create the sample blog posts in an empty database. With force=true posts may exist, and only the sample posts whose slugs are free are created.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Seed the sample blog posts",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Seed even though posts exist",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SeedResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/seed-status": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Report whether the sample blog posts were seeded, how many of them remain and whether SEED_DATABASE enables seeding at startup",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get the sample data status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SeedStatus"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/stats": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Summarize post counts, views, reading time, the most used tags and posts published per month over the last year",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get editorial statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.AdminStats"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/archive": {
+            "get": {
+                "description": "Count published posts per calendar month, newest month first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "archive"
+                ],
+                "summary": "Get the date archive",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/technoprise-blog-backend_internal_models.ArchiveMonth"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/archive/{year}/{month}": {
+            "get": {
+                "description": "Paginated list of posts published in the given calendar month (UTC), newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "archive"
+                ],
+                "summary": "Get posts published in a month",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Year, e.g. 2024",
+                        "name": "year",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Month from 1 to 12",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/authors": {
+            "get": {
+                "description": "List distinct author names with their published post counts, most prolific first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "authors"
+                ],
+                "summary": "List authors",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/technoprise-blog-backend_internal_models.AuthorCount"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/authors/{slug}/feed.rss": {
+            "get": {
+                "description": "RSS 2.0 feed of the latest published posts by the author, identified by the slug of their name as listed by /authors",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "authors"
+                ],
+                "summary": "Get the RSS feed for an author",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Author slug, e.g. jane-doe",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.RSS"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs": {
+            "get": {
+                "description": "Retrieve blog posts with pagination and search functionality",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get paginated list of blog posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, up to MAX_PAGE_SIZE",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search term; results are ordered by relevance",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by featured posts",
+                        "name": "featured",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true",
+                            "false",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "true",
+                        "description": "Filter by published posts; all lists drafts too and requires the API key",
+                        "name": "published",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by author name",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by comma-separated tags, e.g. react,accessibility",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Match any or all of the tags",
+                        "name": "tag_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by ISO 639-1 language code",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published on or after (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published on or before (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated response fields to include, e.g. title,slug,excerpt",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tags to return per post; the rest are counted in tag_overflow",
+                        "name": "max_tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reader's last visit (RFC3339); posts published after it get is_new. Future times are ignored.",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new blog post with accessibility validation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Create a new blog post",
+                "parameters": [
+                    {
+                        "description": "Blog data",
+                        "name": "blog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CreateBlogRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Allow content identical to an existing post",
+                        "name": "allow_duplicate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Reject content flagged by the content scanner instead of warning",
+                        "name": "strict",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key making retries return the original response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CreateBlogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/batch": {
+            "get": {
+                "description": "Fetch published posts by slug in the requested order, without content, listing slugs that match no published post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get several blog posts by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated slugs, at most 50",
+                        "name": "slugs",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BatchBlogsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/batch-delete": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Soft delete up to 100 posts in one transaction, reporting which IDs were deleted and which were not found",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Delete several blog posts",
+                "parameters": [
+                    {
+                        "description": "Blog IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BatchDeleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/batch-publish": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the published state of up to 100 posts in one transaction. Publishing sets published_at if unset and unpublishing clears it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Publish or unpublish several blog posts",
+                "parameters": [
+                    {
+                        "description": "Blog IDs and the published state",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BatchPublishRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BatchPublishResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/popular": {
+            "get": {
+                "description": "Paginated leaderboard of published posts by lifetime view count, ties broken by recency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get the most viewed blog posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated response fields to include, e.g. title,slug,view_count",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/random": {
+            "get": {
+                "description": "Return one random published post without its content, optionally limited to a tag",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get a random blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only pick posts with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/sanitize-preview": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Run raw content through the HTML sanitizer without saving it, returning the HTML that would be stored and the elements and attributes removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Preview sanitized content",
+                "parameters": [
+                    {
+                        "description": "Raw content",
+                        "name": "content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SanitizePreviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SanitizePreviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/slug-preview": {
+            "get": {
+                "description": "Return the slug a title would produce, whether it is taken, and the next available variant without creating anything",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Preview the slug for a title",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post title",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/suggest": {
+            "get": {
+                "description": "Suggest published posts whose title or tags start with the query, most viewed first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get search suggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search prefix (at least 2 characters)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of suggestions",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/technoprise-blog-backend_internal_models.SuggestionResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/trending": {
+            "get": {
+                "description": "Rank published posts by views recorded within the last N days, falling back to lifetime view counts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get trending blog posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 7,
+                        "description": "Window in days, 1 to 365",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of posts, 1 to 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogResponse"
+                            }
+                        },
+                        "headers": {
+                            "X-Trending-Source": {
+                                "type": "string",
+                                "description": "recent when ranked by views in the window, lifetime when it had none"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}": {
+            "put": {
+                "description": "Update an existing blog post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Update a blog post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated blog data",
+                        "name": "blog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.UpdateBlogRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Reject content flagged by the content scanner instead of warning",
+                        "name": "strict",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.UpdateBlogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft delete a blog post by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Delete a blog post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/autosave": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Store the editor's unvalidated working copy, replacing any previous autosave. The live post is not changed and no webhooks are sent.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drafts"
+                ],
+                "summary": "Autosave a working copy of a blog post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Working copy",
+                        "name": "draft",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.AutosaveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogDraftResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/comments": {
+            "post": {
+                "description": "Submit a plain-text comment or reply on a published post; it is held as pending until a moderator approves it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Post a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment data",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/draft": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the latest autosave of a post, which may differ from the live post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drafts"
+                ],
+                "summary": "Get the autosaved working copy of a blog post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogDraftResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/featured": {
+            "patch": {
+                "description": "Update only the featured flag of a blog post, enforcing MAX_FEATURED when set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Toggle featured status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Featured flag",
+                        "name": "featured",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SetFeaturedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/link-check": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Issue HEAD requests to every link in a post and report each as ok, broken or redirect. Results are cached for five minutes per post version.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Check a post's links",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.LinkCheckResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/preview-token": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Mint a signed, expiring token that lets reviewers read a post before it is published",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Create a draft preview token",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the saved revisions of a blog post, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "List revisions of a blog post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogRevisionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/revisions/diff": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Compare the title, excerpt and content of two saved revisions line by line; content lines are block elements such as paragraphs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "Diff two revisions of a blog post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Older revision version",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Newer revision version",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.RevisionDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/revisions/{version}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Roll a blog post back to a saved revision, snapshotting the current state first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "Restore a blog post revision",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision version",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/views/reset": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the lifetime view count of a blog post to zero; the change is written to the audit log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "views"
+                ],
+                "summary": "Reset a post's view count",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.ViewCountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{id}/views/set": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the lifetime view count of a blog post to a non-negative baseline; the change is written to the audit log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "views"
+                ],
+                "summary": "Set a post's view count",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New view count",
+                        "name": "views",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SetViewCountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.ViewCountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{slug}": {
+            "get": {
+                "description": "Retrieve a blog post by its slug and increment view count. A valid preview_token also returns unpublished drafts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get a single blog post by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Draft preview token",
+                        "name": "preview_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated response fields to include, e.g. title,slug,content",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove autoplay and loop from media and flag GIF images; changes are listed in X-Reduced-Motion-Altered",
+                        "name": "reduced_motion",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogDetailResponse"
+                        },
+                        "headers": {
+                            "X-Reduced-Motion-Altered": {
+                                "type": "string",
+                                "description": "Changes made for reduced_motion, e.g. video autoplay=1, img animated-gif=2, or none"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            },
+            "head": {
+                "description": "Return the status and SEO headers of a blog post without a body or counting a view",
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get blog post headers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Draft preview token",
+                        "name": "preview_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "410": {
+                        "description": "Gone"
+                    }
+                }
+            }
+        },
+        "/blogs/{slug}/comments": {
+            "get": {
+                "description": "Retrieve the approved comments of a published post as threads, paginated by top-level comment, newest first unless sort=oldest. Replies are nested oldest first up to COMMENT_MAX_DEPTH levels; deeper replies are collapsed into the last level.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "List comments on a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "newest",
+                        "description": "newest or oldest",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{slug}/related": {
+            "get": {
+                "description": "List published posts sharing the most tags with a post, the posts it links to and the posts linking back to it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get posts related to a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.RelatedPostsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{slug}/sections": {
+            "get": {
+                "description": "Split a published post's content at its top-level headings, in order, for per-section rendering and reading progress",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get a blog post split into sections",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/technoprise-blog-backend_internal_models.ContentSection"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{slug}/stats": {
+            "get": {
+                "description": "Retrieve word count, reading time, structure counts, and readability for a published post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get content statistics for a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.ContentStats"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/csp-report": {
+            "post": {
+                "description": "Accept legacy application/csp-report payloads and Reporting API arrays, logging each violation",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "security"
+                ],
+                "summary": "Collect CSP violation reports",
+                "parameters": [
+                    {
+                        "description": "CSP violation report",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "description": "Run a GraphQL query against published posts. The schema is:\ntype Query { blogs(page: Int = 1, limit: Int = 10, search: String, tag: String): BlogList!  blog(slug: String!): Blog  tags: [Tag!]! }\ntype BlogList { blogs: [Blog!]!  pageInfo: PageInfo! }\ntype PageInfo { total: Int!  page: Int!  limit: Int!  totalPages: Int!  hasNext: Boolean!  hasPrev: Boolean! }\ntype Tag { name: String!  count: Int!  weight: Int! }\nBlog exposes the BlogResponse fields in camelCase. Only queries are supported and introspection is disabled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "Query posts with GraphQL",
+                "parameters": [
+                    {
+                        "description": "GraphQL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_graphql.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_graphql.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_graphql.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Cheap liveness check that does not touch the database, reporting build info, uptime and memory usage",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/ready": {
+            "get": {
+                "description": "Pings the database and reports connection pool statistics",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/series": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create an empty series; posts join it through series_id on create or update",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Create a post series",
+                "parameters": [
+                    {
+                        "description": "Series data",
+                        "name": "series",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.CreateSeriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.Series"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{slug}": {
+            "get": {
+                "description": "Retrieve a series with its published posts in reading order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Get a post series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SeriesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscribers": {
+            "post": {
+                "description": "Request new-post emails; a confirmation link is emailed and nothing else is sent until it is opened",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscribers"
+                ],
+                "summary": "Subscribe to new posts",
+                "parameters": [
+                    {
+                        "description": "Email address",
+                        "name": "subscriber",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SubscribeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SubscribeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscribers/confirm": {
+            "get": {
+                "description": "Confirm an email address using the token from the confirmation email",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscribers"
+                ],
+                "summary": "Confirm a subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SubscriberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscribers/unsubscribe": {
+            "get": {
+                "description": "Remove a subscriber using the token from any email; POST supports one-click List-Unsubscribe",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscribers"
+                ],
+                "summary": "Unsubscribe from new posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Remove a subscriber using the token from any email; POST supports one-click List-Unsubscribe",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscribers"
+                ],
+                "summary": "Unsubscribe from new posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/cloud": {
+            "get": {
+                "description": "Count tags across published posts and assign each a weight from 1 to 5 based on frequency",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get the tag cloud",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/technoprise-blog-backend_internal_models.TagCloudEntry"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/synonyms": {
+            "get": {
+                "description": "Map each tag synonym to the canonical tag it is stored as",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get tag synonyms",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{tag}/feed.rss": {
+            "get": {
+                "description": "RSS 2.0 feed of the latest published posts carrying the tag",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get the RSS feed for a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.RSS"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_apierror.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "encoding_xml.Name": {
+            "type": "object",
+            "properties": {
+                "local": {
+                    "type": "string"
+                },
+                "space": {
+                    "type": "string"
+                }
+            }
+        },
+        "gin.H": {
+            "type": "object",
+            "additionalProperties": {}
+        },
+        "technoprise-blog-backend_internal_apierror.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Machine-readable error code",
+                    "type": "string"
+                },
+                "details": {
+                    "description": "Human-readable specifics, e.g. one entry per invalid field",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "description": "Localized summary",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_graphql.Error": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_graphql.Request": {
+            "type": "object",
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_graphql.Response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_graphql.Error"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.AccessibilityStatementResponse": {
+            "type": "object",
+            "properties": {
+                "contact": {
+                    "type": "string"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "last_audit": {
+                    "type": "string"
+                },
+                "wcag_compliance": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.AdjacentPost": {
+            "type": "object",
+            "properties": {
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.AdminStats": {
+            "type": "object",
+            "properties": {
+                "average_reading_time": {
+                    "description": "Minutes, rounded to one decimal",
+                    "type": "number"
+                },
+                "draft_posts": {
+                    "type": "integer"
+                },
+                "featured_posts": {
+                    "type": "integer"
+                },
+                "published_per_month": {
+                    "description": "Oldest month first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.MonthlyCount"
+                    }
+                },
+                "published_posts": {
+                    "type": "integer"
+                },
+                "top_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.TagCount"
+                    }
+                },
+                "total_posts": {
+                    "type": "integer"
+                },
+                "total_views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.ArchiveMonth": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "integer"
+                },
+                "post_count": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.AuthorCount": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "post_count": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "description": "Identifies the author in feed URLs",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.AutosaveRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "type": "string"
+                },
+                "meta_description": {
+                    "type": "string"
+                },
+                "meta_title": {
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "Comma-separated, as entered",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BatchBlogsResponse": {
+            "type": "object",
+            "properties": {
+                "blogs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogResponse"
+                    }
+                },
+                "not_found": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BatchDeleteResponse": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "not_found": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BatchPublishRequest": {
+            "type": "object",
+            "required": [
+                "ids",
+                "published"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "published": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BatchPublishResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.BatchPublishResult"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BatchPublishResult": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "updated, unchanged or not_found",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BatchRequest": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BlogDetailResponse": {
+            "type": "object",
+            "properties": {
+                "allow_comments": {
+                    "type": "boolean"
+                },
+                "audio_length_seconds": {
+                    "description": "Estimated text-to-speech listening time",
+                    "type": "integer"
+                },
+                "author": {
+                    "type": "string"
+                },
+                "content": {
+                    "description": "Only included in single blog requests",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "type": "string"
+                },
+                "heading_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_new": {
+                    "description": "Published after ?since=; only set when it is given",
+                    "type": "boolean"
+                },
+                "json_ld": {
+                    "description": "schema.org BlogPosting markup",
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "meta": {
+                    "description": "Only included in single blog requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.OpenGraphMeta"
+                        }
+                    ]
+                },
+                "meta_description": {
+                    "type": "string"
+                },
+                "meta_title": {
+                    "type": "string"
+                },
+                "next": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.AdjacentPost"
+                },
+                "prev": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.AdjacentPost"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "reading_time": {
+                    "type": "integer"
+                },
+                "rich_excerpt": {
+                    "description": "Sanitized HTML allowing strong, em and a",
+                    "type": "string"
+                },
+                "series": {
+                    "description": "Position within the post's series, if any",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.SeriesContext"
+                        }
+                    ]
+                },
+                "series_id": {
+                    "type": "integer"
+                },
+                "series_order": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "tag_overflow": {
+                    "description": "Tags left out by ?max_tags=",
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translation_group": {
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "Only included in single blog requests",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.Translation"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BlogDraftResponse": {
+            "type": "object",
+            "properties": {
+                "blog_id": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "type": "string"
+                },
+                "meta_description": {
+                    "type": "string"
+                },
+                "meta_title": {
+                    "type": "string"
+                },
+                "saved_at": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BlogListResponse": {
+            "type": "object",
+            "properties": {
+                "blogs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.BlogResponse"
+                    }
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.PaginationLinks"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BlogResponse": {
+            "type": "object",
+            "properties": {
+                "allow_comments": {
+                    "type": "boolean"
+                },
+                "audio_length_seconds": {
+                    "description": "Estimated text-to-speech listening time",
+                    "type": "integer"
+                },
+                "author": {
+                    "type": "string"
+                },
+                "content": {
+                    "description": "Only included in single blog requests",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "type": "string"
+                },
+                "heading_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_new": {
+                    "description": "Published after ?since=; only set when it is given",
+                    "type": "boolean"
+                },
+                "json_ld": {
+                    "description": "schema.org BlogPosting markup",
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "meta": {
+                    "description": "Only included in single blog requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.OpenGraphMeta"
+                        }
+                    ]
+                },
+                "meta_description": {
+                    "type": "string"
+                },
+                "meta_title": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "reading_time": {
+                    "type": "integer"
+                },
+                "rich_excerpt": {
+                    "description": "Sanitized HTML allowing strong, em and a",
+                    "type": "string"
+                },
+                "series_id": {
+                    "type": "integer"
+                },
+                "series_order": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "tag_overflow": {
+                    "description": "Tags left out by ?max_tags=",
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translation_group": {
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "Only included in single blog requests",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.Translation"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BlogRevisionResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BrokenInternalLink": {
+            "type": "object",
+            "properties": {
+                "blog_id": {
+                    "type": "integer"
+                },
+                "blog_slug": {
+                    "type": "string"
+                },
+                "link": {
+                    "description": "The href as written in the content",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "missing, deleted or unpublished",
+                    "type": "string"
+                },
+                "target_slug": {
+                    "description": "Slug the link points at",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.BrokenInternalLinksResponse": {
+            "type": "object",
+            "properties": {
+                "broken": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.BrokenInternalLink"
+                    }
+                },
+                "checked": {
+                    "description": "Internal links found",
+                    "type": "integer"
+                },
+                "scanned": {
+                    "description": "Published posts scanned",
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.CommentListResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.CommentResponse"
+                    }
+                },
+                "comments_enabled": {
+                    "description": "False when the post has comments turned off",
+                    "type": "boolean"
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.PaginationLinks"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "blog_id": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "replies": {
+                    "description": "Only included in threaded listings",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.CommentResponse"
+                    }
+                },
+                "status": {
+                    "description": "Only included in moderation responses",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.ContentSection": {
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "description": "Heading id, or one derived from its text",
+                    "type": "string"
+                },
+                "heading": {
+                    "description": "Plain heading text; empty for the introduction",
+                    "type": "string"
+                },
+                "html": {
+                    "description": "Section markup, including its heading",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.ContentStats": {
+            "type": "object",
+            "properties": {
+                "grade_level": {
+                    "description": "Flesch-Kincaid grade level",
+                    "type": "number"
+                },
+                "heading_count": {
+                    "type": "integer"
+                },
+                "image_count": {
+                    "type": "integer"
+                },
+                "link_count": {
+                    "type": "integer"
+                },
+                "reading_time": {
+                    "type": "integer"
+                },
+                "word_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.CreateBlogRequest": {
+            "type": "object",
+            "required": [
+                "author",
+                "content",
+                "title"
+            ],
+            "properties": {
+                "allow_comments": {
+                    "description": "Omitted means comments are allowed",
+                    "type": "boolean"
+                },
+                "author": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "content": {
+                    "type": "string",
+                    "minLength": 10
+                },
+                "excerpt": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "description": "Checked by the handler, which rejects a missing or long alt with a 422",
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "meta_description": {
+                    "type": "string",
+                    "maxLength": 160
+                },
+                "meta_title": {
+                    "type": "string",
+                    "maxLength": 60
+                },
+                "published": {
+                    "description": "Omitted means DEFAULT_PUBLISHED",
+                    "type": "boolean"
+                },
+                "series_id": {
+                    "description": "Adds the post to an existing series",
+                    "type": "integer"
+                },
+                "series_order": {
+                    "description": "Omitted means after the series' last post",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "tags": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "translation_group": {
+                    "description": "Links this post to existing language variants",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.CreateBlogResponse": {
+            "type": "object",
+            "properties": {
+                "allow_comments": {
+                    "type": "boolean"
+                },
+                "audio_length_seconds": {
+                    "description": "Estimated text-to-speech listening time",
+                    "type": "integer"
+                },
+                "author": {
+                    "type": "string"
+                },
+                "content": {
+                    "description": "Only included in single blog requests",
+                    "type": "string"
+                },
+                "content_warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_scanner.Finding"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "type": "string"
+                },
+                "heading_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_new": {
+                    "description": "Published after ?since=; only set when it is given",
+                    "type": "boolean"
+                },
+                "json_ld": {
+                    "description": "schema.org BlogPosting markup",
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "meta": {
+                    "description": "Only included in single blog requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.OpenGraphMeta"
+                        }
+                    ]
+                },
+                "meta_description": {
+                    "type": "string"
+                },
+                "meta_title": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "reading_time": {
+                    "type": "integer"
+                },
+                "rich_excerpt": {
+                    "description": "Sanitized HTML allowing strong, em and a",
+                    "type": "string"
+                },
+                "seo_warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.SEOWarning"
+                    }
+                },
+                "series_id": {
+                    "type": "integer"
+                },
+                "series_order": {
+                    "type": "integer"
+                },
+                "similar_posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.SimilarPost"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "tag_overflow": {
+                    "description": "Tags left out by ?max_tags=",
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translation_group": {
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "Only included in single blog requests",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.Translation"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.CreateCommentRequest": {
+            "type": "object",
+            "required": [
+                "author",
+                "content"
+            ],
+            "properties": {
+                "author": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "minLength": 1
+                },
+                "parent_id": {
+                    "description": "Reply to this comment on the same post",
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.CreateSeriesRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.DiffLine": {
+            "type": "object",
+            "properties": {
+                "op": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.FieldDiff": {
+            "type": "object",
+            "properties": {
+                "changed": {
+                    "type": "boolean"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.DiffLine"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.LinkCheckResponse": {
+            "type": "object",
+            "properties": {
+                "blog_id": {
+                    "type": "integer"
+                },
+                "broken": {
+                    "type": "integer"
+                },
+                "checked": {
+                    "type": "integer"
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.LinkStatus"
+                    }
+                },
+                "redirects": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.LinkStatus": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Redirect target",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "ok, broken or redirect",
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.ModerateCommentRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "approved",
+                        "rejected"
+                    ]
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.MonthlyCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "month": {
+                    "description": "YYYY-MM",
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.OpenGraphMeta": {
+            "type": "object",
+            "properties": {
+                "article:author": {
+                    "type": "string"
+                },
+                "article:published_time": {
+                    "type": "string"
+                },
+                "og:description": {
+                    "type": "string"
+                },
+                "og:image": {
+                    "type": "string"
+                },
+                "og:image:alt": {
+                    "type": "string"
+                },
+                "og:title": {
+                    "type": "string"
+                },
+                "og:type": {
+                    "type": "string"
+                },
+                "og:url": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.PaginationLinks": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "type": "string"
+                },
+                "last": {
+                    "type": "string"
+                },
+                "next": {
+                    "type": "string"
+                },
+                "prev": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RSS": {
+            "type": "object",
+            "properties": {
+                "atomNS": {
+                    "type": "string"
+                },
+                "channel": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.RSSChannel"
+                },
+                "dcns": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                },
+                "xmlname": {
+                    "$ref": "#/definitions/encoding_xml.Name"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RSSAtomLink": {
+            "type": "object",
+            "properties": {
+                "href": {
+                    "type": "string"
+                },
+                "rel": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RSSChannel": {
+            "type": "object",
+            "properties": {
+                "atomLink": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.RSSAtomLink"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.RSSItem"
+                    }
+                },
+                "language": {
+                    "type": "string"
+                },
+                "lastBuildDate": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RSSGUID": {
+            "type": "object",
+            "properties": {
+                "isPermaLink": {
+                    "type": "boolean"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RSSItem": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "guid": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.RSSGUID"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "pubDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RelatedPost": {
+            "type": "object",
+            "properties": {
+                "shared_tags": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RelatedPostsResponse": {
+            "type": "object",
+            "properties": {
+                "linked_from": {
+                    "description": "Published posts linking to this post, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.AdjacentPost"
+                    }
+                },
+                "links_to": {
+                    "description": "Published posts this post links to, in link order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.AdjacentPost"
+                    }
+                },
+                "related": {
+                    "description": "Most shared tags first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.RelatedPost"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.RevisionDiffResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.FieldDiff"
+                },
+                "excerpt": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.FieldDiff"
+                },
+                "from": {
+                    "type": "integer"
+                },
+                "title": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.FieldDiff"
+                },
+                "to": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SEOWarning": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SanitizePreviewRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SanitizePreviewResponse": {
+            "type": "object",
+            "properties": {
+                "html": {
+                    "type": "string"
+                },
+                "removed": {
+                    "description": "In order of first removal",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.SanitizerRemoval"
+                    }
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SanitizerRemoval": {
+            "type": "object",
+            "properties": {
+                "attribute": {
+                    "description": "Empty when the whole element was removed",
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "element": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SeedResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "description": "Sample posts whose slugs were already in use",
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SeedStatus": {
+            "type": "object",
+            "properties": {
+                "remaining_seed_posts": {
+                    "description": "Sample posts not deleted",
+                    "type": "integer"
+                },
+                "seed_posts": {
+                    "type": "integer"
+                },
+                "seeded": {
+                    "type": "boolean"
+                },
+                "seeded_at": {
+                    "description": "Null for databases seeded before it was recorded",
+                    "type": "string"
+                },
+                "seeding_enabled": {
+                    "description": "SEED_DATABASE, applied at startup",
+                    "type": "boolean"
+                },
+                "total_posts": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.Series": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SeriesContext": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.AdjacentPost"
+                },
+                "position": {
+                    "description": "1-based position among published posts",
+                    "type": "integer"
+                },
+                "prev": {
+                    "$ref": "#/definitions/technoprise-blog-backend_internal_models.AdjacentPost"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SeriesPost": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "description": "Position within the series, starting at 1",
+                    "type": "integer"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SeriesResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.SeriesPost"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SetFeaturedRequest": {
+            "type": "object",
+            "required": [
+                "featured"
+            ],
+            "properties": {
+                "featured": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SetViewCountRequest": {
+            "type": "object",
+            "required": [
+                "view_count"
+            ],
+            "properties": {
+                "view_count": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SimilarPost": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "similarity": {
+                    "description": "Token overlap from 0 to 1",
+                    "type": "number"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SubscribeRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 254
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SubscribeResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SubscriberResponse": {
+            "type": "object",
+            "properties": {
+                "confirmed": {
+                    "type": "boolean"
+                },
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.SuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.TagCloudEntry": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.TagCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.Translation": {
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.UpdateAccessibilityStatementRequest": {
+            "type": "object",
+            "required": [
+                "features",
+                "last_audit",
+                "wcag_compliance"
+            ],
+            "properties": {
+                "contact": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "features": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "last_audit": {
+                    "type": "string"
+                },
+                "wcag_compliance": {
+                    "type": "string",
+                    "enum": [
+                        "A",
+                        "AA",
+                        "AAA"
+                    ]
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.UpdateBlogRequest": {
+            "type": "object",
+            "properties": {
+                "allow_comments": {
+                    "type": "boolean"
+                },
+                "author": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "content": {
+                    "type": "string",
+                    "minLength": 10
+                },
+                "excerpt": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "meta_description": {
+                    "type": "string",
+                    "maxLength": 160
+                },
+                "meta_title": {
+                    "type": "string",
+                    "maxLength": 60
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "series_id": {
+                    "description": "0 removes the post from its series",
+                    "type": "integer"
+                },
+                "series_order": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "tags": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "version": {
+                    "description": "Expected current version when If-Match is not sent",
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.UpdateBlogResponse": {
+            "type": "object",
+            "properties": {
+                "allow_comments": {
+                    "type": "boolean"
+                },
+                "audio_length_seconds": {
+                    "description": "Estimated text-to-speech listening time",
+                    "type": "integer"
+                },
+                "author": {
+                    "type": "string"
+                },
+                "content": {
+                    "description": "Only included in single blog requests",
+                    "type": "string"
+                },
+                "content_warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_scanner.Finding"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "featured_image": {
+                    "type": "string"
+                },
+                "featured_image_alt": {
+                    "type": "string"
+                },
+                "heading_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_new": {
+                    "description": "Published after ?since=; only set when it is given",
+                    "type": "boolean"
+                },
+                "json_ld": {
+                    "description": "schema.org BlogPosting markup",
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "meta": {
+                    "description": "Only included in single blog requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/technoprise-blog-backend_internal_models.OpenGraphMeta"
+                        }
+                    ]
+                },
+                "meta_description": {
+                    "type": "string"
+                },
+                "meta_title": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "reading_time": {
+                    "type": "integer"
+                },
+                "rich_excerpt": {
+                    "description": "Sanitized HTML allowing strong, em and a",
+                    "type": "string"
+                },
+                "seo_warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.SEOWarning"
+                    }
+                },
+                "series_id": {
+                    "type": "integer"
+                },
+                "series_order": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "tag_overflow": {
+                    "description": "Tags left out by ?max_tags=",
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translation_group": {
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "Only included in single blog requests",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technoprise-blog-backend_internal_models.Translation"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_models.ViewCountResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "previous_view_count": {
+                    "type": "integer"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "technoprise-blog-backend_internal_scanner.Finding": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "match": {
+                    "type": "string"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "localhost:8080",
+	BasePath:         "/api/v1",
+	Schemes:          []string{},
+	Title:            "TechnoPrise Blog API",
+	Description:      "Futuristic accessibility-first blog platform API",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
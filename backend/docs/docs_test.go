@@ -0,0 +1,85 @@
+package docs_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	_ "technoprise-blog-backend/docs"
+)
+
+// routerAnnotation matches a handler's @Router annotation
+var routerAnnotation = regexp.MustCompile(`@Router\s+(\S+)\s+\[(\w+)\]`)
+
+// spec is the part of the generated spec the tests inspect
+type spec struct {
+	Swagger  string                                `json:"swagger"`
+	BasePath string                                `json:"basePath"`
+	Paths    map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+// fetchSpec serves the spec the way main does and returns /swagger/doc.json
+func fetchSpec(t *testing.T) spec {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/swagger/doc.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("doc.json status = %d, want 200", w.Code)
+	}
+	var doc spec
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode doc.json: %v", err)
+	}
+	return doc
+}
+
+func TestDocJSON(t *testing.T) {
+	doc := fetchSpec(t)
+	if doc.Swagger != "2.0" || doc.BasePath != "/api/v1" {
+		t.Errorf("swagger %q with base path %q, want 2.0 with /api/v1", doc.Swagger, doc.BasePath)
+	}
+	if _, ok := doc.Paths["/blogs"]["get"]; !ok {
+		t.Error("spec lacks GET /blogs")
+	}
+}
+
+func TestDocJSONCoversHandlerRoutes(t *testing.T) {
+	doc := fetchSpec(t)
+
+	files, err := filepath.Glob(filepath.Join("..", "internal", "handlers", "*.go"))
+	if err != nil {
+		t.Fatalf("list handlers: %v", err)
+	}
+	routes := 0
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		source, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		for _, match := range routerAnnotation.FindAllStringSubmatch(string(source), -1) {
+			routes++
+			path, method := match[1], strings.ToLower(match[2])
+			if _, ok := doc.Paths[path][method]; !ok {
+				t.Errorf("%s: %s %s is annotated but missing from the spec; regenerate it with swag init", filepath.Base(file), strings.ToUpper(method), path)
+			}
+		}
+	}
+	if routes == 0 {
+		t.Fatal("no @Router annotations found")
+	}
+}
@@ -0,0 +1,109 @@
+package apierror
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/i18n"
+)
+
+// Machine-readable error codes, one per kind of failure
+const (
+	CodeInvalidRequest       = "invalid_request"
+	CodeValidationFailed     = "validation_failed"
+	CodeUnauthorized         = "unauthorized"
+	CodeForbidden            = "forbidden"
+	CodeNotFound             = "not_found"
+	CodeConflict             = "conflict"
+	CodeGone                 = "gone"
+	CodePreconditionFailed   = "precondition_failed"
+	CodePayloadTooLarge      = "payload_too_large"
+	CodePreconditionRequired = "precondition_required"
+	CodeRateLimited          = "rate_limited"
+	CodeInternal             = "internal_error"
+	CodeUnavailable          = "unavailable"
+)
+
+// Response is the JSON envelope of every error response. Some errors add
+// further fields, such as retry_after or current_version.
+type Response struct {
+	Error   string   `json:"error"`   // Localized summary
+	Code    string   `json:"code"`    // Machine-readable error code
+	Details []string `json:"details"` // Human-readable specifics, e.g. one entry per invalid field
+}
+
+// CodeFor returns the error code for an HTTP status
+func CodeFor(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusGone:
+		return CodeGone
+	case http.StatusPreconditionFailed:
+		return CodePreconditionFailed
+	case http.StatusRequestEntityTooLarge:
+		return CodePayloadTooLarge
+	case http.StatusPreconditionRequired:
+		return CodePreconditionRequired
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeInvalidRequest
+}
+
+// Body builds the error envelope, translating message for the request locale
+func Body(c *gin.Context, status int, message string, details ...string) gin.H {
+	return body(c, CodeFor(status), message, details)
+}
+
+func body(c *gin.Context, code, message string, details []string) gin.H {
+	if details == nil {
+		details = []string{}
+	}
+	return gin.H{
+		"error":   i18n.T(c, message),
+		"code":    code,
+		"details": details,
+	}
+}
+
+// Respond writes an error response
+func Respond(c *gin.Context, status int, message string, details ...string) {
+	c.JSON(status, Body(c, status, message, details...))
+}
+
+// RespondWith writes an error response carrying extra fields alongside the envelope
+func RespondWith(c *gin.Context, status int, message string, extra gin.H, details ...string) {
+	response := Body(c, status, message, details...)
+	for key, value := range extra {
+		response[key] = value
+	}
+	c.JSON(status, response)
+}
+
+// Abort writes an error response and stops the handler chain
+func Abort(c *gin.Context, status int, message string, details ...string) {
+	c.AbortWithStatusJSON(status, Body(c, status, message, details...))
+}
+
+// AbortWith writes an error response carrying extra fields and stops the handler chain
+func AbortWith(c *gin.Context, status int, message string, extra gin.H, details ...string) {
+	response := Body(c, status, message, details...)
+	for key, value := range extra {
+		response[key] = value
+	}
+	c.AbortWithStatusJSON(status, response)
+}
@@ -0,0 +1,70 @@
+package apierror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCodeFor(t *testing.T) {
+	for status, want := range map[int]string{
+		http.StatusBadRequest:            CodeInvalidRequest,
+		http.StatusUnauthorized:          CodeUnauthorized,
+		http.StatusNotFound:              CodeNotFound,
+		http.StatusConflict:              CodeConflict,
+		http.StatusPreconditionFailed:    CodePreconditionFailed,
+		http.StatusTooManyRequests:       CodeRateLimited,
+		http.StatusServiceUnavailable:    CodeUnavailable,
+		http.StatusInternalServerError:   CodeInternal,
+		http.StatusBadGateway:            CodeInternal,
+		http.StatusUnprocessableEntity:   CodeInvalidRequest,
+		http.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	} {
+		if got := CodeFor(status); got != want {
+			t.Errorf("CodeFor(%d) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestRespondEnvelope(t *testing.T) {
+	router := gin.New()
+	router.GET("/plain", func(c *gin.Context) { Respond(c, http.StatusNotFound, "Blog post not found") })
+	router.GET("/details", func(c *gin.Context) {
+		Respond(c, http.StatusBadRequest, "Invalid tag", "tags must not be empty", "tags must be short")
+	})
+	router.GET("/extra", func(c *gin.Context) {
+		RespondWith(c, http.StatusConflict, "Version conflict", gin.H{"current_version": 4})
+	})
+	router.GET("/abort", func(c *gin.Context) {
+		Abort(c, http.StatusUnauthorized, "Invalid API key")
+	}, func(c *gin.Context) { t.Error("handler chain continued after Abort") })
+
+	tests := []struct {
+		path   string
+		status int
+		want   map[string]interface{}
+	}{
+		{"/plain", http.StatusNotFound, map[string]interface{}{"error": "Blog post not found", "code": "not_found", "details": []interface{}{}}},
+		{"/details", http.StatusBadRequest, map[string]interface{}{"error": "Invalid tag", "code": "invalid_request", "details": []interface{}{"tags must not be empty", "tags must be short"}}},
+		{"/extra", http.StatusConflict, map[string]interface{}{"error": "Version conflict", "code": "conflict", "details": []interface{}{}, "current_version": float64(4)}},
+		{"/abort", http.StatusUnauthorized, map[string]interface{}{"error": "Invalid API key", "code": "unauthorized", "details": []interface{}{}}},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		if w.Code != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.path, w.Code, tt.status)
+		}
+		var got map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatalf("%s: decode %q: %v", tt.path, w.Body.String(), err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: body = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
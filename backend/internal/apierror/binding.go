@@ -0,0 +1,160 @@
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ConfigureValidator makes request binding enforce the `validate` struct tags
+// used by the request models and report fields by their JSON names
+func ConfigureValidator() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.SetTagName("validate")
+		v.RegisterTagNameFunc(jsonFieldName)
+	}
+}
+
+// jsonFieldName returns the name a struct field has in JSON, falling back to
+// the snake_case Go name for untagged fields
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return snakeCase(field.Name)
+	}
+	return name
+}
+
+// BindFailed writes a 400 response for a request body that could not be bound,
+// describing each invalid field without exposing parser internals
+func BindFailed(c *gin.Context, err error) {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		details := make([]string, len(validationErrors))
+		for i, fieldErr := range validationErrors {
+			details[i] = FieldMessage(fieldErr)
+		}
+		c.JSON(http.StatusBadRequest, body(c, CodeValidationFailed, "Invalid request data", details))
+		return
+	}
+	Respond(c, http.StatusBadRequest, "Invalid request data", decodeMessage(err))
+}
+
+// decodeMessage describes a JSON decoding failure
+func decodeMessage(err error) string {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, io.EOF):
+		return "request body must not be empty"
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return "request body must be valid JSON"
+	case errors.As(err, &typeErr):
+		if typeErr.Field == "" {
+			return "request body must be a JSON " + jsonKind(typeErr.Type)
+		}
+		return typeErr.Field + " must be " + withArticle(jsonKind(typeErr.Type))
+	}
+	return "request body could not be read"
+}
+
+// jsonKind names the JSON type that decodes into t
+func jsonKind(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return "object"
+}
+
+func withArticle(kind string) string {
+	if strings.IndexByte("aeiou", kind[0]) >= 0 {
+		return "an " + kind
+	}
+	return "a " + kind
+}
+
+// FieldMessage turns a validation failure into a sentence such as
+// "meta_title must be at most 60 characters"
+func FieldMessage(err validator.FieldError) string {
+	field := err.Field()
+	param := err.Param()
+	switch err.Tag() {
+	case "required":
+		return field + " is required"
+	case "required_with":
+		// The parameter names the other field by its Go name
+		return field + " is required when " + snakeCase(param) + " is set"
+	case "min":
+		return field + " must be at least " + param + sizeUnit(err.Kind(), param)
+	case "max":
+		return field + " must be at most " + param + sizeUnit(err.Kind(), param)
+	case "len":
+		return field + " must be exactly " + param + sizeUnit(err.Kind(), param)
+	case "email":
+		return field + " must be a valid email address"
+	case "url":
+		return field + " must be a valid URL"
+	case "uuid":
+		return field + " must be a UUID"
+	case "oneof":
+		return field + " must be one of " + strings.Join(strings.Fields(param), ", ")
+	}
+	return field + " is invalid"
+}
+
+// sizeUnit names what a min, max or len parameter counts for a field kind
+func sizeUnit(kind reflect.Kind, param string) string {
+	unit := ""
+	switch kind {
+	case reflect.String:
+		unit = " character"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		unit = " item"
+	default:
+		return ""
+	}
+	if param != "1" {
+		unit += "s"
+	}
+	return unit
+}
+
+// snakeCase converts a Go field name such as MetaTitle to meta_title
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
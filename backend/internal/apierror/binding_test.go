@@ -0,0 +1,145 @@
+package apierror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	ConfigureValidator()
+	os.Exit(m.Run())
+}
+
+// bindRequest binds body into v the way the handlers do and returns the response
+func bindRequest(t *testing.T, body string, v interface{}) (*httptest.ResponseRecorder, Response) {
+	t.Helper()
+	router := gin.New()
+	router.POST("/", func(c *gin.Context) {
+		if err := c.ShouldBindJSON(v); err != nil {
+			BindFailed(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, r)
+
+	var resp Response
+	if w.Code != http.StatusNoContent {
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decode response %q: %v", w.Body.String(), err)
+		}
+	}
+	return w, resp
+}
+
+func TestBindFailedValidationErrors(t *testing.T) {
+	body := `{
+		"title": "",
+		"content": "short",
+		"excerpt": "` + strings.Repeat("x", 501) + `",
+		"meta_title": "` + strings.Repeat("t", 61) + `",
+		"language": "eng",
+		"translation_group": "not-a-uuid",
+		"series_order": -1,
+		"featured_image": "not a url"
+	}`
+	w, resp := bindRequest(t, body, &models.CreateBlogRequest{})
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", w.Code)
+	}
+	if resp.Error != "Invalid request data" || resp.Code != CodeValidationFailed {
+		t.Errorf("envelope = %+v, want Invalid request data / %s", resp, CodeValidationFailed)
+	}
+	want := []string{
+		"title is required",
+		"content must be at least 10 characters",
+		"excerpt must be at most 500 characters",
+		"author is required",
+		"meta_title must be at most 60 characters",
+		"language must be exactly 2 characters",
+		"translation_group must be a UUID",
+		"series_order must be at least 1",
+		"featured_image must be a valid URL",
+	}
+	if !reflect.DeepEqual(resp.Details, want) {
+		t.Errorf("details =\n%q\nwant\n%q", resp.Details, want)
+	}
+
+	w, resp = bindRequest(t, `{"ids": []}`, &models.BatchRequest{})
+	if w.Code != http.StatusBadRequest || !reflect.DeepEqual(resp.Details, []string{"ids must be at least 1 item"}) {
+		t.Errorf("batch: status %d, details %q", w.Code, resp.Details)
+	}
+
+	w, _ = bindRequest(t, `{"title": "Valid", "content": "Long enough content", "author": "Jane"}`, &models.CreateBlogRequest{})
+	if w.Code != http.StatusNoContent {
+		t.Errorf("valid request: status = %d, want 204", w.Code)
+	}
+}
+
+func TestBindFailedDecodeErrors(t *testing.T) {
+	tests := []struct {
+		body   string
+		detail string
+	}{
+		{``, "request body must not be empty"},
+		{`{"title": "unterminated`, "request body must be valid JSON"},
+		{`{"title": }`, "request body must be valid JSON"},
+		{`{"title": 5}`, "title must be a string"},
+		{`{"published": "yes"}`, "published must be a boolean"},
+		{`{"series_id": "one"}`, "series_id must be an integer"},
+		{`["title"]`, "request body must be a JSON object"},
+	}
+	for _, tt := range tests {
+		w, resp := bindRequest(t, tt.body, &models.CreateBlogRequest{})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", tt.body, w.Code)
+			continue
+		}
+		if resp.Code != CodeInvalidRequest || !reflect.DeepEqual(resp.Details, []string{tt.detail}) {
+			t.Errorf("%s: code %q, details %q; want %s, [%q]", tt.body, resp.Code, resp.Details, CodeInvalidRequest, tt.detail)
+		}
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, want := range map[string]string{
+		"Title":            "title",
+		"MetaDesc":         "meta_desc",
+		"FeaturedImageAlt": "featured_image_alt",
+		"ID":               "id",
+		"Step2Name":        "step2_name",
+	} {
+		if got := snakeCase(name); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestValidationErrorsUseJSONFieldNames(t *testing.T) {
+	type request struct {
+		MetaDesc  string `json:"meta_description" validate:"max=3"`
+		Untagged  string `validate:"required"`
+		OmitEmpty string `json:"summary,omitempty" validate:"required"`
+	}
+	_, resp := bindRequest(t, `{"meta_description": "too long"}`, &request{})
+	want := []string{
+		"meta_description must be at most 3 characters",
+		"untagged is required",
+		"summary is required",
+	}
+	if !reflect.DeepEqual(resp.Details, want) {
+		t.Errorf("details = %q, want %q", resp.Details, want)
+	}
+}
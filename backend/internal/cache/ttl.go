@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// entry is a cached value with its expiry
+type entry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// TTLCache is a concurrency-safe in-memory cache whose entries expire after a fixed duration
+type TTLCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[string]entry
+	now     func() time.Time
+}
+
+// NewTTLCache creates a cache holding entries for ttl. A non-positive ttl disables caching.
+func NewTTLCache(ttl time.Duration) *TTLCache {
+	return &TTLCache{
+		ttl:     ttl,
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// Enabled reports whether the cache stores anything
+func (c *TTLCache) Enabled() bool {
+	return c != nil && c.ttl > 0
+}
+
+// Get returns the unexpired value stored under key
+func (c *TTLCache) Get(key string) (interface{}, bool) {
+	if !c.Enabled() {
+		return nil, false
+	}
+
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok || !c.now().Before(e.expiresAt) {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Set stores value under key, evicting expired entries
+func (c *TTLCache) Set(key string, value interface{}) {
+	if !c.Enabled() {
+		return
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = entry{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+// Purge removes every entry
+func (c *TTLCache) Purge() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.entries = make(map[string]entry)
+	c.mu.Unlock()
+}
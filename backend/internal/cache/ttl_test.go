@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTTLCacheExpiry(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := NewTTLCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	if _, ok := c.Get("key"); ok {
+		t.Fatal("empty cache reported a hit")
+	}
+	c.Set("key", "value")
+	if value, ok := c.Get("key"); !ok || value != "value" {
+		t.Fatalf("Get = %v, %v; want value, true", value, ok)
+	}
+
+	now = now.Add(59 * time.Second)
+	if _, ok := c.Get("key"); !ok {
+		t.Error("entry expired before its TTL")
+	}
+	now = now.Add(time.Second)
+	if _, ok := c.Get("key"); ok {
+		t.Error("entry outlived its TTL")
+	}
+
+	// Setting evicts expired entries
+	c.Set("other", 1)
+	if len(c.entries) != 1 {
+		t.Errorf("%d entries after eviction, want 1", len(c.entries))
+	}
+
+	c.Purge()
+	if _, ok := c.Get("other"); ok {
+		t.Error("entry survived a purge")
+	}
+}
+
+func TestTTLCacheDisabled(t *testing.T) {
+	for _, c := range []*TTLCache{NewTTLCache(0), NewTTLCache(-time.Second), nil} {
+		c.Set("key", "value")
+		if _, ok := c.Get("key"); ok || c.Enabled() {
+			t.Errorf("disabled cache %v stored a value", c)
+		}
+		c.Purge()
+	}
+}
+
+func TestTTLCacheConcurrentUse(t *testing.T) {
+	c := NewTTLCache(time.Minute)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := strconv.Itoa(i % 5)
+			for j := 0; j < 100; j++ {
+				c.Set(key, j)
+				c.Get(key)
+				if j%25 == 0 {
+					c.Purge()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
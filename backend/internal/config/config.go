@@ -0,0 +1,419 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"technoprise-blog-backend/internal/mailer"
+	"technoprise-blog-backend/internal/middleware"
+	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/preview"
+	"technoprise-blog-backend/internal/webhooks"
+)
+
+// Defaults for settings that have no natural home in another package
+const (
+	DefaultPort            = "8080"
+	DefaultMaxRevisions    = 20
+	DefaultListCacheTTL    = 60 * time.Second
+	DefaultCountCacheTTL   = 30 * time.Second
+	DefaultCSPReportLimit  = 60
+	DefaultSearchRateLimit = 30
+	DefaultSubscribeLimit  = 10
+)
+
+// DefaultCORSOrigins are the frontend origins allowed when CORS_ALLOW_ORIGINS is unset
+var DefaultCORSOrigins = []string{
+	"http://localhost:4200",
+	"https://localhost:4200",
+	"http://localhost:4201",
+	"http://localhost:4202",
+	"http://localhost:4203",
+	"http://127.0.0.1:4200",
+	"http://127.0.0.1:39623", // Browser preview proxy
+}
+
+// DefaultTrustedProxies are the proxies whose X-Forwarded-For is honored when TRUSTED_PROXIES is unset
+var DefaultTrustedProxies = []string{"127.0.0.1/8", "::1/128"}
+
+// Config is every setting read from the environment at startup
+type Config struct {
+	Server     Server
+	Database   Database
+	CORS       CORS
+	Security   Security
+	RateLimits RateLimits
+	Content    Content
+	Blog       Blog
+	Webhooks   Webhooks
+	Mail       mailer.Config
+}
+
+// Server configures the HTTP server and its middleware
+type Server struct {
+	Port           string        // PORT
+	Release        bool          // GIN_MODE=release
+	TrustedProxies []string      // TRUSTED_PROXIES; empty trusts none
+	LogSkipPaths   []string      // LOG_SKIP_PATHS; empty logs every request
+	RequestTimeout time.Duration // REQUEST_TIMEOUT; zero disables it
+	MaxBodyBytes   int64         // MAX_BODY_BYTES
+	EnableSwagger  bool          // ENABLE_SWAGGER, ignored in release mode
+}
+
+// Database configures the connection and seeding
+type Database struct {
+	Host         string
+	Port         string
+	User         string
+	Password     string
+	Name         string
+	SSLMode      string
+	LogQueries   bool // On outside release mode
+	Seed         bool // SEED_DATABASE, defaulting to on outside release mode
+	SeedExplicit bool // Whether SEED_DATABASE was set
+}
+
+// CORS configures cross-origin requests from the frontend
+type CORS struct {
+	AllowOrigins []string // CORS_ALLOW_ORIGINS
+}
+
+// Security configures authentication, preview tokens and response headers
+type Security struct {
+	APIKey             string
+	Headers            middleware.SecurityHeaderConfig
+	CSPReportStore     bool
+	PreviewTokenSecret string
+	PreviewTokenTTL    time.Duration
+}
+
+// RateLimits are per-IP requests allowed per minute; zero disables a limit
+type RateLimits struct {
+	CSPReports int
+	Search     int
+	Subscribe  int
+}
+
+// Content configures how post content is processed. Zero sizes and rates keep
+// the defaults of the package applying them.
+type Content struct {
+	ReadingWPM        int
+	SpeakingWPM       int
+	SlugMaxLength     int
+	DefaultPageSize   int
+	MaxPageSize       int
+	SanitizerPolicy   string // Path to a JSON policy file
+	TagSynonyms       string // Path to a JSON synonyms file
+	ContentScan       bool
+	ContentScanConfig string // Path to a JSON scanner config file
+}
+
+// Blog configures the blog handlers
+type Blog struct {
+	SiteURL               string // Absolute public site URL, without a trailing slash
+	APIKey                string
+	MaxRevisions          int // Zero keeps every revision
+	MaxFeatured           int // Zero means unlimited
+	ListCacheTTL          time.Duration
+	CountCacheTTL         time.Duration
+	StrictFields          bool
+	StrictPagination      bool
+	SimilarTitleThreshold float64
+	ExcerptLength         int
+	ExcerptBoundary       models.ExcerptBoundary
+	CommentMaxDepth       int
+	ValidateImageURLs     bool
+	DefaultPublished      bool
+	SeedDatabase          bool // Mirrors Database.Seed for the seed status report
+}
+
+// Webhooks configures outgoing event notifications
+type Webhooks struct {
+	URLs   []string
+	Secret string
+}
+
+// Error lists every invalid setting found while loading
+type Error struct {
+	Problems []string
+}
+
+func (e *Error) Error() string {
+	return "invalid configuration: " + strings.Join(e.Problems, "; ")
+}
+
+// Load reads and validates the configuration from the process environment
+func Load() (*Config, error) {
+	return LoadFrom(os.LookupEnv)
+}
+
+// LoadFrom reads and validates the configuration using lookup, returning an
+// *Error listing every invalid setting
+func LoadFrom(lookup func(string) (string, bool)) (*Config, error) {
+	e := &env{lookup: lookup}
+	release := e.string("GIN_MODE", "") == "release"
+
+	cfg := &Config{
+		Server: Server{
+			Port:           e.port("PORT", DefaultPort),
+			Release:        release,
+			TrustedProxies: e.list("TRUSTED_PROXIES", DefaultTrustedProxies),
+			LogSkipPaths:   e.list("LOG_SKIP_PATHS", middleware.DefaultLogSkipPaths),
+			RequestTimeout: e.duration("REQUEST_TIMEOUT", middleware.DefaultRequestTimeout),
+			MaxBodyBytes:   int64(e.integer("MAX_BODY_BYTES", int(middleware.DefaultMaxBodyBytes), 1)),
+			EnableSwagger:  e.boolean("ENABLE_SWAGGER", false),
+		},
+		Database: Database{
+			Host:       e.string("DB_HOST", "localhost"),
+			Port:       e.string("DB_PORT", "5432"),
+			User:       e.string("DB_USER", "postgres"),
+			Password:   e.string("DB_PASSWORD", "password"),
+			Name:       e.string("DB_NAME", "technoprise_blog"),
+			SSLMode:    e.string("DB_SSLMODE", "disable"),
+			LogQueries: !release,
+		},
+		CORS: CORS{
+			AllowOrigins: e.origins("CORS_ALLOW_ORIGINS", DefaultCORSOrigins),
+		},
+		Security: Security{
+			APIKey:             e.string("API_KEY", ""),
+			Headers:            e.securityHeaders(),
+			CSPReportStore:     e.boolean("CSP_REPORT_STORE", false),
+			PreviewTokenSecret: e.string("PREVIEW_TOKEN_SECRET", ""),
+			PreviewTokenTTL:    e.duration("PREVIEW_TOKEN_TTL", preview.DefaultTTL),
+		},
+		RateLimits: RateLimits{
+			CSPReports: e.integer("CSP_REPORT_RATE_LIMIT", DefaultCSPReportLimit, 0),
+			Search:     e.integer("SEARCH_RATE_LIMIT", DefaultSearchRateLimit, 0),
+			Subscribe:  e.integer("SUBSCRIBE_RATE_LIMIT", DefaultSubscribeLimit, 0),
+		},
+		Content: Content{
+			ReadingWPM:        e.integer("READING_WPM", 0, 1),
+			SpeakingWPM:       e.integer("SPEAKING_WPM", 0, 1),
+			SlugMaxLength:     e.integer("SLUG_MAX_LENGTH", 0, 1),
+			DefaultPageSize:   e.integer("DEFAULT_PAGE_SIZE", 0, 1),
+			MaxPageSize:       e.integer("MAX_PAGE_SIZE", 0, 1),
+			SanitizerPolicy:   e.string("SANITIZER_POLICY", ""),
+			TagSynonyms:       e.string("TAG_SYNONYMS", ""),
+			ContentScan:       e.boolean("CONTENT_SCAN", false),
+			ContentScanConfig: e.string("CONTENT_SCAN_CONFIG", ""),
+		},
+		Blog: Blog{
+			SiteURL:               e.siteURL(release),
+			APIKey:                e.string("API_KEY", ""),
+			MaxRevisions:          e.integer("MAX_REVISIONS", DefaultMaxRevisions, 0),
+			MaxFeatured:           e.integer("MAX_FEATURED", 0, 0),
+			ListCacheTTL:          e.duration("LIST_CACHE_TTL", DefaultListCacheTTL),
+			CountCacheTTL:         e.duration("COUNT_CACHE_TTL", DefaultCountCacheTTL),
+			StrictFields:          e.boolean("STRICT_FIELDS", false),
+			StrictPagination:      e.boolean("STRICT_PAGINATION", false),
+			SimilarTitleThreshold: e.fraction("SIMILAR_TITLE_THRESHOLD", models.DefaultSimilarTitleThreshold),
+			ExcerptLength:         models.ClampExcerptLength(e.integer("EXCERPT_LENGTH", models.DefaultExcerptLength, 1)),
+			ExcerptBoundary:       e.excerptBoundary("EXCERPT_BOUNDARY"),
+			CommentMaxDepth:       e.integer("COMMENT_MAX_DEPTH", models.DefaultCommentMaxDepth, 0),
+			ValidateImageURLs:     e.boolean("VALIDATE_IMAGE_URLS", false),
+			DefaultPublished:      e.boolean("DEFAULT_PUBLISHED", false),
+		},
+		Webhooks: Webhooks{
+			URLs:   webhooks.ParseURLs(e.string("WEBHOOK_URLS", "")),
+			Secret: e.string("WEBHOOK_SECRET", ""),
+		},
+		Mail: mailer.Config{
+			Host:     e.string("SMTP_HOST", ""),
+			Port:     e.string("SMTP_PORT", ""),
+			Username: e.string("SMTP_USERNAME", ""),
+			Password: e.string("SMTP_PASSWORD", ""),
+			From:     e.string("SMTP_FROM", ""),
+		},
+	}
+
+	cfg.Database.Seed = e.boolean("SEED_DATABASE", !release)
+	cfg.Database.SeedExplicit = e.string("SEED_DATABASE", "") != ""
+	cfg.Blog.SeedDatabase = cfg.Database.Seed
+
+	if len(e.problems) > 0 {
+		return nil, &Error{Problems: e.problems}
+	}
+	return cfg, nil
+}
+
+// env reads settings through lookup, collecting a problem for each invalid value
+type env struct {
+	lookup   func(string) (string, bool)
+	problems []string
+}
+
+// invalid records a problem with the setting key
+func (e *env) invalid(key, format string, args ...interface{}) {
+	e.problems = append(e.problems, key+" "+fmt.Sprintf(format, args...))
+}
+
+// string returns the trimmed value of key, or fallback when it is unset or blank
+func (e *env) string(key, fallback string) string {
+	if value, _ := e.lookup(key); strings.TrimSpace(value) != "" {
+		return strings.TrimSpace(value)
+	}
+	return fallback
+}
+
+// integer parses key as an integer of at least min
+func (e *env) integer(key string, fallback, min int) int {
+	raw := e.string(key, "")
+	if raw == "" {
+		return fallback
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < min {
+		e.invalid(key, "must be an integer of at least %d, got %q", min, raw)
+		return fallback
+	}
+	return value
+}
+
+// fraction parses key as a number from 0 to 1
+func (e *env) fraction(key string, fallback float64) float64 {
+	raw := e.string(key, "")
+	if raw == "" {
+		return fallback
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || value < 0 || value > 1 {
+		e.invalid(key, "must be a number from 0 to 1, got %q", raw)
+		return fallback
+	}
+	return value
+}
+
+// boolean parses key as true or false
+func (e *env) boolean(key string, fallback bool) bool {
+	raw := e.string(key, "")
+	if raw == "" {
+		return fallback
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		e.invalid(key, "must be true or false, got %q", raw)
+		return fallback
+	}
+	return value
+}
+
+// duration parses key as a non-negative Go duration such as 30s
+func (e *env) duration(key string, fallback time.Duration) time.Duration {
+	raw := e.string(key, "")
+	if raw == "" {
+		return fallback
+	}
+	value, err := time.ParseDuration(raw)
+	if err != nil || value < 0 {
+		e.invalid(key, "must be a non-negative duration such as 30s, got %q", raw)
+		return fallback
+	}
+	return value
+}
+
+// port parses key as a TCP port number
+func (e *env) port(key, fallback string) string {
+	raw := e.string(key, fallback)
+	if value, err := strconv.Atoi(raw); err != nil || value < 1 || value > 65535 {
+		e.invalid(key, "must be a port from 1 to 65535, got %q", raw)
+		return fallback
+	}
+	return raw
+}
+
+// list splits key on commas. A key set to an empty value yields an empty list
+// rather than fallback.
+func (e *env) list(key string, fallback []string) []string {
+	value, ok := e.lookup(key)
+	if !ok {
+		return fallback
+	}
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// origins parses key as a list of absolute http or https origins
+func (e *env) origins(key string, fallback []string) []string {
+	if e.string(key, "") == "" {
+		return fallback
+	}
+	origins := e.list(key, fallback)
+	for _, origin := range origins {
+		parsed, err := url.Parse(origin)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || strings.Trim(parsed.Path, "/") != "" {
+			e.invalid(key, "must list origins such as https://blog.example.com, got %q", origin)
+		}
+	}
+	return origins
+}
+
+// siteURL reads SITE_URL, which falls back to models.DefaultSiteURL in
+// development but must be set in release mode
+func (e *env) siteURL(release bool) string {
+	raw := e.string("SITE_URL", "")
+	if raw == "" {
+		if release {
+			e.invalid("SITE_URL", "must be set when GIN_MODE=release")
+		}
+		return models.DefaultSiteURL
+	}
+	siteURL, err := models.ParseSiteURL(raw)
+	if err != nil {
+		e.invalid("SITE_URL", "%v, got %q", err, raw)
+		return models.DefaultSiteURL
+	}
+	return siteURL
+}
+
+// excerptBoundary parses key as an excerpt boundary, defaulting to word
+func (e *env) excerptBoundary(key string) models.ExcerptBoundary {
+	raw := e.string(key, "")
+	if raw == "" {
+		return models.ExcerptBoundaryWord
+	}
+	boundary, ok := models.ParseExcerptBoundary(raw)
+	if !ok {
+		e.invalid(key, "must be %q or %q, got %q", models.ExcerptBoundaryWord, models.ExcerptBoundarySentence, raw)
+	}
+	return boundary
+}
+
+// securityHeaders applies the header overrides to the defaults; "off" disables
+// a header
+func (e *env) securityHeaders() middleware.SecurityHeaderConfig {
+	headers := middleware.DefaultSecurityHeaderConfig()
+	if value, ok := e.lookup("CONTENT_SECURITY_POLICY"); ok {
+		if strings.TrimSpace(value) == "" {
+			e.invalid("CONTENT_SECURITY_POLICY", "is set but empty")
+		} else {
+			headers.CSP = strings.TrimSpace(value)
+		}
+	}
+	headers.CSPReportOnly = e.boolean("CSP_REPORT_ONLY", false)
+	headers.FrameOptions = e.string("X_FRAME_OPTIONS", headers.FrameOptions)
+	headers.ReferrerPolicy = e.string("REFERRER_POLICY", headers.ReferrerPolicy)
+	if value := e.string("HSTS_MAX_AGE", ""); value == middleware.HeaderDisabled {
+		headers.HSTSMaxAge = middleware.HSTSDisabled
+	} else if value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 0 {
+			e.invalid("HSTS_MAX_AGE", "must be a non-negative number of seconds or off, got %q", value)
+		} else {
+			headers.HSTSMaxAge = seconds
+		}
+	}
+	if err := headers.Validate(); err != nil {
+		e.problems = append(e.problems, "security headers: "+err.Error())
+	}
+	return headers
+}
@@ -0,0 +1,562 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/middleware"
+	"technoprise-blog-backend/internal/models"
+)
+
+// loadEnv loads the configuration from the given variables only
+func loadEnv(vars map[string]string) (*Config, error) {
+	return LoadFrom(func(key string) (string, bool) {
+		value, ok := vars[key]
+		return value, ok
+	})
+}
+
+// expectProblem fails the test unless err reports a problem with key
+func expectProblem(t *testing.T, err error, key string) {
+	t.Helper()
+	configErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("error = %v, want a *config.Error mentioning %s", err, key)
+	}
+	for _, problem := range configErr.Problems {
+		if strings.Contains(problem, key) {
+			return
+		}
+	}
+	t.Errorf("problems %q do not mention %s", configErr.Problems, key)
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := loadEnv(nil)
+	if err != nil {
+		t.Fatalf("load defaults: %v", err)
+	}
+
+	if cfg.Server.Port != DefaultPort || cfg.Server.Release || cfg.Server.RequestTimeout != middleware.DefaultRequestTimeout ||
+		cfg.Server.MaxBodyBytes != middleware.DefaultMaxBodyBytes {
+		t.Errorf("server = %+v", cfg.Server)
+	}
+	wantDB := Database{Host: "localhost", Port: "5432", User: "postgres", Password: "password", Name: "technoprise_blog",
+		SSLMode: "disable", LogQueries: true, Seed: true}
+	if cfg.Database != wantDB {
+		t.Errorf("database = %+v, want %+v", cfg.Database, wantDB)
+	}
+	if !reflect.DeepEqual(cfg.CORS.AllowOrigins, DefaultCORSOrigins) {
+		t.Errorf("CORS origins = %q, want the defaults", cfg.CORS.AllowOrigins)
+	}
+	wantLimits := RateLimits{CSPReports: DefaultCSPReportLimit, Search: DefaultSearchRateLimit, Subscribe: DefaultSubscribeLimit}
+	if cfg.RateLimits != wantLimits {
+		t.Errorf("rate limits = %+v, want %+v", cfg.RateLimits, wantLimits)
+	}
+	if cfg.Blog.MaxRevisions != DefaultMaxRevisions || cfg.Blog.ListCacheTTL != DefaultListCacheTTL || cfg.Blog.APIKey != "" ||
+		cfg.Security.APIKey != "" || cfg.Blog.SimilarTitleThreshold != models.DefaultSimilarTitleThreshold {
+		t.Errorf("blog = %+v", cfg.Blog)
+	}
+	if len(cfg.Webhooks.URLs) != 0 || cfg.Mail.Host != "" {
+		t.Errorf("webhooks %+v and mail %+v, want neither configured", cfg.Webhooks, cfg.Mail)
+	}
+}
+
+func TestLoadReleaseMode(t *testing.T) {
+	cfg, err := loadEnv(map[string]string{"GIN_MODE": "release", "SITE_URL": "https://blog.example.com"})
+	if err != nil {
+		t.Fatalf("load release config: %v", err)
+	}
+	if !cfg.Server.Release || cfg.Database.LogQueries || cfg.Database.Seed {
+		t.Errorf("release config: Release %v, LogQueries %v, Seed %v; want release without query logs or seeding",
+			cfg.Server.Release, cfg.Database.LogQueries, cfg.Database.Seed)
+	}
+}
+
+func TestLoadSettings(t *testing.T) {
+	cfg, err := loadEnv(map[string]string{
+		"PORT":                    "9090",
+		"DB_HOST":                 " db.internal ",
+		"DB_NAME":                 "blog",
+		"CORS_ALLOW_ORIGINS":      "https://blog.example.com, https://admin.example.com/",
+		"SEARCH_RATE_LIMIT":       "0",
+		"SUBSCRIBE_RATE_LIMIT":    "3",
+		"API_KEY":                 "secret",
+		"MAX_REVISIONS":           "5",
+		"REQUEST_TIMEOUT":         "2s",
+		"WEBHOOK_URLS":            "https://hooks.example.com/a,https://hooks.example.com/b",
+		"SIMILAR_TITLE_THRESHOLD": "0.5",
+	})
+	if err != nil {
+		t.Fatalf("load settings: %v", err)
+	}
+	if cfg.Server.Port != "9090" || cfg.Database.Host != "db.internal" || cfg.Database.Name != "blog" {
+		t.Errorf("server port %q, database %+v", cfg.Server.Port, cfg.Database)
+	}
+	if want := []string{"https://blog.example.com", "https://admin.example.com/"}; !reflect.DeepEqual(cfg.CORS.AllowOrigins, want) {
+		t.Errorf("CORS origins = %q, want %q", cfg.CORS.AllowOrigins, want)
+	}
+	if cfg.RateLimits.Search != 0 || cfg.RateLimits.Subscribe != 3 || cfg.RateLimits.CSPReports != DefaultCSPReportLimit {
+		t.Errorf("rate limits = %+v", cfg.RateLimits)
+	}
+	if cfg.Security.APIKey != "secret" || cfg.Blog.APIKey != "secret" || cfg.Blog.MaxRevisions != 5 ||
+		cfg.Server.RequestTimeout != 2*time.Second || len(cfg.Webhooks.URLs) != 2 || cfg.Blog.SimilarTitleThreshold != 0.5 {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestLoadReportsEveryProblem(t *testing.T) {
+	_, err := loadEnv(map[string]string{
+		"PORT":                    "99999",
+		"CORS_ALLOW_ORIGINS":      "blog.example.com",
+		"SEARCH_RATE_LIMIT":       "-1",
+		"MAX_REVISIONS":           "many",
+		"REQUEST_TIMEOUT":         "forever",
+		"STRICT_FIELDS":           "sometimes",
+		"SIMILAR_TITLE_THRESHOLD": "1.5",
+	})
+	for _, key := range []string{"PORT", "CORS_ALLOW_ORIGINS", "SEARCH_RATE_LIMIT", "MAX_REVISIONS", "REQUEST_TIMEOUT", "STRICT_FIELDS", "SIMILAR_TITLE_THRESHOLD"} {
+		expectProblem(t, err, key)
+	}
+	if configErr, ok := err.(*Error); ok && len(configErr.Problems) != 7 {
+		t.Errorf("problems = %q, want 7", configErr.Problems)
+	}
+	if err != nil && !strings.HasPrefix(err.Error(), "invalid configuration: PORT ") {
+		t.Errorf("error = %q, want it to start with the first problem", err.Error())
+	}
+
+	for _, port := range []string{"0", "http", "65536"} {
+		_, err := loadEnv(map[string]string{"PORT": port})
+		expectProblem(t, err, "PORT")
+	}
+	for _, origins := range []string{"ftp://blog.example.com", "https://", "https://blog.example.com/app"} {
+		_, err := loadEnv(map[string]string{"CORS_ALLOW_ORIGINS": origins})
+		expectProblem(t, err, "CORS_ALLOW_ORIGINS")
+	}
+}
+
+func TestLoadReadsProcessEnvironment(t *testing.T) {
+	t.Setenv("PORT", "7070")
+	t.Setenv("DB_NAME", "from_env")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Server.Port != "7070" || cfg.Database.Name != "from_env" {
+		t.Errorf("port %q and database %q, want the process environment's", cfg.Server.Port, cfg.Database.Name)
+	}
+}
+
+func TestContentSecurityPolicySettings(t *testing.T) {
+	cfg, err := loadEnv(nil)
+	if err != nil {
+		t.Fatalf("load defaults: %v", err)
+	}
+	if cfg.Security.Headers.CSP != middleware.DefaultContentSecurityPolicy || cfg.Security.Headers.CSPReportOnly {
+		t.Errorf("default CSP = %q report-only %v", cfg.Security.Headers.CSP, cfg.Security.Headers.CSPReportOnly)
+	}
+
+	cfg, err = loadEnv(map[string]string{
+		"CONTENT_SECURITY_POLICY": " default-src 'self' ",
+		"CSP_REPORT_ONLY":         "true",
+	})
+	if err != nil {
+		t.Fatalf("load custom CSP: %v", err)
+	}
+	if cfg.Security.Headers.CSP != "default-src 'self'" || !cfg.Security.Headers.CSPReportOnly {
+		t.Errorf("custom CSP = %q report-only %v", cfg.Security.Headers.CSP, cfg.Security.Headers.CSPReportOnly)
+	}
+
+	_, err = loadEnv(map[string]string{"CONTENT_SECURITY_POLICY": "  "})
+	expectProblem(t, err, "CONTENT_SECURITY_POLICY")
+	_, err = loadEnv(map[string]string{"CSP_REPORT_ONLY": "sometimes"})
+	expectProblem(t, err, "CSP_REPORT_ONLY")
+}
+
+func TestReadingSpeedSetting(t *testing.T) {
+	cfg, err := loadEnv(map[string]string{"READING_WPM": "250"})
+	if err != nil {
+		t.Fatalf("load READING_WPM: %v", err)
+	}
+	if cfg.Content.ReadingWPM != 250 {
+		t.Errorf("ReadingWPM = %d, want 250", cfg.Content.ReadingWPM)
+	}
+
+	for _, raw := range []string{"0", "-100", "fast", "2.5"} {
+		_, err := loadEnv(map[string]string{"READING_WPM": raw})
+		expectProblem(t, err, "READING_WPM")
+	}
+}
+
+func TestDefaultPublishedSetting(t *testing.T) {
+	for raw, want := range map[string]bool{"": false, "true": true, "false": false, "1": true} {
+		cfg, err := loadEnv(map[string]string{"DEFAULT_PUBLISHED": raw})
+		if err != nil {
+			t.Fatalf("load DEFAULT_PUBLISHED=%q: %v", raw, err)
+		}
+		if cfg.Blog.DefaultPublished != want {
+			t.Errorf("DEFAULT_PUBLISHED=%q: DefaultPublished = %v, want %v", raw, cfg.Blog.DefaultPublished, want)
+		}
+	}
+	_, err := loadEnv(map[string]string{"DEFAULT_PUBLISHED": "usually"})
+	expectProblem(t, err, "DEFAULT_PUBLISHED")
+}
+
+func TestExcerptLengthSetting(t *testing.T) {
+	for raw, want := range map[string]int{"": models.DefaultExcerptLength, "120": 120, "500": 500, "5000": models.ExcerptMaxLength} {
+		cfg, err := loadEnv(map[string]string{"EXCERPT_LENGTH": raw})
+		if err != nil {
+			t.Fatalf("load EXCERPT_LENGTH=%q: %v", raw, err)
+		}
+		if cfg.Blog.ExcerptLength != want {
+			t.Errorf("EXCERPT_LENGTH=%q: ExcerptLength = %d, want %d", raw, cfg.Blog.ExcerptLength, want)
+		}
+	}
+	for _, raw := range []string{"0", "-1", "long"} {
+		_, err := loadEnv(map[string]string{"EXCERPT_LENGTH": raw})
+		expectProblem(t, err, "EXCERPT_LENGTH")
+	}
+}
+
+func TestExcerptBoundarySetting(t *testing.T) {
+	for raw, want := range map[string]models.ExcerptBoundary{"": models.ExcerptBoundaryWord, "word": models.ExcerptBoundaryWord, "Sentence": models.ExcerptBoundarySentence} {
+		cfg, err := loadEnv(map[string]string{"EXCERPT_BOUNDARY": raw})
+		if err != nil {
+			t.Fatalf("load EXCERPT_BOUNDARY=%q: %v", raw, err)
+		}
+		if cfg.Blog.ExcerptBoundary != want {
+			t.Errorf("EXCERPT_BOUNDARY=%q: ExcerptBoundary = %q, want %q", raw, cfg.Blog.ExcerptBoundary, want)
+		}
+	}
+	_, err := loadEnv(map[string]string{"EXCERPT_BOUNDARY": "paragraph"})
+	expectProblem(t, err, "EXCERPT_BOUNDARY")
+}
+
+func TestTrustedProxiesSetting(t *testing.T) {
+	tests := []struct {
+		vars map[string]string
+		want []string
+	}{
+		{nil, DefaultTrustedProxies},
+		{map[string]string{"TRUSTED_PROXIES": " 10.0.0.0/8 , 192.168.1.10,, "}, []string{"10.0.0.0/8", "192.168.1.10"}},
+		{map[string]string{"TRUSTED_PROXIES": ""}, []string{}},
+	}
+	for _, tt := range tests {
+		cfg, err := loadEnv(tt.vars)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.vars, err)
+		}
+		if !reflect.DeepEqual(cfg.Server.TrustedProxies, tt.want) {
+			t.Errorf("%v: trusted proxies = %q, want %q", tt.vars, cfg.Server.TrustedProxies, tt.want)
+		}
+	}
+}
+
+func TestTrustedProxiesResolveClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		proxies    string // TRUSTED_PROXIES, unset when "default"
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		// The loopback default honors a local reverse proxy only
+		{"default", "127.0.0.1:4000", "203.0.113.7", "203.0.113.7"},
+		{"default", "[::1]:4000", "203.0.113.7", "203.0.113.7"},
+		{"default", "198.51.100.20:4000", "203.0.113.7", "198.51.100.20"},
+		{"default", "127.0.0.1:4000", "", "127.0.0.1"},
+		// Spoofed entries added before a trusted proxy are skipped
+		{"10.0.0.0/8", "10.1.2.3:4000", "1.2.3.4, 203.0.113.7, 10.9.9.9", "203.0.113.7"},
+		{"10.0.0.0/8", "127.0.0.1:4000", "203.0.113.7", "127.0.0.1"},
+		// With no trusted proxies X-Forwarded-For is ignored entirely
+		{"", "127.0.0.1:4000", "203.0.113.7", "127.0.0.1"},
+	}
+	for _, tt := range tests {
+		vars := map[string]string{}
+		if tt.proxies != "default" {
+			vars["TRUSTED_PROXIES"] = tt.proxies
+		}
+		cfg, err := loadEnv(vars)
+		if err != nil {
+			t.Fatalf("load config: %v", err)
+		}
+		router := gin.New()
+		if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+			t.Fatalf("%q: set trusted proxies: %v", tt.proxies, err)
+		}
+		router.GET("/", func(c *gin.Context) { c.String(http.StatusOK, c.ClientIP()) })
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = tt.remoteAddr
+		if tt.forwarded != "" {
+			r.Header.Set("X-Forwarded-For", tt.forwarded)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		if got := w.Body.String(); got != tt.want {
+			t.Errorf("proxies %q, remote %s, X-Forwarded-For %q: client IP = %s, want %s", tt.proxies, tt.remoteAddr, tt.forwarded, got, tt.want)
+		}
+	}
+
+	router := gin.New()
+	if err := router.SetTrustedProxies([]string{"not-a-cidr"}); err == nil {
+		t.Error("SetTrustedProxies accepted an invalid entry")
+	}
+}
+
+func TestPageSizeSettings(t *testing.T) {
+	cfg, err := loadEnv(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Content.DefaultPageSize != 0 || cfg.Content.MaxPageSize != 0 {
+		t.Errorf("unset page sizes = %d, %d; want 0, 0 to keep the handler defaults", cfg.Content.DefaultPageSize, cfg.Content.MaxPageSize)
+	}
+
+	cfg, err = loadEnv(map[string]string{"DEFAULT_PAGE_SIZE": "25", "MAX_PAGE_SIZE": "50"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Content.DefaultPageSize != 25 || cfg.Content.MaxPageSize != 50 {
+		t.Errorf("page sizes = %d, %d; want 25, 50", cfg.Content.DefaultPageSize, cfg.Content.MaxPageSize)
+	}
+
+	for _, key := range []string{"DEFAULT_PAGE_SIZE", "MAX_PAGE_SIZE"} {
+		for _, raw := range []string{"0", "-5", "ten"} {
+			_, err := loadEnv(map[string]string{key: raw})
+			expectProblem(t, err, key)
+		}
+	}
+}
+
+func TestSecurityHeaderSettings(t *testing.T) {
+	cfg, err := loadEnv(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cfg.Security.Headers, middleware.DefaultSecurityHeaderConfig(); got != want {
+		t.Errorf("default headers = %+v, want %+v", got, want)
+	}
+
+	cfg, err = loadEnv(map[string]string{"X_FRAME_OPTIONS": "SAMEORIGIN", "REFERRER_POLICY": "same-origin", "HSTS_MAX_AGE": "86400"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers := cfg.Security.Headers
+	if headers.FrameOptions != "SAMEORIGIN" || headers.ReferrerPolicy != "same-origin" || headers.HSTSMaxAge != 86400 {
+		t.Errorf("overridden headers = %+v", headers)
+	}
+
+	cfg, err = loadEnv(map[string]string{"X_FRAME_OPTIONS": "off", "REFERRER_POLICY": "off", "HSTS_MAX_AGE": "off"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers = cfg.Security.Headers
+	if headers.FrameOptions != middleware.HeaderDisabled || headers.ReferrerPolicy != middleware.HeaderDisabled || headers.HSTSMaxAge != middleware.HSTSDisabled {
+		t.Errorf("disabled headers = %+v", headers)
+	}
+
+	for key, problem := range map[[2]string]string{
+		{"X_FRAME_OPTIONS", "ALLOWALL"}:  "X-Frame-Options",
+		{"REFERRER_POLICY", "sometimes"}: "Referrer-Policy",
+		{"HSTS_MAX_AGE", "-1"}:           "HSTS_MAX_AGE",
+		{"HSTS_MAX_AGE", "a year"}:       "HSTS_MAX_AGE",
+	} {
+		_, err := loadEnv(map[string]string{key[0]: key[1]})
+		expectProblem(t, err, problem)
+	}
+}
+
+func TestCountCacheTTLSetting(t *testing.T) {
+	cfg, err := loadEnv(nil)
+	if err != nil {
+		t.Fatalf("load defaults: %v", err)
+	}
+	if cfg.Blog.CountCacheTTL != DefaultCountCacheTTL {
+		t.Errorf("default CountCacheTTL = %v, want %v", cfg.Blog.CountCacheTTL, DefaultCountCacheTTL)
+	}
+
+	for raw, want := range map[string]time.Duration{"5s": 5 * time.Second, "0": 0} {
+		cfg, err := loadEnv(map[string]string{"COUNT_CACHE_TTL": raw})
+		if err != nil {
+			t.Fatalf("load COUNT_CACHE_TTL=%s: %v", raw, err)
+		}
+		if cfg.Blog.CountCacheTTL != want {
+			t.Errorf("COUNT_CACHE_TTL=%s: CountCacheTTL = %v, want %v", raw, cfg.Blog.CountCacheTTL, want)
+		}
+	}
+
+	for _, raw := range []string{"-1s", "soon", "30"} {
+		_, err := loadEnv(map[string]string{"COUNT_CACHE_TTL": raw})
+		expectProblem(t, err, "COUNT_CACHE_TTL")
+	}
+}
+
+func TestEnableSwaggerSetting(t *testing.T) {
+	cfg, err := loadEnv(nil)
+	if err != nil {
+		t.Fatalf("load defaults: %v", err)
+	}
+	if cfg.Server.EnableSwagger {
+		t.Error("Swagger enabled by default")
+	}
+
+	cfg, err = loadEnv(map[string]string{"ENABLE_SWAGGER": "true"})
+	if err != nil {
+		t.Fatalf("load ENABLE_SWAGGER: %v", err)
+	}
+	if !cfg.Server.EnableSwagger {
+		t.Error("ENABLE_SWAGGER=true did not enable Swagger")
+	}
+
+	_, err = loadEnv(map[string]string{"ENABLE_SWAGGER": "sometimes"})
+	expectProblem(t, err, "ENABLE_SWAGGER")
+}
+
+func TestSlugMaxLengthSetting(t *testing.T) {
+	cfg, err := loadEnv(map[string]string{"SLUG_MAX_LENGTH": "60"})
+	if err != nil {
+		t.Fatalf("load SLUG_MAX_LENGTH: %v", err)
+	}
+	if cfg.Content.SlugMaxLength != 60 {
+		t.Errorf("SlugMaxLength = %d, want 60", cfg.Content.SlugMaxLength)
+	}
+
+	for _, raw := range []string{"0", "-5", "long"} {
+		_, err := loadEnv(map[string]string{"SLUG_MAX_LENGTH": raw})
+		expectProblem(t, err, "SLUG_MAX_LENGTH")
+	}
+}
+
+func TestSpeakingRateSetting(t *testing.T) {
+	cfg, err := loadEnv(map[string]string{"SPEAKING_WPM": "170"})
+	if err != nil {
+		t.Fatalf("load SPEAKING_WPM: %v", err)
+	}
+	if cfg.Content.SpeakingWPM != 170 || cfg.Content.ReadingWPM != 0 {
+		t.Errorf("SpeakingWPM = %d and ReadingWPM = %d, want 170 and unset", cfg.Content.SpeakingWPM, cfg.Content.ReadingWPM)
+	}
+
+	for _, raw := range []string{"0", "-150", "slow"} {
+		_, err := loadEnv(map[string]string{"SPEAKING_WPM": raw})
+		expectProblem(t, err, "SPEAKING_WPM")
+	}
+}
+
+func TestSeedDatabaseSetting(t *testing.T) {
+	release := map[string]string{"GIN_MODE": "release", "SITE_URL": "https://blog.example.com", "API_KEY": "release-key"}
+	withVar := func(vars map[string]string, key, value string) map[string]string {
+		merged := map[string]string{key: value}
+		for k, v := range vars {
+			merged[k] = v
+		}
+		return merged
+	}
+
+	tests := []struct {
+		name     string
+		vars     map[string]string
+		seed     bool
+		explicit bool
+	}{
+		{"development default", nil, true, false},
+		{"release default", release, false, false},
+		{"disabled in development", map[string]string{"SEED_DATABASE": "false"}, false, true},
+		{"enabled in release", withVar(release, "SEED_DATABASE", "true"), true, true},
+	}
+	for _, tt := range tests {
+		cfg, err := loadEnv(tt.vars)
+		if err != nil {
+			t.Fatalf("%s: load config: %v", tt.name, err)
+		}
+		if cfg.Database.Seed != tt.seed || cfg.Database.SeedExplicit != tt.explicit || cfg.Blog.SeedDatabase != tt.seed {
+			t.Errorf("%s: Seed %v, SeedExplicit %v, Blog.SeedDatabase %v; want %v, %v, %v", tt.name,
+				cfg.Database.Seed, cfg.Database.SeedExplicit, cfg.Blog.SeedDatabase, tt.seed, tt.explicit, tt.seed)
+		}
+	}
+
+	_, err := loadEnv(map[string]string{"SEED_DATABASE": "maybe"})
+	expectProblem(t, err, "SEED_DATABASE")
+}
+
+func TestLogSkipPathsSetting(t *testing.T) {
+	tests := []struct {
+		vars map[string]string
+		want []string
+	}{
+		{nil, middleware.DefaultLogSkipPaths},
+		{map[string]string{"LOG_SKIP_PATHS": "/api/v1/health, /metrics ,"}, []string{"/api/v1/health", "/metrics"}},
+		// An empty list logs every request
+		{map[string]string{"LOG_SKIP_PATHS": ""}, []string{}},
+	}
+	for _, tt := range tests {
+		cfg, err := loadEnv(tt.vars)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.vars, err)
+		}
+		if !reflect.DeepEqual(cfg.Server.LogSkipPaths, tt.want) {
+			t.Errorf("%v: log skip paths = %q, want %q", tt.vars, cfg.Server.LogSkipPaths, tt.want)
+		}
+	}
+}
+
+func TestContentScanSettings(t *testing.T) {
+	cfg, err := loadEnv(nil)
+	if err != nil {
+		t.Fatalf("load defaults: %v", err)
+	}
+	if cfg.Content.ContentScan || cfg.Content.ContentScanConfig != "" {
+		t.Errorf("default scan settings = %v, %q; want off with no config", cfg.Content.ContentScan, cfg.Content.ContentScanConfig)
+	}
+
+	cfg, err = loadEnv(map[string]string{"CONTENT_SCAN": "true", "CONTENT_SCAN_CONFIG": "/etc/blog/scanner.json"})
+	if err != nil {
+		t.Fatalf("load CONTENT_SCAN: %v", err)
+	}
+	if !cfg.Content.ContentScan || cfg.Content.ContentScanConfig != "/etc/blog/scanner.json" {
+		t.Errorf("scan settings = %v, %q", cfg.Content.ContentScan, cfg.Content.ContentScanConfig)
+	}
+
+	_, err = loadEnv(map[string]string{"CONTENT_SCAN": "yes please"})
+	expectProblem(t, err, "CONTENT_SCAN")
+}
+
+func TestSiteURLSetting(t *testing.T) {
+	tests := []struct {
+		vars map[string]string
+		want string
+	}{
+		{nil, models.DefaultSiteURL},
+		{map[string]string{"SITE_URL": " https://blog.example.com/ "}, "https://blog.example.com"},
+		{map[string]string{"SITE_URL": "https://example.com/blog"}, "https://example.com/blog"},
+		{map[string]string{"GIN_MODE": "release", "SITE_URL": "https://blog.example.com"}, "https://blog.example.com"},
+	}
+	for _, tt := range tests {
+		cfg, err := loadEnv(tt.vars)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.vars, err)
+		}
+		if cfg.Blog.SiteURL != tt.want {
+			t.Errorf("%v: SiteURL = %q, want %q", tt.vars, cfg.Blog.SiteURL, tt.want)
+		}
+	}
+
+	// A missing value fails startup in release mode, and an invalid one in any mode
+	for _, vars := range []map[string]string{
+		{"GIN_MODE": "release"},
+		{"GIN_MODE": "release", "SITE_URL": "blog.example.com"},
+		{"GIN_MODE": "release", "SITE_URL": "ftp://blog.example.com"},
+		{"SITE_URL": "/relative/path"},
+		{"SITE_URL": "https://"},
+		{"SITE_URL": "https://blog.example.com/?ref=feed"},
+		{"SITE_URL": "https://blog.example.com/#top"},
+	} {
+		_, err := loadEnv(vars)
+		expectProblem(t, err, "SITE_URL")
+	}
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jinzhu/gorm"
+)
+
+// logQueries mirrors the SQL logging setting of the root connection onto
+// context-bound handles
+var logQueries bool
+
+// contextDB routes gorm's queries through the context-aware database/sql
+// methods so they are cancelled when the context ends
+type contextDB struct {
+	db  *sql.DB
+	ctx context.Context
+}
+
+func (c *contextDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.db.ExecContext(c.ctx, query, args...)
+}
+
+func (c *contextDB) Prepare(query string) (*sql.Stmt, error) {
+	return c.db.PrepareContext(c.ctx, query)
+}
+
+func (c *contextDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.db.QueryContext(c.ctx, query, args...)
+}
+
+func (c *contextDB) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.db.QueryRowContext(c.ctx, query, args...)
+}
+
+func (c *contextDB) Begin() (*sql.Tx, error) {
+	return c.db.BeginTx(c.ctx, nil)
+}
+
+// BeginTx ignores ctx because gorm's Begin always passes context.Background
+func (c *contextDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return c.db.BeginTx(c.ctx, opts)
+}
+
+// WithContext returns a handle on db's connection pool whose queries and
+// transactions are cancelled when ctx is done. jinzhu/gorm has no per-query
+// context, so the handle wraps the pool rather than cloning db.
+func WithContext(db *gorm.DB, ctx context.Context) *gorm.DB {
+	bound, err := gorm.Open(db.Dialect().GetName(), &contextDB{db: db.DB(), ctx: ctx})
+	if err != nil {
+		return db
+	}
+	if logQueries {
+		bound.LogMode(true)
+	}
+	return bound
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestMain(m *testing.M) {
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// newTestDB opens a migrated SQLite database that is removed after the test
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	db.LogMode(false)
+	if err := Migrate(db); err != nil {
+		t.Fatalf("migrate database: %v", err)
+	}
+	return db
+}
+
+func TestMigrateRecomputesReadingTimes(t *testing.T) {
+	t.Cleanup(func() { models.SetReadingWPM(models.DefaultReadingWPM) })
+	db := newTestDB(t)
+
+	blog := models.Blog{Title: "Long read", Slug: "long-read", Author: "Test Author", Content: "<p>" + strings.Repeat("word ", 1000) + "</p>"}
+	if err := db.Create(&blog).Error; err != nil {
+		t.Fatalf("create blog: %v", err)
+	}
+	readingTime := func() int {
+		var stored models.Blog
+		db.Unscoped().First(&stored, blog.ID)
+		return stored.ReadingTime
+	}
+	if got := readingTime(); got != 5 {
+		t.Fatalf("reading time at %d wpm = %d, want 5", models.DefaultReadingWPM, got)
+	}
+
+	// Rerunning at the same speed leaves rows alone
+	db.Model(&blog).UpdateColumn("reading_time", 42)
+	if err := Migrate(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if got := readingTime(); got != 42 {
+		t.Errorf("reading time after migrating at an unchanged speed = %d, want 42", got)
+	}
+
+	models.SetReadingWPM(100)
+	if err := Migrate(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if got := readingTime(); got != 10 {
+		t.Errorf("reading time after switching to 100 wpm = %d, want 10", got)
+	}
+	var setting models.Setting
+	db.Where("key = ?", models.SettingReadingWPM).First(&setting)
+	if setting.Value != "100" {
+		t.Errorf("recorded reading speed = %q, want 100", setting.Value)
+	}
+}
+
+func TestMigrateSeedsAccessibilityStatementOnce(t *testing.T) {
+	db := newTestDB(t)
+
+	var statement models.AccessibilityStatement
+	if err := db.First(&statement, models.AccessibilityStatementID).Error; err != nil {
+		t.Fatalf("load seeded statement: %v", err)
+	}
+	if want := models.DefaultAccessibilityStatement(); statement.WCAGLevel != want.WCAGLevel || statement.Features != want.Features {
+		t.Errorf("seeded statement = %+v, want the defaults", statement)
+	}
+
+	statement.WCAGLevel = "AAA"
+	statement.Contact = "access@example.com"
+	if err := db.Save(&statement).Error; err != nil {
+		t.Fatalf("save statement: %v", err)
+	}
+	if err := Migrate(db); err != nil {
+		t.Fatalf("migrate again: %v", err)
+	}
+
+	var statements []models.AccessibilityStatement
+	db.Find(&statements)
+	if len(statements) != 1 || statements[0].WCAGLevel != "AAA" || statements[0].Contact != "access@example.com" {
+		t.Errorf("statements after a second migration = %+v, want the single edited row", statements)
+	}
+}
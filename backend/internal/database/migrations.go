@@ -0,0 +1,172 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/models"
+)
+
+// SchemaMigration records a numbered migration that has been applied
+type SchemaMigration struct {
+	Version   int    `gorm:"primary_key;auto_increment:false"`
+	Name      string `gorm:"size:255;not null"`
+	AppliedAt time.Time
+}
+
+// TableName stores applied migrations in schema_migrations
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// migration is a schema change or data backfill that AutoMigrate cannot express
+type migration struct {
+	version int
+	name    string
+	up      func(tx *gorm.DB) error
+}
+
+// migrations run in version order after AutoMigrate, each exactly once. Append
+// new migrations with the next version; never renumber or edit applied ones.
+var migrations = []migration{
+	{1, "backfill_content_hashes", backfillContentHashes},
+	{2, "backfill_heading_counts", backfillHeadingCounts},
+	{3, "backfill_rich_excerpts", backfillRichExcerpts},
+	{4, "backfill_languages_and_translation_groups", backfillTranslations},
+	{5, "add_blog_listing_indexes", addBlogListingIndexes},
+}
+
+// applyMigrations runs every migration not yet recorded in schema_migrations.
+// Each runs in a transaction with its record, so a failed migration is retried
+// on the next start and a concurrent start cannot record one twice.
+func applyMigrations(db *gorm.DB) error {
+	var applied []SchemaMigration
+	if err := db.Find(&applied).Error; err != nil {
+		return err
+	}
+	done := make(map[int]bool, len(applied))
+	for _, record := range applied {
+		done[record.Version] = true
+	}
+
+	previous := 0
+	for _, m := range migrations {
+		if m.version <= previous {
+			return fmt.Errorf("migration %d (%s) is out of order", m.version, m.name)
+		}
+		previous = m.version
+		if done[m.version] {
+			continue
+		}
+
+		log.Printf("🔄 Applying migration %04d %s...", m.version, m.name)
+		tx := db.Begin()
+		if err := m.up(tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d (%s) failed: %v", m.version, m.name, err)
+		}
+		if err := tx.Create(&SchemaMigration{Version: m.version, Name: m.name, AppliedAt: time.Now().UTC()}).Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record migration %d (%s): %v", m.version, m.name, err)
+		}
+		if err := tx.Commit().Error; err != nil {
+			return fmt.Errorf("failed to commit migration %d (%s): %v", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+// backfillContentHashes hashes posts created before duplicate detection
+func backfillContentHashes(tx *gorm.DB) error {
+	var blogs []models.Blog
+	if err := tx.Unscoped().Select("id, content").Where("content_hash IS NULL OR content_hash = ''").Find(&blogs).Error; err != nil {
+		return err
+	}
+	for _, blog := range blogs {
+		if err := tx.Unscoped().Model(&blog).UpdateColumn("content_hash", models.ContentHash(blog.Content)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillHeadingCounts caches heading counts for posts created before the column existed
+func backfillHeadingCounts(tx *gorm.DB) error {
+	var uncounted []models.Blog
+	if err := tx.Unscoped().Select("id, content").Where("heading_count = 0 AND content LIKE ?", "%<h%").Find(&uncounted).Error; err != nil {
+		return err
+	}
+	for _, blog := range uncounted {
+		if count := models.CountHeadings(blog.Content); count > 0 {
+			if err := tx.Unscoped().Model(&blog).UpdateColumn("heading_count", count).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// backfillRichExcerpts caches rich excerpts for posts created before the column existed
+func backfillRichExcerpts(tx *gorm.DB) error {
+	var unexcerpted []models.Blog
+	if err := tx.Unscoped().Select("id, content").Where("rich_excerpt IS NULL OR rich_excerpt = ''").Find(&unexcerpted).Error; err != nil {
+		return err
+	}
+	for _, blog := range unexcerpted {
+		if excerpt := models.GenerateRichExcerpt(blog.Content, models.DefaultExcerptLength); excerpt != "" {
+			if err := tx.Unscoped().Model(&blog).UpdateColumn("rich_excerpt", excerpt).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// backfillTranslations defaults posts created before localization to English,
+// each in its own translation group
+func backfillTranslations(tx *gorm.DB) error {
+	if err := tx.Unscoped().Model(&models.Blog{}).Where("language IS NULL OR language = ''").
+		UpdateColumn("language", models.DefaultLanguage).Error; err != nil {
+		return err
+	}
+	var ungrouped []models.Blog
+	if err := tx.Unscoped().Select("id").Where("translation_group IS NULL OR translation_group = ''").Find(&ungrouped).Error; err != nil {
+		return err
+	}
+	for _, blog := range ungrouped {
+		if err := tx.Unscoped().Model(&blog).UpdateColumn("translation_group", models.NewTranslationGroup()).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blogIndexes serve the common post queries:
+//   - idx_blogs_published_created_at: GetBlogs' published filter ordered by
+//     created_at; published leads, so it also serves plain published filters
+//   - idx_blogs_featured: the featured filter and featured post lookups
+//   - idx_blogs_published_at: archive months and feeds ordered by publish date
+//
+// Slug lookups already use the index behind the column's unique constraint.
+var blogIndexes = []struct {
+	name    string
+	columns []string
+}{
+	{"idx_blogs_published_created_at", []string{"published", "created_at"}},
+	{"idx_blogs_featured", []string{"featured"}},
+	{"idx_blogs_published_at", []string{"published_at"}},
+}
+
+// addBlogListingIndexes creates the listing indexes. AddIndex skips indexes
+// that already exist.
+func addBlogListingIndexes(tx *gorm.DB) error {
+	blogs := tx.Model(&models.Blog{})
+	for _, index := range blogIndexes {
+		if err := blogs.AddIndex(index.name, index.columns...).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
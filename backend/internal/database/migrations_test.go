@@ -0,0 +1,217 @@
+package database
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/models"
+)
+
+// useMigrations replaces the migration list for the rest of the test
+func useMigrations(t *testing.T, list []migration) {
+	t.Helper()
+	original := migrations
+	migrations = list
+	t.Cleanup(func() { migrations = original })
+}
+
+func TestMigrateRecordsEveryMigration(t *testing.T) {
+	db := newTestDB(t)
+
+	var applied []SchemaMigration
+	db.Order("version ASC").Find(&applied)
+	if len(applied) != len(migrations) {
+		t.Fatalf("recorded %d migrations, want %d", len(applied), len(migrations))
+	}
+	for i, record := range applied {
+		if record.Version != migrations[i].version || record.Name != migrations[i].name || record.AppliedAt.IsZero() {
+			t.Errorf("record %d = %+v, want version %d %s", i, record, migrations[i].version, migrations[i].name)
+		}
+	}
+}
+
+func TestApplyMigrationsRunsOnce(t *testing.T) {
+	db := newTestDB(t)
+	runs := 0
+	useMigrations(t, []migration{{1, "count_runs", func(tx *gorm.DB) error {
+		runs++
+		return tx.Create(&models.Setting{Key: "migrated", Value: "yes"}).Error
+	}}})
+	// The migrations Migrate already applied are forgotten so version 1 is new
+	db.Delete(&SchemaMigration{})
+
+	for i := 0; i < 3; i++ {
+		if err := applyMigrations(db); err != nil {
+			t.Fatalf("apply %d: %v", i+1, err)
+		}
+	}
+	if runs != 1 {
+		t.Errorf("migration ran %d times, want once", runs)
+	}
+	var record SchemaMigration
+	if err := db.First(&record, 1).Error; err != nil || record.Name != "count_runs" {
+		t.Errorf("record = %+v, %v; want count_runs", record, err)
+	}
+	var setting models.Setting
+	if err := db.Where("key = ?", "migrated").First(&setting).Error; err != nil {
+		t.Errorf("migration change not committed: %v", err)
+	}
+}
+
+func TestApplyMigrationsRetriesFailedMigration(t *testing.T) {
+	db := newTestDB(t)
+	db.Delete(&SchemaMigration{})
+	fail := true
+	useMigrations(t, []migration{
+		{1, "first", func(tx *gorm.DB) error { return nil }},
+		{2, "flaky", func(tx *gorm.DB) error {
+			if err := tx.Create(&models.Setting{Key: "partial", Value: "yes"}).Error; err != nil {
+				return err
+			}
+			if fail {
+				return errors.New("boom")
+			}
+			return nil
+		}},
+	})
+
+	if err := applyMigrations(db); err == nil {
+		t.Fatal("failing migration reported no error")
+	}
+	var versions []int
+	db.Model(&SchemaMigration{}).Order("version ASC").Pluck("version", &versions)
+	if len(versions) != 1 || versions[0] != 1 {
+		t.Errorf("recorded versions after failure = %v, want [1]", versions)
+	}
+	var partial int
+	db.Model(&models.Setting{}).Where("key = ?", "partial").Count(&partial)
+	if partial != 0 {
+		t.Error("failed migration's changes were not rolled back")
+	}
+
+	fail = false
+	if err := applyMigrations(db); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	versions = nil
+	db.Model(&SchemaMigration{}).Order("version ASC").Pluck("version", &versions)
+	if len(versions) != 2 {
+		t.Errorf("recorded versions after retry = %v, want [1 2]", versions)
+	}
+}
+
+func TestApplyMigrationsRejectsOutOfOrder(t *testing.T) {
+	db := newTestDB(t)
+	noop := func(tx *gorm.DB) error { return nil }
+	for _, list := range [][]migration{
+		{{2, "second", noop}, {1, "first", noop}},
+		{{1, "first", noop}, {1, "again", noop}},
+	} {
+		useMigrations(t, list)
+		if err := applyMigrations(db); err == nil {
+			t.Errorf("migrations %v applied out of order", list)
+		}
+	}
+}
+
+// sqliteIndexes returns the columns of each index on table, and which are unique
+func sqliteIndexes(t *testing.T, db *gorm.DB, table string) (map[string][]string, map[string]bool) {
+	t.Helper()
+	rows, err := db.Raw("SELECT name, \"unique\" FROM pragma_index_list(?)", table).Rows()
+	if err != nil {
+		t.Fatalf("list indexes: %v", err)
+	}
+	unique := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		var isUnique bool
+		if err := rows.Scan(&name, &isUnique); err != nil {
+			t.Fatalf("scan index: %v", err)
+		}
+		unique[name] = isUnique
+	}
+	rows.Close()
+
+	columns := make(map[string][]string, len(unique))
+	for name := range unique {
+		var names []string
+		if err := db.Raw("SELECT name FROM pragma_index_info(?) ORDER BY seqno", name).Pluck("name", &names).Error; err != nil {
+			t.Fatalf("list columns of %s: %v", name, err)
+		}
+		columns[name] = names
+	}
+	return columns, unique
+}
+
+func TestAddBlogListingIndexes(t *testing.T) {
+	db := newTestDB(t)
+
+	// Running the migration again is harmless
+	if err := addBlogListingIndexes(db); err != nil {
+		t.Fatalf("rerun addBlogListingIndexes: %v", err)
+	}
+
+	columns, unique := sqliteIndexes(t, db, "blogs")
+	for _, index := range blogIndexes {
+		if !reflect.DeepEqual(columns[index.name], index.columns) {
+			t.Errorf("index %s columns = %v, want %v", index.name, columns[index.name], index.columns)
+		}
+		if unique[index.name] {
+			t.Errorf("index %s is unique", index.name)
+		}
+	}
+
+	// No index repeats another's leading columns, and slug keeps the single
+	// unique index of its constraint
+	slugIndexes := 0
+	for name, cols := range columns {
+		if reflect.DeepEqual(cols, []string{"published"}) {
+			t.Errorf("index %s duplicates idx_blogs_published_created_at", name)
+		}
+		if reflect.DeepEqual(cols, []string{"slug"}) {
+			slugIndexes++
+			if !unique[name] {
+				t.Errorf("slug index %s is not unique", name)
+			}
+		}
+	}
+	if slugIndexes != 1 {
+		t.Errorf("slug has %d indexes, want 1", slugIndexes)
+	}
+
+	// The common queries are answered from the indexes
+	plans := map[string]string{
+		"SELECT id FROM blogs WHERE published = 1 ORDER BY created_at DESC": "idx_blogs_published_created_at",
+		"SELECT id FROM blogs WHERE published = 1":                          "idx_blogs_published_created_at",
+		"SELECT id FROM blogs WHERE published_at >= '2024-01-01'":           "idx_blogs_published_at",
+	}
+	for query, index := range plans {
+		rows, err := db.Raw("EXPLAIN QUERY PLAN " + query).Rows()
+		if err != nil {
+			t.Fatalf("explain %q: %v", query, err)
+		}
+		var details []string
+		for rows.Next() {
+			var id, parent, unused int
+			var detail string
+			rows.Scan(&id, &parent, &unused, &detail)
+			details = append(details, detail)
+		}
+		rows.Close()
+		if plan := strings.Join(details, "; "); !strings.Contains(plan, index) {
+			t.Errorf("plan for %q = %q, want it to use %s", query, plan, index)
+		}
+	}
+
+	blog := models.Blog{Title: "Indexed", Slug: "indexed", Author: "Test Author", Content: "<p>One</p>"}
+	if err := db.Create(&blog).Error; err != nil {
+		t.Fatalf("create blog: %v", err)
+	}
+	duplicate := models.Blog{Title: "Indexed again", Slug: "indexed", Author: "Test Author", Content: "<p>Two</p>"}
+	if err := db.Create(&duplicate).Error; err == nil {
+		t.Error("duplicate slug accepted")
+	}
+}
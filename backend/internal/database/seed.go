@@ -0,0 +1,116 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/models"
+)
+
+// ErrSeedNotEmpty is returned by Seed when posts exist and force is not set
+var ErrSeedNotEmpty = errors.New("database already contains blog posts")
+
+// SeedSlugs returns the slugs of the sample blog posts
+func SeedSlugs() []string {
+	samples := sampleBlogs()
+	slugs := make([]string, len(samples))
+	for i, blog := range samples {
+		slugs[i] = blog.Slug
+	}
+	return slugs
+}
+
+// Seed creates the sample blog posts. Without force it refuses to touch a
+// database that already has posts; with force it adds the sample posts whose
+// slugs are not in use, including by deleted posts, and reports how many were
+// created and skipped.
+func Seed(db *gorm.DB, force bool) (created, skipped int, err error) {
+	var count int64
+	if err := db.Model(&models.Blog{}).Count(&count).Error; err != nil {
+		return 0, 0, err
+	}
+	if count > 0 && !force {
+		return 0, 0, ErrSeedNotEmpty
+	}
+
+	tx := db.Begin()
+	created, err = insertSampleBlogs(tx)
+	if err != nil {
+		tx.Rollback()
+		return 0, 0, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, 0, err
+	}
+	return created, len(sampleBlogs()) - created, nil
+}
+
+// insertSampleBlogs creates the sample posts whose slugs are free and records
+// when any were created, returning the number of posts created
+func insertSampleBlogs(db *gorm.DB) (int, error) {
+	var taken []string
+	if err := db.Unscoped().Model(&models.Blog{}).Where("slug IN (?)", SeedSlugs()).Pluck("slug", &taken).Error; err != nil {
+		return 0, err
+	}
+	inUse := make(map[string]bool, len(taken))
+	for _, slug := range taken {
+		inUse[slug] = true
+	}
+
+	created := 0
+	for _, blog := range sampleBlogs() {
+		if inUse[blog.Slug] {
+			continue
+		}
+		if err := db.Create(&blog).Error; err != nil {
+			return created, fmt.Errorf("failed to create blog: %v", err)
+		}
+		created++
+	}
+	if created == 0 {
+		return 0, nil
+	}
+
+	setting := models.Setting{Key: models.SettingSeededAt, Value: time.Now().UTC().Format(time.RFC3339)}
+	if err := db.Save(&setting).Error; err != nil {
+		return created, err
+	}
+	return created, nil
+}
+
+// GetSeedStatus reports whether the database was seeded and how many sample
+// posts are left. Databases seeded before the seed time was recorded count as
+// seeded while any sample post exists, deleted or not.
+func GetSeedStatus(db *gorm.DB) (models.SeedStatus, error) {
+	slugs := SeedSlugs()
+	status := models.SeedStatus{SeedPosts: len(slugs)}
+
+	if err := db.Model(&models.Blog{}).Where("slug IN (?)", slugs).Count(&status.RemainingSeedPosts).Error; err != nil {
+		return status, err
+	}
+	if err := db.Model(&models.Blog{}).Count(&status.TotalPosts).Error; err != nil {
+		return status, err
+	}
+
+	var setting models.Setting
+	err := db.Where("key = ?", models.SettingSeededAt).First(&setting).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return status, err
+	}
+	if err == nil {
+		if seededAt, err := time.Parse(time.RFC3339, setting.Value); err == nil {
+			status.SeededAt = &seededAt
+		}
+		status.Seeded = true
+		return status, nil
+	}
+
+	var seedRows int
+	if err := db.Unscoped().Model(&models.Blog{}).Where("slug IN (?)", slugs).Count(&seedRows).Error; err != nil {
+		return status, err
+	}
+	status.Seeded = seedRows > 0
+	return status, nil
+}
@@ -0,0 +1,173 @@
+package database
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+// countBlogs returns the number of posts in db
+func countBlogs(t *testing.T, db *gorm.DB) int {
+	t.Helper()
+	var count int
+	if err := db.Model(&models.Blog{}).Count(&count).Error; err != nil {
+		t.Fatalf("count blogs: %v", err)
+	}
+	return count
+}
+
+// captureLog returns what fn writes to the standard logger
+func captureLog(t *testing.T, fn func()) string {
+	t.Helper()
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(io.Discard)
+	fn()
+	return buf.String()
+}
+
+func TestSeedOnStartup(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.Database
+		seeded bool
+		log    string
+	}{
+		{"release default", config.Database{}, false, "disabled by default in release mode"},
+		{"disabled", config.Database{SeedExplicit: true}, false, "disabled by SEED_DATABASE"},
+		{"enabled", config.Database{Seed: true}, true, "Successfully seeded"},
+		{"explicitly enabled", config.Database{Seed: true, SeedExplicit: true}, true, "Successfully seeded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			output := captureLog(t, func() {
+				if err := seedOnStartup(db, tt.cfg); err != nil {
+					t.Fatalf("seedOnStartup: %v", err)
+				}
+			})
+			want := 0
+			if tt.seeded {
+				want = len(SeedSlugs())
+			}
+			if got := countBlogs(t, db); got != want {
+				t.Errorf("posts after seeding = %d, want %d", got, want)
+			}
+			if !strings.Contains(output, tt.log) {
+				t.Errorf("log %q does not mention %q", output, tt.log)
+			}
+		})
+	}
+}
+
+func TestSeedOnStartupKeepsExistingPosts(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.Create(&models.Blog{Title: "Own post", Slug: "own-post", Author: "Test Author", Content: "<p>Mine</p>"}).Error; err != nil {
+		t.Fatalf("create blog: %v", err)
+	}
+
+	output := captureLog(t, func() {
+		if err := seedOnStartup(db, config.Database{Seed: true}); err != nil {
+			t.Fatalf("seedOnStartup: %v", err)
+		}
+	})
+	if got := countBlogs(t, db); got != 1 {
+		t.Errorf("posts = %d, want the existing post only", got)
+	}
+	if !strings.Contains(output, "already contains blog posts") {
+		t.Errorf("log %q does not say posts exist", output)
+	}
+}
+
+func TestSeedForceGuard(t *testing.T) {
+	db := newTestDB(t)
+	samples := len(SeedSlugs())
+
+	created, skipped, err := Seed(db, false)
+	if err != nil || created != samples || skipped != 0 {
+		t.Fatalf("Seed(empty) = %d, %d, %v; want %d, 0, nil", created, skipped, err, samples)
+	}
+	if _, _, err := Seed(db, false); err != ErrSeedNotEmpty {
+		t.Errorf("Seed without force = %v, want ErrSeedNotEmpty", err)
+	}
+	if count := countBlogs(t, db); count != samples {
+		t.Errorf("posts after refused seed = %d, want %d", count, samples)
+	}
+
+	// Forcing fills only the free slugs: soft deleted posts keep theirs
+	slugs := SeedSlugs()
+	if err := db.Unscoped().Where("slug = ?", slugs[0]).Delete(&models.Blog{}).Error; err != nil {
+		t.Fatalf("purge blog: %v", err)
+	}
+	if err := db.Where("slug = ?", slugs[1]).Delete(&models.Blog{}).Error; err != nil {
+		t.Fatalf("delete blog: %v", err)
+	}
+	created, skipped, err = Seed(db, true)
+	if err != nil || created != 1 || skipped != samples-1 {
+		t.Errorf("Seed(force) = %d, %d, %v; want 1, %d, nil", created, skipped, err, samples-1)
+	}
+	var restored models.Blog
+	if err := db.Where("slug = ?", slugs[0]).First(&restored).Error; err != nil {
+		t.Errorf("purged sample post was not recreated: %v", err)
+	}
+}
+
+func TestGetSeedStatus(t *testing.T) {
+	db := newTestDB(t)
+	samples := len(SeedSlugs())
+
+	status, err := GetSeedStatus(db)
+	if err != nil {
+		t.Fatalf("GetSeedStatus: %v", err)
+	}
+	if want := (models.SeedStatus{SeedPosts: samples}); status != want {
+		t.Errorf("empty status = %+v, want %+v", status, want)
+	}
+
+	if _, _, err := Seed(db, false); err != nil {
+		t.Fatalf("Seed: %v", err)
+	}
+	if err := db.Create(&models.Blog{Title: "Own post", Slug: "own-post", Author: "Test Author", Content: "<p>Mine</p>"}).Error; err != nil {
+		t.Fatalf("create blog: %v", err)
+	}
+	if err := db.Where("slug = ?", SeedSlugs()[0]).Delete(&models.Blog{}).Error; err != nil {
+		t.Fatalf("delete blog: %v", err)
+	}
+	status, err = GetSeedStatus(db)
+	if err != nil {
+		t.Fatalf("GetSeedStatus: %v", err)
+	}
+	if !status.Seeded || status.SeededAt == nil || time.Since(*status.SeededAt) > time.Minute {
+		t.Errorf("seeded status = %+v, want seeded just now", status)
+	}
+	if status.RemainingSeedPosts != samples-1 || status.TotalPosts != samples {
+		t.Errorf("status = %d remaining of %d, %d total; want %d and %d", status.RemainingSeedPosts, status.SeedPosts, status.TotalPosts, samples-1, samples)
+	}
+}
+
+func TestGetSeedStatusWithoutSeededAt(t *testing.T) {
+	// Databases seeded before the time was recorded are detected by their sample posts
+	db := newTestDB(t)
+	slug := SeedSlugs()[0]
+	if err := db.Create(&models.Blog{Title: "Sample", Slug: slug, Author: "Test Author", Content: "<p>Sample</p>"}).Error; err != nil {
+		t.Fatalf("create blog: %v", err)
+	}
+	if err := db.Where("slug = ?", slug).Delete(&models.Blog{}).Error; err != nil {
+		t.Fatalf("delete blog: %v", err)
+	}
+
+	status, err := GetSeedStatus(db)
+	if err != nil {
+		t.Fatalf("GetSeedStatus: %v", err)
+	}
+	if !status.Seeded || status.SeededAt != nil || status.RemainingSeedPosts != 0 {
+		t.Errorf("status = %+v, want seeded with no time and no remaining posts", status)
+	}
+}
@@ -0,0 +1,390 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// ResolveFunc computes a field's value from its parent value and arguments
+type ResolveFunc func(source interface{}, args map[string]interface{}) (interface{}, error)
+
+// FieldDef defines a field of an object type
+type FieldDef struct {
+	Type    *Object  // Object type of the result, a single value or a slice; nil for scalars
+	Args    []string // Accepted argument names
+	Resolve ResolveFunc
+}
+
+// Object is a GraphQL object type
+type Object struct {
+	Name   string
+	Fields map[string]*FieldDef
+}
+
+// Schema is an executable set of types rooted at the Query type. Only query
+// operations are supported and introspection is not available.
+type Schema struct {
+	Query *Object
+}
+
+// Request is a GraphQL-over-HTTP request body
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Response is a GraphQL-over-HTTP response body. Data is omitted when the
+// request could not be executed at all.
+type Response struct {
+	Data   interface{} `json:"data,omitempty"`
+	Errors []Error     `json:"errors,omitempty"`
+}
+
+// Error is a GraphQL error, with the response path of the field that failed
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Execute parses and runs a request against the schema. Field errors null the
+// failing field and are reported alongside the partial data.
+func (s *Schema) Execute(req Request) Response {
+	doc, err := Parse(req.Query)
+	if err != nil {
+		return Response{Errors: []Error{{Message: err.Error()}}}
+	}
+
+	operation, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return Response{Errors: []Error{{Message: err.Error()}}}
+	}
+	if operation.Type != "query" {
+		return Response{Errors: []Error{{Message: fmt.Sprintf("%s operations are not supported", operation.Type)}}}
+	}
+
+	variables := map[string]interface{}{}
+	for _, definition := range operation.Variables {
+		if value, ok := req.Variables[definition.Name]; ok {
+			variables[definition.Name] = value
+		} else if definition.Default != nil {
+			variables[definition.Name] = definition.Default
+		} else if strings.HasSuffix(definition.Type, "!") {
+			return Response{Errors: []Error{{Message: fmt.Sprintf("variable $%s of required type %s was not provided", definition.Name, definition.Type)}}}
+		}
+	}
+
+	e := &executor{doc: doc, variables: variables}
+	data := e.selectionSet(s.Query, nil, operation.Selections, nil)
+	return Response{Data: data, Errors: e.errors}
+}
+
+// selectOperation picks the operation to run from a document
+func selectOperation(doc *Document, name string) (*Operation, error) {
+	if name == "" {
+		if len(doc.Operations) > 1 {
+			return nil, fmt.Errorf("operationName is required when the document contains several operations")
+		}
+		return doc.Operations[0], nil
+	}
+	for _, operation := range doc.Operations {
+		if operation.Name == name {
+			return operation, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation %q", name)
+}
+
+// executor holds the state of a single request execution
+type executor struct {
+	doc       *Document
+	variables map[string]interface{}
+	errors    []Error
+}
+
+// fail records a field error at path
+func (e *executor) fail(path []interface{}, format string, args ...interface{}) {
+	e.errors = append(e.errors, Error{
+		Message: fmt.Sprintf(format, args...),
+		Path:    append([]interface{}{}, path...),
+	})
+}
+
+// selectionSet resolves selections against source, an instance of typ
+func (e *executor) selectionSet(typ *Object, source interface{}, selections []Selection, path []interface{}) *orderedMap {
+	result := &orderedMap{values: map[string]interface{}{}}
+	grouped := map[string][]*Field{}
+	var keys []string
+	if err := e.collectFields(typ, selections, map[string]bool{}, grouped, &keys); err != nil {
+		e.fail(path, "%s", err)
+		return result
+	}
+
+	for _, key := range keys {
+		fields := grouped[key]
+		fieldPath := append(append([]interface{}{}, path...), key)
+		result.set(key, e.field(typ, source, fields, fieldPath))
+	}
+	return result
+}
+
+// collectFields flattens fragments into fields grouped by response key, in order
+func (e *executor) collectFields(typ *Object, selections []Selection, visited map[string]bool, grouped map[string][]*Field, keys *[]string) error {
+	for _, selection := range selections {
+		switch selection := selection.(type) {
+		case *Field:
+			include, err := e.included(selection.Directives)
+			if err != nil {
+				return err
+			}
+			if !include {
+				continue
+			}
+			key := selection.ResponseKey()
+			if _, seen := grouped[key]; !seen {
+				*keys = append(*keys, key)
+			}
+			grouped[key] = append(grouped[key], selection)
+		case *FragmentSpread:
+			include, err := e.included(selection.Directives)
+			if err != nil {
+				return err
+			}
+			if !include || visited[selection.Name] {
+				continue
+			}
+			visited[selection.Name] = true
+			fragment, ok := e.doc.Fragments[selection.Name]
+			if !ok {
+				return fmt.Errorf("unknown fragment %q", selection.Name)
+			}
+			if fragment.TypeCondition != typ.Name {
+				continue
+			}
+			if err := e.collectFields(typ, fragment.Selections, visited, grouped, keys); err != nil {
+				return err
+			}
+		case *InlineFragment:
+			include, err := e.included(selection.Directives)
+			if err != nil {
+				return err
+			}
+			if !include || (selection.TypeCondition != "" && selection.TypeCondition != typ.Name) {
+				continue
+			}
+			if err := e.collectFields(typ, selection.Selections, visited, grouped, keys); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// included evaluates @skip and @include directives
+func (e *executor) included(directives []Directive) (bool, error) {
+	for _, directive := range directives {
+		if directive.Name != "skip" && directive.Name != "include" {
+			continue
+		}
+		value, err := e.resolveValue(directive.Arguments["if"])
+		if err != nil {
+			return false, err
+		}
+		condition, ok := value.(bool)
+		if !ok {
+			return false, fmt.Errorf("@%s requires a Boolean \"if\" argument", directive.Name)
+		}
+		if (directive.Name == "skip") == condition {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// field resolves one response key, merging the sub-selections of repeated fields
+func (e *executor) field(typ *Object, source interface{}, fields []*Field, path []interface{}) interface{} {
+	field := fields[0]
+	if field.Name == "__typename" {
+		return typ.Name
+	}
+
+	def, ok := typ.Fields[field.Name]
+	if !ok {
+		e.fail(path, "cannot query field %q on type %q", field.Name, typ.Name)
+		return nil
+	}
+
+	args := map[string]interface{}{}
+	for name, literal := range field.Arguments {
+		if !contains(def.Args, name) {
+			e.fail(path, "unknown argument %q on field %q", name, typ.Name+"."+field.Name)
+			return nil
+		}
+		value, err := e.resolveValue(literal)
+		if err != nil {
+			e.fail(path, "%s", err)
+			return nil
+		}
+		args[name] = value
+	}
+
+	var selections []Selection
+	for _, f := range fields {
+		selections = append(selections, f.Selections...)
+	}
+	if def.Type == nil && len(selections) > 0 {
+		e.fail(path, "field %q is a scalar and cannot have a selection", field.Name)
+		return nil
+	}
+	if def.Type != nil && len(selections) == 0 {
+		e.fail(path, "field %q of type %q must have a selection of subfields", field.Name, def.Type.Name)
+		return nil
+	}
+
+	value, err := def.Resolve(source, args)
+	if err != nil {
+		e.fail(path, "%s", err)
+		return nil
+	}
+	if def.Type == nil {
+		return value
+	}
+	return e.complete(def.Type, value, selections, path)
+}
+
+// complete applies a selection to an object value or to each item of a slice
+func (e *executor) complete(typ *Object, value interface{}, selections []Selection, path []interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			itemPath := append(append([]interface{}{}, path...), i)
+			items[i] = e.selectionSet(typ, v.Index(i).Interface(), selections, itemPath)
+		}
+		return items
+	}
+	return e.selectionSet(typ, value, selections, path)
+}
+
+// resolveValue substitutes variables into an argument literal
+func (e *executor) resolveValue(value Value) (interface{}, error) {
+	switch value := value.(type) {
+	case Variable:
+		return e.variables[value.Name], nil
+	case EnumValue:
+		return value.Name, nil
+	case []interface{}:
+		list := make([]interface{}, len(value))
+		for i, item := range value {
+			resolved, err := e.resolveValue(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = resolved
+		}
+		return list, nil
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			resolved, err := e.resolveValue(item)
+			if err != nil {
+				return nil, err
+			}
+			object[key] = resolved
+		}
+		return object, nil
+	}
+	return value, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IntArg returns an integer argument, or fallback when it is absent or null.
+// JSON variables decode as float64, so integral floats are accepted.
+func IntArg(args map[string]interface{}, name string, fallback int) (int, error) {
+	switch value := args[name].(type) {
+	case nil:
+		return fallback, nil
+	case int:
+		return value, nil
+	case float64:
+		if value == math.Trunc(value) && math.Abs(value) <= math.MaxInt32 {
+			return int(value), nil
+		}
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			return int(n), nil
+		}
+	}
+	return 0, fmt.Errorf("argument %q must be an Int", name)
+}
+
+// StringArg returns a string argument, or fallback when it is absent or null
+func StringArg(args map[string]interface{}, name, fallback string) (string, error) {
+	switch value := args[name].(type) {
+	case nil:
+		return fallback, nil
+	case string:
+		return value, nil
+	}
+	return "", fmt.Errorf("argument %q must be a String", name)
+}
+
+// orderedMap is a JSON object that keeps fields in selection order
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func (m *orderedMap) set(key string, value interface{}) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// MarshalJSON encodes the fields in selection order
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
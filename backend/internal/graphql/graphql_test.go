@@ -0,0 +1,185 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type testBook struct {
+	Title  string
+	Author string
+}
+
+// testSchema exposes books(limit) and book(title) over a fixed shelf
+func testSchema() *Schema {
+	shelf := []testBook{{"Dune", "Herbert"}, {"Emma", "Austen"}, {"Ulysses", "Joyce"}}
+	bookType := &Object{
+		Name: "Book",
+		Fields: map[string]*FieldDef{
+			"title": {Resolve: func(s interface{}, _ map[string]interface{}) (interface{}, error) {
+				return s.(testBook).Title, nil
+			}},
+			"author": {Resolve: func(s interface{}, _ map[string]interface{}) (interface{}, error) {
+				return s.(testBook).Author, nil
+			}},
+		},
+	}
+	return &Schema{Query: &Object{
+		Name: "Query",
+		Fields: map[string]*FieldDef{
+			"books": {
+				Type: bookType,
+				Args: []string{"limit"},
+				Resolve: func(_ interface{}, args map[string]interface{}) (interface{}, error) {
+					limit, err := IntArg(args, "limit", len(shelf))
+					if err != nil {
+						return nil, err
+					}
+					if limit < 0 {
+						return nil, errors.New("limit must not be negative")
+					}
+					if limit > len(shelf) {
+						limit = len(shelf)
+					}
+					return shelf[:limit], nil
+				},
+			},
+			"book": {
+				Type: bookType,
+				Args: []string{"title"},
+				Resolve: func(_ interface{}, args map[string]interface{}) (interface{}, error) {
+					title, err := StringArg(args, "title", "")
+					if err != nil {
+						return nil, err
+					}
+					for _, book := range shelf {
+						if book.Title == title {
+							return book, nil
+						}
+					}
+					return nil, nil
+				},
+			},
+		},
+	}}
+}
+
+// execute runs a request and returns the encoded response
+func execute(t *testing.T, req Request) string {
+	t.Helper()
+	encoded, err := json.Marshal(testSchema().Execute(req))
+	if err != nil {
+		t.Fatalf("encode response: %v", err)
+	}
+	return string(encoded)
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name string
+		req  Request
+		want string
+	}{
+		{
+			"shorthand query keeps selection order",
+			Request{Query: `{ books(limit: 2) { author title } }`},
+			`{"data":{"books":[{"author":"Herbert","title":"Dune"},{"author":"Austen","title":"Emma"}]}}`,
+		},
+		{
+			"aliases and typename",
+			Request{Query: `{ first: book(title: "Emma") { __typename name: title } missing: book(title: "Beowulf") { title } }`},
+			`{"data":{"first":{"__typename":"Book","name":"Emma"},"missing":null}}`,
+		},
+		{
+			"variables with defaults",
+			Request{Query: `query Shelf($limit: Int = 1, $title: String!) { books(limit: $limit) { title } book(title: $title) { author } }`, Variables: map[string]interface{}{"title": "Ulysses"}},
+			`{"data":{"books":[{"title":"Dune"}],"book":{"author":"Joyce"}}}`,
+		},
+		{
+			"JSON numbers are Ints",
+			Request{Query: `query($limit: Int) { books(limit: $limit) { title } }`, Variables: map[string]interface{}{"limit": float64(1)}},
+			`{"data":{"books":[{"title":"Dune"}]}}`,
+		},
+		{
+			"fragments and directives",
+			Request{Query: `query($withAuthor: Boolean!) { book(title: "Dune") { ...Names ... on Book @include(if: $withAuthor) { author } } } fragment Names on Book { title }`, Variables: map[string]interface{}{"withAuthor": false}},
+			`{"data":{"book":{"title":"Dune"}}}`,
+		},
+		{
+			"named operation",
+			Request{Query: `query A { book(title: "Dune") { title } } query B { book(title: "Emma") { title } }`, OperationName: "B"},
+			`{"data":{"book":{"title":"Emma"}}}`,
+		},
+		{
+			"resolver errors null only the failing field",
+			Request{Query: `{ books(limit: -1) { title } book(title: "Dune") { title } }`},
+			`{"data":{"books":null,"book":{"title":"Dune"}},"errors":[{"message":"limit must not be negative","path":["books"]}]}`,
+		},
+		{
+			"wrongly typed arguments",
+			Request{Query: `{ books(limit: "two") { title } }`},
+			`{"data":{"books":null},"errors":[{"message":"argument \"limit\" must be an Int","path":["books"]}]}`,
+		},
+		{
+			"unknown fields and arguments",
+			Request{Query: `{ shelf book(isbn: "1") { title } }`},
+			`{"data":{"shelf":null,"book":null},"errors":[{"message":"cannot query field \"shelf\" on type \"Query\"","path":["shelf"]},{"message":"unknown argument \"isbn\" on field \"Query.book\"","path":["book"]}]}`,
+		},
+		{
+			"selections must match the field type",
+			Request{Query: `{ books { title { length } } book(title: "Dune") }`},
+			`{"data":{"books":[{"title":null},{"title":null},{"title":null}],"book":null},"errors":[{"message":"field \"title\" is a scalar and cannot have a selection","path":["books",0,"title"]},{"message":"field \"title\" is a scalar and cannot have a selection","path":["books",1,"title"]},{"message":"field \"title\" is a scalar and cannot have a selection","path":["books",2,"title"]},{"message":"field \"book\" of type \"Book\" must have a selection of subfields","path":["book"]}]}`,
+		},
+		{
+			"mutations are not supported",
+			Request{Query: `mutation { books { title } }`},
+			`{"errors":[{"message":"mutation operations are not supported"}]}`,
+		},
+		{
+			"missing required variable",
+			Request{Query: `query($title: String!) { book(title: $title) { title } }`},
+			`{"errors":[{"message":"variable $title of required type String! was not provided"}]}`,
+		},
+		{
+			"ambiguous operation",
+			Request{Query: `query A { books { title } } query B { books { title } }`},
+			`{"errors":[{"message":"operationName is required when the document contains several operations"}]}`,
+		},
+		{
+			"unknown operation",
+			Request{Query: `query A { books { title } }`, OperationName: "C"},
+			`{"errors":[{"message":"unknown operation \"C\""}]}`,
+		},
+	}
+	for _, tt := range tests {
+		if got := execute(t, tt.req); got != tt.want {
+			t.Errorf("%s:\n got %s\nwant %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseSyntaxErrors(t *testing.T) {
+	for _, query := range []string{
+		``,
+		`{ books { title }`,
+		`{ book(title: "unterminated) { title } }`,
+		`query { books } fragment F on Book { title } fragment F on Book { author }`,
+		`fragment F on Book { title }`,
+		`{ books(limit: ) { title } }`,
+	} {
+		doc, err := Parse(query)
+		if err == nil {
+			t.Errorf("Parse(%q) = %+v, want a syntax error", query, doc)
+			continue
+		}
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Parse(%q) error %T is not a *SyntaxError", query, err)
+		}
+		if resp := testSchema().Execute(Request{Query: query}); resp.Data != nil || len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, syntaxErr.Message) {
+			t.Errorf("Execute(%q) = %+v, want only the syntax error", query, resp)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// accessibilityFeatureMaxLength caps the length of one listed accessibility feature
+const accessibilityFeatureMaxLength = 200
+
+// AccessibilityHandler serves the editable accessibility statement
+type AccessibilityHandler struct {
+	db *gorm.DB
+}
+
+// NewAccessibilityHandler creates an accessibility statement handler
+func NewAccessibilityHandler(db *gorm.DB) *AccessibilityHandler {
+	return &AccessibilityHandler{db: db}
+}
+
+// GetStatement handles GET /api/v1/accessibility
+// @Summary Get the accessibility statement
+// @Description Return the site's WCAG conformance level, accessibility features, last audit date and contact
+// @Tags accessibility
+// @Produce json
+// @Success 200 {object} models.AccessibilityStatementResponse
+// @Failure 500 {object} apierror.Response
+// @Router /accessibility [get]
+func (h *AccessibilityHandler) GetStatement(c *gin.Context) {
+	var statement models.AccessibilityStatement
+	if err := requestDB(c, h.db).First(&statement, models.AccessibilityStatementID).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch accessibility statement")
+		return
+	}
+
+	c.JSON(http.StatusOK, statement.ToResponse())
+}
+
+// UpdateStatement handles PUT /api/v1/accessibility
+// @Summary Replace the accessibility statement
+// @Description Replace the WCAG conformance level, features, last audit date and contact
+// @Tags accessibility
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param statement body models.UpdateAccessibilityStatementRequest true "Accessibility statement"
+// @Success 200 {object} models.AccessibilityStatementResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /accessibility [put]
+func (h *AccessibilityHandler) UpdateStatement(c *gin.Context) {
+	var req models.UpdateAccessibilityStatementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	features := make([]string, 0, len(req.Features))
+	for _, feature := range req.Features {
+		// Features are stored one per line
+		feature = strings.Join(strings.Fields(models.SanitizeString(feature)), " ")
+		if feature == "" || len([]rune(feature)) > accessibilityFeatureMaxLength {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "each feature must be between 1 and 200 characters")
+			return
+		}
+		features = append(features, feature)
+	}
+
+	statement := models.AccessibilityStatement{
+		ID:        models.AccessibilityStatementID,
+		WCAGLevel: req.WCAGCompliance,
+		Features:  strings.Join(features, "\n"),
+		LastAudit: req.LastAudit.UTC(),
+		Contact:   strings.TrimSpace(models.SanitizeString(req.Contact)),
+	}
+	if err := requestDB(c, h.db).Save(&statement).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to update accessibility statement")
+		return
+	}
+
+	c.JSON(http.StatusOK, statement.ToResponse())
+}
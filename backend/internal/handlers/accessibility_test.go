@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestAccessibilityStatement(t *testing.T) {
+	db := newTestDB(t)
+	h := NewAccessibilityHandler(db)
+	router := gin.New()
+	router.GET("/accessibility", h.GetStatement)
+	router.PUT("/accessibility", requireTestAPIKey(), h.UpdateStatement)
+
+	// The migration seeds the statement the endpoint used to return
+	w := serve(router, testRequest{method: http.MethodGet, path: "/accessibility"})
+	expectStatus(t, w, http.StatusOK)
+	var raw map[string]interface{}
+	decode(t, w, &raw)
+	for _, key := range []string{"wcag_compliance", "features", "last_audit", "contact"} {
+		if _, ok := raw[key]; !ok {
+			t.Errorf("statement lacks %q: %v", key, raw)
+		}
+	}
+	var seeded models.AccessibilityStatementResponse
+	decode(t, w, &seeded)
+	want := models.DefaultAccessibilityStatement()
+	if seeded.WCAGCompliance != "AA" || !reflect.DeepEqual(seeded.Features, strings.Split(want.Features, "\n")) || seeded.Contact != "" {
+		t.Errorf("seeded statement = %+v", seeded)
+	}
+	if seeded.LastAudit.IsZero() || seeded.LastAudit.Location() != time.UTC {
+		t.Errorf("seeded last_audit = %v, want a UTC time", seeded.LastAudit)
+	}
+
+	update := gin.H{
+		"wcag_compliance": "AAA",
+		"features":        []string{"  Captions   on all video ", "Keyboard\x07 navigation\n"},
+		"last_audit":      "2024-05-01T09:30:00+02:00",
+		"contact":         " access@example.com ",
+	}
+	w = serve(router, testRequest{method: http.MethodPut, path: "/accessibility", body: update})
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	w = serve(router, testRequest{method: http.MethodPut, path: "/accessibility", body: update, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	wantUpdated := models.AccessibilityStatementResponse{
+		WCAGCompliance: "AAA",
+		Features:       []string{"Captions on all video", "Keyboard navigation"},
+		LastAudit:      time.Date(2024, time.May, 1, 7, 30, 0, 0, time.UTC),
+		Contact:        "access@example.com",
+	}
+	var updated models.AccessibilityStatementResponse
+	decode(t, w, &updated)
+	if !reflect.DeepEqual(updated, wantUpdated) {
+		t.Errorf("updated statement = %+v, want %+v", updated, wantUpdated)
+	}
+
+	// The edit is stored in the single statement row
+	w = serve(router, testRequest{method: http.MethodGet, path: "/accessibility"})
+	expectStatus(t, w, http.StatusOK)
+	var stored models.AccessibilityStatementResponse
+	decode(t, w, &stored)
+	if !stored.LastAudit.Equal(wantUpdated.LastAudit) {
+		t.Errorf("stored last_audit = %v, want %v", stored.LastAudit, wantUpdated.LastAudit)
+	}
+	stored.LastAudit = wantUpdated.LastAudit
+	if !reflect.DeepEqual(stored, wantUpdated) {
+		t.Errorf("stored statement = %+v, want %+v", stored, wantUpdated)
+	}
+	var rows int
+	db.Model(&models.AccessibilityStatement{}).Count(&rows)
+	if rows != 1 {
+		t.Errorf("statement rows = %d, want 1", rows)
+	}
+
+	for name, body := range map[string]gin.H{
+		"unknown level":  {"wcag_compliance": "AAAA", "features": []string{"Captions"}, "last_audit": "2024-05-01T00:00:00Z"},
+		"no features":    {"wcag_compliance": "AA", "features": []string{}, "last_audit": "2024-05-01T00:00:00Z"},
+		"blank feature":  {"wcag_compliance": "AA", "features": []string{"Captions", "  "}, "last_audit": "2024-05-01T00:00:00Z"},
+		"long feature":   {"wcag_compliance": "AA", "features": []string{strings.Repeat("x", accessibilityFeatureMaxLength+1)}, "last_audit": "2024-05-01T00:00:00Z"},
+		"no audit date":  {"wcag_compliance": "AA", "features": []string{"Captions"}},
+		"bad audit date": {"wcag_compliance": "AA", "features": []string{"Captions"}, "last_audit": "May 2024"},
+	} {
+		w := serve(router, testRequest{method: http.MethodPut, path: "/accessibility", body: body, apiKey: true})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", name, w.Code)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// recomputeBatchSize is the number of posts recomputed per transaction
+const recomputeBatchSize = 100
+
+// RecomputeDerivedFields handles POST /api/v1/admin/recompute
+// @Summary Recompute derived post fields
+// @Description Recalculate reading time, heading count, rich excerpt and content hash for every post and regenerate empty excerpts, in batches
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} gin.H
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/recompute [post]
+func (h *BlogHandler) RecomputeDerivedFields(c *gin.Context) {
+	var processed, changed, batches int
+	var lastID uint
+
+	for {
+		var blogs []models.Blog
+		if err := requestDB(c, h.db).Select("id, content, excerpt, rich_excerpt, reading_time, heading_count, content_hash").
+			Where("id > ?", lastID).
+			Order("id ASC").
+			Limit(recomputeBatchSize).
+			Find(&blogs).Error; err != nil {
+			c.Error(err)
+			apierror.RespondWith(c, http.StatusInternalServerError, "Failed to fetch blog posts", gin.H{
+				"processed": processed,
+				"changed":   changed,
+			})
+			return
+		}
+		if len(blogs) == 0 {
+			break
+		}
+
+		tx := requestDB(c, h.db).Begin()
+		batchChanged := 0
+		for _, blog := range blogs {
+			updates := map[string]interface{}{}
+			if readingTime := models.CalculateReadingTime(blog.Content); readingTime != blog.ReadingTime {
+				updates["reading_time"] = readingTime
+			}
+			if headingCount := models.CountHeadings(blog.Content); headingCount != blog.HeadingCount {
+				updates["heading_count"] = headingCount
+			}
+			if richExcerpt := models.GenerateRichExcerpt(blog.Content, h.excerptLength); richExcerpt != blog.RichExcerpt {
+				updates["rich_excerpt"] = richExcerpt
+			}
+			if hash := models.ContentHash(blog.Content); hash != blog.ContentHash {
+				updates["content_hash"] = hash
+			}
+			if blog.Excerpt == "" {
+				updates["excerpt"] = models.GenerateExcerpt(blog.Content, h.excerptLength, h.excerptBoundary)
+			}
+			if len(updates) == 0 {
+				continue
+			}
+
+			// UpdateColumns skips hooks and leaves updated_at alone
+			if err := tx.Model(&blog).UpdateColumns(updates).Error; err != nil {
+				tx.Rollback()
+				c.Error(err)
+				apierror.RespondWith(c, http.StatusInternalServerError, "Failed to update blog posts", gin.H{
+					"processed": processed,
+					"changed":   changed,
+				})
+				return
+			}
+			batchChanged++
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			c.Error(err)
+			apierror.RespondWith(c, http.StatusInternalServerError, "Failed to update blog posts", gin.H{
+				"processed": processed,
+				"changed":   changed,
+			})
+			return
+		}
+
+		processed += len(blogs)
+		changed += batchChanged
+		batches++
+		lastID = blogs[len(blogs)-1].ID
+	}
+
+	if changed > 0 {
+		h.invalidateListCache()
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"processed":   processed,
+		"changed":     changed,
+		"batches":     batches,
+		"reading_wpm": models.ReadingWPM(),
+	})
+}
+
+// statsTopTags is the number of tags listed in the admin stats
+const statsTopTags = 10
+
+// statsMonths is the number of calendar months covered by the publishing history
+const statsMonths = 12
+
+// postTotals holds the aggregate counters for the admin stats
+type postTotals struct {
+	Total              int
+	Published          int
+	Featured           int
+	TotalViews         int64
+	AverageReadingTime float64
+}
+
+// monthRow is one month of the publishing history query
+type monthRow struct {
+	Month string
+	Count int
+}
+
+// monthExpression formats published_at as YYYY-MM in the connected dialect
+func monthExpression(db *gorm.DB) string {
+	if db.Dialect().GetName() == "postgres" {
+		return "to_char(published_at, 'YYYY-MM')"
+	}
+	return "strftime('%Y-%m', published_at)"
+}
+
+// GetAdminStats handles GET /api/v1/admin/stats
+// @Summary Get editorial statistics
+// @Description Summarize post counts, views, reading time, the most used tags and posts published per month over the last year
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} models.AdminStats
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/stats [get]
+func (h *BlogHandler) GetAdminStats(c *gin.Context) {
+	var totals postTotals
+	if err := requestDB(c, h.db).Model(&models.Blog{}).
+		Select("COUNT(*) AS total, "+
+			"COALESCE(SUM(CASE WHEN published = ? THEN 1 ELSE 0 END), 0) AS published, "+
+			"COALESCE(SUM(CASE WHEN featured = ? THEN 1 ELSE 0 END), 0) AS featured, "+
+			"COALESCE(SUM(view_count), 0) AS total_views, "+
+			"COALESCE(AVG(reading_time), 0) AS average_reading_time", true, true).
+		Scan(&totals).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to compute post totals")
+		return
+	}
+
+	var tagLists []string
+	if err := requestDB(c, h.db).Model(&models.Blog{}).
+		Where("tags <> ''").
+		Pluck("tags", &tagLists).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch tags")
+		return
+	}
+
+	// Cover the current month and the eleven before it
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -(statsMonths - 1), 0)
+	month := monthExpression(h.db)
+
+	var rows []monthRow
+	if err := requestDB(c, h.db).Model(&models.Blog{}).
+		Select(month+" AS month, COUNT(*) AS count").
+		Where("published = ? AND published_at >= ?", true, start).
+		Group(month).
+		Scan(&rows).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to compute publishing history")
+		return
+	}
+
+	byMonth := make(map[string]int, len(rows))
+	for _, row := range rows {
+		byMonth[row.Month] = row.Count
+	}
+
+	// Report every month, including those with nothing published
+	perMonth := make([]models.MonthlyCount, statsMonths)
+	for i := range perMonth {
+		key := start.AddDate(0, i, 0).Format("2006-01")
+		perMonth[i] = models.MonthlyCount{Month: key, Count: byMonth[key]}
+	}
+
+	c.JSON(http.StatusOK, models.AdminStats{
+		TotalPosts:         totals.Total,
+		PublishedPosts:     totals.Published,
+		DraftPosts:         totals.Total - totals.Published,
+		FeaturedPosts:      totals.Featured,
+		TotalViews:         totals.TotalViews,
+		AverageReadingTime: math.Round(totals.AverageReadingTime*10) / 10,
+		TopTags:            models.TopTags(tagLists, statsTopTags),
+		PublishedPerMonth:  perMonth,
+	})
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+// longParagraph is a first paragraph longer than the excerpt lengths under test
+var longParagraph = "<p>" + strings.Repeat("Accessible <strong>design</strong> helps every reader. ", 20) + "</p>"
+
+func TestExcerptsFollowConfiguredLength(t *testing.T) {
+	const length = 80
+	previous := models.ExcerptLength()
+	models.SetExcerptLength(length)
+	t.Cleanup(func() { models.SetExcerptLength(previous) })
+
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ExcerptLength = length })
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.POST("/admin/recompute", h.RecomputeDerivedFields)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title":   "Configured excerpt length",
+		"content": longParagraph,
+		"author":  "Test Author",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+
+	var blog models.Blog
+	if err := db.First(&blog).Error; err != nil {
+		t.Fatalf("load blog: %v", err)
+	}
+	if n := utf8.RuneCountInString(blog.Excerpt); n > length {
+		t.Errorf("excerpt has %d characters, want at most %d", n, length)
+	}
+	if n := utf8.RuneCountInString(models.VisibleText(blog.RichExcerpt)); n > length {
+		t.Errorf("rich excerpt has %d visible characters, want at most %d", n, length)
+	}
+
+	// Recomputing regenerates the rich excerpt at the configured length
+	rich := blog.RichExcerpt
+	db.Model(&blog).UpdateColumn("rich_excerpt", "")
+	w = serve(router, testRequest{method: http.MethodPost, path: "/admin/recompute"})
+	expectStatus(t, w, http.StatusOK)
+	db.First(&blog, blog.ID)
+	if blog.RichExcerpt != rich {
+		t.Errorf("recomputed rich excerpt = %q, want %q", blog.RichExcerpt, rich)
+	}
+}
+
+func TestRecomputeDerivedFields(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/admin/recompute", requireTestAPIKey(), h.RecomputeDerivedFields)
+
+	long := createTestBlog(t, db, models.Blog{Title: "Long read", Content: "<p>" + strings.Repeat("word ", 1000) + "</p>", Published: true})
+	blank := createTestBlog(t, db, models.Blog{Title: "Blank excerpt"})
+	current := createTestBlog(t, db, models.Blog{Title: "Up to date"})
+
+	// Simulate rows saved before the derived fields changed
+	db.Model(&long).UpdateColumn("reading_time", 1)
+	if err := db.Model(&blank).UpdateColumns(map[string]interface{}{"excerpt": "", "content_hash": "stale"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	var before models.Blog
+	db.First(&before, current.ID)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/admin/recompute"})
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/admin/recompute", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var resp struct {
+		Processed int `json:"processed"`
+		Changed   int `json:"changed"`
+		Batches   int `json:"batches"`
+	}
+	decode(t, w, &resp)
+	if resp.Processed != 3 || resp.Changed != 2 || resp.Batches != 1 {
+		t.Errorf("response = %+v, want 3 processed, 2 changed in 1 batch", resp)
+	}
+
+	var stored models.Blog
+	db.First(&stored, long.ID)
+	if stored.ReadingTime != 5 {
+		t.Errorf("stale reading time recomputed to %d, want 5", stored.ReadingTime)
+	}
+	var regenerated models.Blog
+	db.First(&regenerated, blank.ID)
+	if regenerated.Excerpt == "" || regenerated.ContentHash != models.ContentHash(blank.Content) {
+		t.Errorf("blank excerpt %q and hash %q were not regenerated", regenerated.Excerpt, regenerated.ContentHash)
+	}
+	var untouched models.Blog
+	db.First(&untouched, current.ID)
+	if !untouched.UpdatedAt.Equal(before.UpdatedAt) {
+		t.Errorf("up to date post's updated_at changed from %v to %v", before.UpdatedAt, untouched.UpdatedAt)
+	}
+
+	// A second run finds nothing left to fix
+	w = serve(router, testRequest{method: http.MethodPost, path: "/admin/recompute", apiKey: true})
+	decode(t, w, &resp)
+	if resp.Changed != 0 {
+		t.Errorf("second run changed %d posts, want 0", resp.Changed)
+	}
+}
+
+func TestGetAdminStats(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/admin/stats", requireTestAPIKey(), h.GetAdminStats)
+
+	now := time.Now().UTC()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 12, 0, 0, 0, time.UTC)
+	monthsAgo := func(n int) *time.Time {
+		at := thisMonth.AddDate(0, -n, 0)
+		return &at
+	}
+	words := func(word string, n int) string {
+		return "<p>" + strings.Repeat(word+" ", n) + "</p>"
+	}
+
+	createTestBlog(t, db, models.Blog{Title: "Post A", Published: true, Featured: true, ViewCount: 100, Content: words("alpha", 600), Tags: "react, css", PublishedAt: monthsAgo(0)})
+	createTestBlog(t, db, models.Blog{Title: "Post B", Published: true, ViewCount: 50, Content: words("beta", 200), Tags: "react", PublishedAt: monthsAgo(0)})
+	createTestBlog(t, db, models.Blog{Title: "Post C", Published: true, Featured: true, ViewCount: 10, Content: words("gamma", 1000), Tags: "go", PublishedAt: monthsAgo(2)})
+	createTestBlog(t, db, models.Blog{Title: "Post D", Published: true, ViewCount: 5, Content: words("delta", 200), PublishedAt: monthsAgo(13)})
+	createTestBlog(t, db, models.Blog{Title: "Draft E", Content: words("epsilon", 400), Tags: "react, go"})
+	removed := createTestBlog(t, db, models.Blog{Title: "Removed F", Published: true, Featured: true, ViewCount: 1000, Tags: "spam", PublishedAt: monthsAgo(0)})
+	db.Delete(&removed)
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/admin/stats"})
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	w = serve(router, testRequest{method: http.MethodGet, path: "/admin/stats", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var stats models.AdminStats
+	decode(t, w, &stats)
+
+	if stats.TotalPosts != 5 || stats.PublishedPosts != 4 || stats.DraftPosts != 1 || stats.FeaturedPosts != 2 {
+		t.Errorf("counts = %d total, %d published, %d drafts, %d featured; want 5, 4, 1, 2",
+			stats.TotalPosts, stats.PublishedPosts, stats.DraftPosts, stats.FeaturedPosts)
+	}
+	if stats.TotalViews != 165 {
+		t.Errorf("total_views = %d, want 165", stats.TotalViews)
+	}
+	if stats.AverageReadingTime != 2.4 {
+		t.Errorf("average_reading_time = %v, want 2.4", stats.AverageReadingTime)
+	}
+	wantTags := []models.TagCount{{Tag: "react", Count: 3}, {Tag: "go", Count: 2}, {Tag: "css", Count: 1}}
+	if !reflect.DeepEqual(stats.TopTags, wantTags) {
+		t.Errorf("top_tags = %v, want %v", stats.TopTags, wantTags)
+	}
+
+	if len(stats.PublishedPerMonth) != 12 {
+		t.Fatalf("%d months reported, want 12", len(stats.PublishedPerMonth))
+	}
+	for i, month := range stats.PublishedPerMonth {
+		want := models.MonthlyCount{Month: monthsAgo(11 - i).Format("2006-01")}
+		switch 11 - i {
+		case 0:
+			want.Count = 2
+		case 2:
+			want.Count = 1
+		}
+		if month != want {
+			t.Errorf("month %d = %+v, want %+v", i, month, want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// archiveMaxYear bounds the year accepted by the month archive
+const archiveMaxYear = 9999
+
+// archiveDateExpressions extracts the year and month of published_at as
+// integers in the connected dialect
+func archiveDateExpressions(db *gorm.DB) (string, string) {
+	if db.Dialect().GetName() == "postgres" {
+		return "CAST(EXTRACT(YEAR FROM published_at) AS INTEGER)", "CAST(EXTRACT(MONTH FROM published_at) AS INTEGER)"
+	}
+	return "CAST(strftime('%Y', published_at) AS INTEGER)", "CAST(strftime('%m', published_at) AS INTEGER)"
+}
+
+// GetArchive handles GET /api/v1/archive
+// @Summary Get the date archive
+// @Description Count published posts per calendar month, newest month first
+// @Tags archive
+// @Produce json
+// @Success 200 {array} models.ArchiveMonth
+// @Failure 500 {object} apierror.Response
+// @Router /archive [get]
+func (h *BlogHandler) GetArchive(c *gin.Context) {
+	db := requestDB(c, h.db)
+	year, month := archiveDateExpressions(db)
+
+	months := []models.ArchiveMonth{}
+	if err := db.Model(&models.Blog{}).
+		Select(year+" AS year, "+month+" AS month, COUNT(*) AS post_count").
+		Where("published = ? AND published_at IS NOT NULL", true).
+		Group(year + ", " + month).
+		Order("year DESC, month DESC").
+		Scan(&months).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch archive")
+		return
+	}
+
+	c.JSON(http.StatusOK, months)
+}
+
+// GetArchiveMonth handles GET /api/v1/archive/:year/:month
+// @Summary Get posts published in a month
+// @Description Paginated list of posts published in the given calendar month (UTC), newest first
+// @Tags archive
+// @Produce json
+// @Param year path int true "Year, e.g. 2024"
+// @Param month path int true "Month from 1 to 12"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} models.BlogListResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /archive/{year}/{month} [get]
+func (h *BlogHandler) GetArchiveMonth(c *gin.Context) {
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil || year < 1 || year > archiveMaxYear {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid year", "year must be an integer between 1 and "+strconv.Itoa(archiveMaxYear))
+		return
+	}
+	month, err := strconv.Atoi(c.Param("month"))
+	if err != nil || month < 1 || month > 12 {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid month", "month must be an integer between 1 and 12")
+		return
+	}
+
+	page, limit, ok := h.parsePagination(c)
+	if !ok {
+		return
+	}
+
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	query := requestDB(c, h.db).Model(&models.Blog{}).
+		Where("published = ? AND published_at >= ? AND published_at < ?", true, start, end)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to count blogs")
+		return
+	}
+
+	offset := (page - 1) * limit
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+
+	var blogs []models.Blog
+	if err := query.Order("published_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&blogs).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blogs")
+		return
+	}
+
+	blogResponses := make([]models.BlogResponse, len(blogs))
+	for i, blog := range blogs {
+		blogResponses[i] = blog.ToResponse(false)
+	}
+
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.Header("X-Page", strconv.Itoa(page))
+	c.Header("X-Per-Page", strconv.Itoa(limit))
+
+	c.JSON(http.StatusOK, models.BlogListResponse{
+		Blogs:      blogResponses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+		Links:      paginationLinks(c, page, totalPages),
+	})
+}
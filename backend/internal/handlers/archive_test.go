@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+// timeOf returns a pointer to t
+func timeOf(t time.Time) *time.Time {
+	return &t
+}
+
+// createArchivePosts publishes posts across a few months, around month boundaries, and adds a draft
+func createArchivePosts(t *testing.T, h *BlogHandler) {
+	t.Helper()
+	for _, post := range []struct {
+		title       string
+		publishedAt time.Time
+	}{
+		{"Late 2023", time.Date(2023, time.December, 31, 23, 59, 59, 0, time.UTC)},
+		{"New year", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"Mid January", time.Date(2024, time.January, 15, 9, 30, 0, 0, time.UTC)},
+		{"End of January", time.Date(2024, time.January, 31, 23, 59, 59, 0, time.UTC)},
+		{"Start of February", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"March post", time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)},
+	} {
+		createTestBlog(t, h.db, models.Blog{Title: post.title, Published: true, PublishedAt: timeOf(post.publishedAt)})
+	}
+	createTestBlog(t, h.db, models.Blog{Title: "January draft", PublishedAt: timeAt(time.January, 20)})
+}
+
+func TestGetArchive(t *testing.T) {
+	h, _ := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/archive", h.GetArchive)
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/archive"})
+	expectStatus(t, w, http.StatusOK)
+	if w.Body.String() != "[]" {
+		t.Errorf("empty archive = %s, want []", w.Body.String())
+	}
+
+	createArchivePosts(t, h)
+	w = serve(router, testRequest{method: http.MethodGet, path: "/archive"})
+	expectStatus(t, w, http.StatusOK)
+	var months []models.ArchiveMonth
+	decode(t, w, &months)
+	want := []models.ArchiveMonth{
+		{Year: 2024, Month: 3, PostCount: 1},
+		{Year: 2024, Month: 2, PostCount: 1},
+		{Year: 2024, Month: 1, PostCount: 3},
+		{Year: 2023, Month: 12, PostCount: 1},
+	}
+	if !reflect.DeepEqual(months, want) {
+		t.Errorf("archive = %+v, want %+v", months, want)
+	}
+}
+
+func TestGetArchiveMonth(t *testing.T) {
+	h, _ := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/archive/:year/:month", h.GetArchiveMonth)
+	createArchivePosts(t, h)
+
+	tests := []struct {
+		path   string
+		titles []string
+		total  string
+	}{
+		{"/archive/2024/1", []string{"End of January", "Mid January", "New year"}, "3"},
+		{"/archive/2024/01", []string{"End of January", "Mid January", "New year"}, "3"},
+		{"/archive/2024/2", []string{"Start of February"}, "1"},
+		{"/archive/2023/12", []string{"Late 2023"}, "1"},
+		{"/archive/2024/4", []string{}, "0"},
+		{"/archive/2024/1?limit=2", []string{"End of January", "Mid January"}, "3"},
+		{"/archive/2024/1?limit=2&page=2", []string{"New year"}, "3"},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodGet, path: tt.path})
+		expectStatus(t, w, http.StatusOK)
+		if got := w.Header().Get("X-Total-Count"); got != tt.total {
+			t.Errorf("%s: X-Total-Count = %s, want %s", tt.path, got, tt.total)
+		}
+		if got := listTitles(t, router, tt.path); !reflect.DeepEqual(got, tt.titles) {
+			t.Errorf("%s: titles = %v, want %v", tt.path, got, tt.titles)
+		}
+	}
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/archive/2024/1?limit=2"})
+	var resp models.BlogListResponse
+	decode(t, w, &resp)
+	if resp.TotalPages != 2 || !resp.HasNext || resp.HasPrev || resp.Blogs[0].Content != "" {
+		t.Errorf("page 1 of 2: total pages %d, has next %v, has prev %v, content %q", resp.TotalPages, resp.HasNext, resp.HasPrev, resp.Blogs[0].Content)
+	}
+
+	for _, path := range []string{
+		"/archive/0/1",
+		"/archive/10000/1",
+		"/archive/twenty/1",
+		"/archive/2024/0",
+		"/archive/2024/13",
+		"/archive/2024/jan",
+	} {
+		expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: path}), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// publishedAuthors lists the authors of published posts with their post counts,
+// most prolific first
+func (h *BlogHandler) publishedAuthors(c *gin.Context) ([]models.AuthorCount, error) {
+	authors := []models.AuthorCount{}
+	if err := requestDB(c, h.db).Model(&models.Blog{}).
+		Select("author, COUNT(*) AS post_count").
+		Where("published = ?", true).
+		Group("author").
+		Order("post_count DESC, author ASC").
+		Scan(&authors).Error; err != nil {
+		return nil, err
+	}
+	for i := range authors {
+		authors[i].Slug = models.GenerateSlug(authors[i].Author)
+	}
+	return authors, nil
+}
+
+// GetAuthors handles GET /api/v1/authors
+// @Summary List authors
+// @Description List distinct author names with their published post counts, most prolific first
+// @Tags authors
+// @Produce json
+// @Success 200 {array} models.AuthorCount
+// @Failure 500 {object} apierror.Response
+// @Router /authors [get]
+func (h *BlogHandler) GetAuthors(c *gin.Context) {
+	authors, err := h.publishedAuthors(c)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch authors")
+		return
+	}
+
+	c.JSON(http.StatusOK, authors)
+}
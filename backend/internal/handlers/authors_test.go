@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/database"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestGetAuthors(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/authors", h.GetAuthors)
+	if _, _, err := database.Seed(db, false); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	createTestBlog(t, db, models.Blog{Title: "Second by Alex", Author: "Alex Rivera", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Third by Alex", Author: "Alex Rivera", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Unfinished by Alex", Author: "Alex Rivera"})
+	removed := createTestBlog(t, db, models.Blog{Title: "Removed by Alex", Author: "Alex Rivera", Published: true})
+	db.Delete(&removed)
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/authors"})
+	expectStatus(t, w, http.StatusOK)
+	var authors []models.AuthorCount
+	decode(t, w, &authors)
+
+	// Every seeded author has one published post except the two with only drafts
+	if len(authors) != 10 {
+		t.Fatalf("%d authors listed, want 10: %+v", len(authors), authors)
+	}
+	if want := (models.AuthorCount{Author: "Alex Rivera", Slug: "alex-rivera", PostCount: 3}); authors[0] != want {
+		t.Errorf("first author = %+v, want %+v", authors[0], want)
+	}
+	names := make([]string, 0, len(authors)-1)
+	for _, author := range authors[1:] {
+		if author.PostCount != 1 {
+			t.Errorf("%s: post_count = %d, want 1", author.Author, author.PostCount)
+		}
+		if author.Author == "Lisa Wang" || author.Author == "Rachel Thompson" {
+			t.Errorf("%s listed but has only drafts", author.Author)
+		}
+		names = append(names, author.Author)
+	}
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("authors with equal counts are not ordered by name: %v", names)
+	}
+}
@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/webhooks"
+)
+
+// bindBatchRequest parses and validates a batch request, returning its
+// de-duplicated IDs in request order
+func bindBatchRequest(c *gin.Context) ([]uint, bool) {
+	var req models.BatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return nil, false
+	}
+	return batchIDs(c, req.IDs)
+}
+
+// batchIDs de-duplicates and bounds the IDs of a batch request, writing a 400
+// response when none remain or there are too many
+func batchIDs(c *gin.Context, requested []uint) ([]uint, bool) {
+	seen := make(map[uint]bool)
+	ids := []uint{}
+	for _, id := range requested {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "ids must contain at least one blog ID")
+		return nil, false
+	}
+	if len(ids) > models.MaxBatchSize {
+		apierror.Respond(c, http.StatusBadRequest, "Batch too large", fmt.Sprintf("At most %d IDs may be sent per request, got %d", models.MaxBatchSize, len(ids)))
+		return nil, false
+	}
+
+	return ids, true
+}
+
+// GetBlogsBySlugs handles GET /api/v1/blogs/batch
+// @Summary Get several blog posts by slug
+// @Description Fetch published posts by slug in the requested order, without content, listing slugs that match no published post
+// @Tags blogs
+// @Produce json
+// @Param slugs query string true "Comma-separated slugs, at most 50"
+// @Success 200 {object} models.BatchBlogsResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/batch [get]
+func (h *BlogHandler) GetBlogsBySlugs(c *gin.Context) {
+	seen := make(map[string]bool)
+	slugs := []string{}
+	for _, slug := range strings.Split(c.Query("slugs"), ",") {
+		slug = models.CanonicalSlug(slug)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		slugs = append(slugs, slug)
+	}
+
+	if len(slugs) == 0 {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "slugs must contain at least one slug")
+		return
+	}
+	if len(slugs) > models.MaxBatchSlugs {
+		apierror.Respond(c, http.StatusBadRequest, "Batch too large", fmt.Sprintf("At most %d slugs may be sent per request, got %d", models.MaxBatchSlugs, len(slugs)))
+		return
+	}
+
+	var blogs []models.Blog
+	if err := requestDB(c, h.db).Where("slug IN (?) AND published = ?", slugs, true).Find(&blogs).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blogs")
+		return
+	}
+	bySlug := make(map[string]models.Blog, len(blogs))
+	for _, blog := range blogs {
+		bySlug[blog.Slug] = blog
+	}
+
+	response := models.BatchBlogsResponse{Blogs: []models.BlogResponse{}, NotFound: []string{}}
+	for _, slug := range slugs {
+		blog, ok := bySlug[slug]
+		if !ok {
+			response.NotFound = append(response.NotFound, slug)
+			continue
+		}
+		response.Blogs = append(response.Blogs, blog.ToResponse(false))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// splitFound partitions ids into those present in blogs and those missing
+func splitFound(ids []uint, blogs []models.Blog) ([]uint, []uint) {
+	found := make(map[uint]bool, len(blogs))
+	for _, blog := range blogs {
+		found[blog.ID] = true
+	}
+
+	processed, notFound := []uint{}, []uint{}
+	for _, id := range ids {
+		if found[id] {
+			processed = append(processed, id)
+		} else {
+			notFound = append(notFound, id)
+		}
+	}
+	return processed, notFound
+}
+
+// BatchDeleteBlogs handles POST /api/v1/blogs/batch-delete
+// @Summary Delete several blog posts
+// @Description Soft delete up to 100 posts in one transaction, reporting which IDs were deleted and which were not found
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.BatchRequest true "Blog IDs"
+// @Success 200 {object} models.BatchDeleteResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/batch-delete [post]
+func (h *BlogHandler) BatchDeleteBlogs(c *gin.Context) {
+	ids, ok := bindBatchRequest(c)
+	if !ok {
+		return
+	}
+
+	tx := requestDB(c, h.db).Begin()
+
+	var blogs []models.Blog
+	if err := tx.Where("id IN (?)", ids).Find(&blogs).Error; err != nil {
+		tx.Rollback()
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog posts")
+		return
+	}
+
+	if err := tx.Where("id IN (?)", ids).Delete(&models.Blog{}).Error; err != nil {
+		tx.Rollback()
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to delete blog posts")
+		return
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to delete blog posts")
+		return
+	}
+
+	deleted, notFound := splitFound(ids, blogs)
+
+	if len(blogs) > 0 {
+		h.invalidateListCache()
+		for _, blog := range blogs {
+			h.webhooks.Dispatch(webhooks.EventBlogDeleted, blog.ToResponse(false))
+		}
+	}
+
+	c.JSON(http.StatusOK, models.BatchDeleteResponse{
+		Deleted:  deleted,
+		NotFound: notFound,
+	})
+}
+
+// BatchPublishBlogs handles POST /api/v1/blogs/batch-publish
+// @Summary Publish or unpublish several blog posts
+// @Description Set the published state of up to 100 posts in one transaction. Publishing sets published_at if unset and unpublishing clears it.
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.BatchPublishRequest true "Blog IDs and the published state"
+// @Success 200 {object} models.BatchPublishResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/batch-publish [post]
+func (h *BlogHandler) BatchPublishBlogs(c *gin.Context) {
+	var req models.BatchPublishRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+	ids, ok := batchIDs(c, req.IDs)
+	if !ok {
+		return
+	}
+	published := *req.Published
+
+	tx := requestDB(c, h.db).Begin()
+
+	var blogs []models.Blog
+	if err := tx.Select("id, published").Where("id IN (?)", ids).Find(&blogs).Error; err != nil {
+		tx.Rollback()
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog posts")
+		return
+	}
+
+	changed := []uint{}
+	for _, blog := range blogs {
+		if blog.Published != published {
+			changed = append(changed, blog.ID)
+		}
+	}
+
+	if len(changed) > 0 {
+		// UpdateColumns skips the Before hooks, so apply their published_at rules here
+		now := time.Now()
+		updates := map[string]interface{}{
+			"published":    published,
+			"published_at": nil,
+			"version":      gorm.Expr("version + 1"),
+			"updated_at":   now,
+		}
+		if published {
+			updates["published_at"] = gorm.Expr("COALESCE(published_at, ?)", now)
+		}
+		if err := tx.Model(&models.Blog{}).Where("id IN (?)", changed).UpdateColumns(updates).Error; err != nil {
+			tx.Rollback()
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to update blog posts")
+			return
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to update blog posts")
+		return
+	}
+
+	// Reload the posts for their current state and the event payloads
+	if err := requestDB(c, h.db).Where("id IN (?)", ids).Find(&blogs).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog posts")
+		return
+	}
+	byID := make(map[uint]models.Blog, len(blogs))
+	for _, blog := range blogs {
+		byID[blog.ID] = blog
+	}
+	isChanged := make(map[uint]bool, len(changed))
+	for _, id := range changed {
+		isChanged[id] = true
+	}
+
+	results := make([]models.BatchPublishResult, len(ids))
+	for i, id := range ids {
+		blog, found := byID[id]
+		switch {
+		case !found:
+			results[i] = models.BatchPublishResult{ID: id, Status: models.BatchStatusNotFound}
+			continue
+		case isChanged[id]:
+			results[i].Status = models.BatchStatusUpdated
+		default:
+			results[i].Status = models.BatchStatusUnchanged
+		}
+		results[i].ID = id
+		results[i].Published = blog.Published
+		results[i].PublishedAt = blog.PublishedAt
+	}
+
+	if len(changed) > 0 {
+		h.invalidateListCache()
+		for _, id := range changed {
+			blog := byID[id]
+			h.webhooks.Dispatch(webhooks.EventBlogUpdated, blog.ToResponse(false))
+			if blog.Published {
+				h.webhooks.Dispatch(webhooks.EventBlogPublished, blog.ToResponse(false))
+				h.notifySubscribers(blog)
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, models.BatchPublishResponse{Results: results})
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestBatchDeleteBlogs(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs/batch-delete", requireTestAPIKey(), h.BatchDeleteBlogs)
+
+	spam := createTestBlog(t, db, models.Blog{Title: "Spam one", Published: true})
+	draft := createTestBlog(t, db, models.Blog{Title: "Spam draft"})
+	kept := createTestBlog(t, db, models.Blog{Title: "Kept post", Published: true})
+	gone := createTestBlog(t, db, models.Blog{Title: "Already gone", Published: true})
+	db.Delete(&gone)
+
+	body := gin.H{"ids": []uint{spam.ID, 9999, draft.ID, spam.ID, gone.ID, 0}}
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/batch-delete", body: body})
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs/batch-delete", body: body, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.BatchDeleteResponse
+	decode(t, w, &resp)
+	if want := []uint{spam.ID, draft.ID}; !reflect.DeepEqual(resp.Deleted, want) {
+		t.Errorf("deleted = %v, want %v", resp.Deleted, want)
+	}
+	if want := []uint{9999, gone.ID}; !reflect.DeepEqual(resp.NotFound, want) {
+		t.Errorf("not_found = %v, want %v", resp.NotFound, want)
+	}
+
+	var remaining []models.Blog
+	db.Find(&remaining)
+	if len(remaining) != 1 || remaining[0].ID != kept.ID {
+		t.Errorf("remaining posts = %+v, want only %q", remaining, kept.Title)
+	}
+	var softDeleted int
+	db.Unscoped().Model(&models.Blog{}).Where("id IN (?) AND deleted_at IS NOT NULL", []uint{spam.ID, draft.ID}).Count(&softDeleted)
+	if softDeleted != 2 {
+		t.Errorf("%d of the deleted posts kept as soft deleted rows, want 2", softDeleted)
+	}
+
+	tooMany := make([]uint, models.MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = uint(i + 1)
+	}
+	for name, ids := range map[string][]uint{"empty": {}, "only zero": {0}, "over the cap": tooMany} {
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/batch-delete", body: gin.H{"ids": ids}, apiKey: true})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", name, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestBatchPublishBlogs(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs/batch-publish", requireTestAPIKey(), h.BatchPublishBlogs)
+
+	first := createTestBlog(t, db, models.Blog{Title: "First draft"})
+	second := createTestBlog(t, db, models.Blog{Title: "Second draft"})
+	live := createTestBlog(t, db, models.Blog{Title: "Already live", Published: true, PublishedAt: timeAt(time.March, 1)})
+	for _, blog := range []models.Blog{first, second} {
+		if blog.PublishedAt != nil {
+			t.Fatalf("draft %q has published_at %v", blog.Title, blog.PublishedAt)
+		}
+	}
+
+	publish := func(published bool, ids ...uint) []models.BatchPublishResult {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/batch-publish", body: gin.H{"ids": ids, "published": published}, apiKey: true})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BatchPublishResponse
+		decode(t, w, &resp)
+		return resp.Results
+	}
+	load := func(id uint) models.Blog {
+		t.Helper()
+		var blog models.Blog
+		if err := db.First(&blog, id).Error; err != nil {
+			t.Fatalf("load post %d: %v", id, err)
+		}
+		return blog
+	}
+
+	before := time.Now().Add(-time.Second)
+	results := publish(true, first.ID, 9999, second.ID, live.ID)
+	statuses := make([]string, len(results))
+	for i, result := range results {
+		statuses[i] = result.Status
+	}
+	wantStatuses := []string{models.BatchStatusUpdated, models.BatchStatusNotFound, models.BatchStatusUpdated, models.BatchStatusUnchanged}
+	if !reflect.DeepEqual(statuses, wantStatuses) {
+		t.Fatalf("statuses = %v, want %v", statuses, wantStatuses)
+	}
+	if results[1].ID != 9999 || results[1].Published || results[1].PublishedAt != nil {
+		t.Errorf("not found result = %+v", results[1])
+	}
+
+	// Newly published posts get a publish date; an already published post keeps its own
+	for _, draft := range []models.Blog{first, second} {
+		stored := load(draft.ID)
+		if !stored.Published || stored.PublishedAt == nil || stored.PublishedAt.Before(before) {
+			t.Errorf("%q after publish: published %v, published_at %v; want published now", draft.Title, stored.Published, stored.PublishedAt)
+		}
+		if stored.Version != draft.Version+1 {
+			t.Errorf("%q version = %d, want %d", draft.Title, stored.Version, draft.Version+1)
+		}
+	}
+	if stored := load(live.ID); !stored.PublishedAt.Equal(*live.PublishedAt) || stored.Version != live.Version {
+		t.Errorf("unchanged post: published_at %v, version %d; want %v, %d", stored.PublishedAt, stored.Version, live.PublishedAt, live.Version)
+	}
+	if results[0].PublishedAt == nil || !results[0].PublishedAt.Equal(*load(first.ID).PublishedAt) {
+		t.Errorf("result published_at = %v, want the stored date", results[0].PublishedAt)
+	}
+
+	// Unpublishing clears the date, for posts published in a batch or on their own
+	results = publish(false, first.ID, live.ID)
+	for i, blog := range []models.Blog{first, live} {
+		stored := load(blog.ID)
+		if stored.Published || stored.PublishedAt != nil {
+			t.Errorf("%q after unpublish: published %v, published_at %v; want a draft without a date", blog.Title, stored.Published, stored.PublishedAt)
+		}
+		if results[i].Status != models.BatchStatusUpdated || results[i].Published || results[i].PublishedAt != nil {
+			t.Errorf("%q result = %+v", blog.Title, results[i])
+		}
+	}
+	if stored := load(second.ID); !stored.Published || stored.PublishedAt == nil {
+		t.Errorf("post left out of the batch was unpublished: %+v", stored)
+	}
+
+	// Republishing sets a fresh date
+	before = time.Now().Add(-time.Second)
+	publish(true, live.ID)
+	if stored := load(live.ID); stored.PublishedAt == nil || stored.PublishedAt.Before(before) {
+		t.Errorf("republished published_at = %v, want a new date", stored.PublishedAt)
+	}
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/batch-publish", body: gin.H{"ids": []uint{first.ID}, "published": true}})
+	expectStatus(t, w, http.StatusUnauthorized)
+	for _, body := range []gin.H{{"ids": []uint{first.ID}}, {"ids": []uint{}, "published": true}, {"ids": []uint{0}, "published": true}} {
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/batch-publish", body: body, apiKey: true})
+		expectStatus(t, w, http.StatusBadRequest)
+	}
+}
+
+func TestGetBlogsBySlugs(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/batch", h.GetBlogsBySlugs)
+
+	for _, title := range []string{"Alpha", "Bravo", "Charlie"} {
+		createTestBlog(t, db, models.Blog{Title: title, Published: true})
+	}
+	createTestBlog(t, db, models.Blog{Title: "Draft"})
+	gone := createTestBlog(t, db, models.Blog{Title: "Gone", Published: true})
+	db.Delete(&gone)
+
+	// Requested order is kept, duplicates and blanks dropped, slugs matched case-insensitively
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/batch?slugs=charlie,missing,ALPHA/,draft,,charlie,gone,bravo"})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.BatchBlogsResponse
+	decode(t, w, &resp)
+	slugs := make([]string, len(resp.Blogs))
+	for i, blog := range resp.Blogs {
+		slugs[i] = blog.Slug
+		if blog.Content != "" {
+			t.Errorf("%s: content included in the batch response", blog.Slug)
+		}
+	}
+	if want := []string{"charlie", "alpha", "bravo"}; !reflect.DeepEqual(slugs, want) {
+		t.Errorf("blogs = %q, want %q", slugs, want)
+	}
+	if want := []string{"missing", "draft", "gone"}; !reflect.DeepEqual(resp.NotFound, want) {
+		t.Errorf("not_found = %q, want %q", resp.NotFound, want)
+	}
+
+	// Nothing found still succeeds, with empty arrays rather than null
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/batch?slugs=nope"})
+	expectStatus(t, w, http.StatusOK)
+	if body := w.Body.String(); body != `{"blogs":[],"not_found":["nope"]}` {
+		t.Errorf("body = %s", body)
+	}
+
+	atCap := make([]string, models.MaxBatchSlugs)
+	for i := range atCap {
+		atCap[i] = "post-" + itoa(uint(i))
+	}
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/batch?slugs=" + strings.Join(atCap, ",")})
+	expectStatus(t, w, http.StatusOK)
+	for name, query := range map[string]string{
+		"missing":      "",
+		"blank":        "?slugs=,%20,",
+		"over the cap": "?slugs=" + strings.Join(atCap, ",") + ",one-more",
+	} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/batch" + query})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", name, w.Code, http.StatusBadRequest)
+		}
+	}
+}
@@ -1,25 +1,86 @@
 package handlers
 
 import (
+	"encoding/json"
+	"html"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/cache"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/mailer"
+	"technoprise-blog-backend/internal/middleware"
 	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/preview"
+	"technoprise-blog-backend/internal/scanner"
+	"technoprise-blog-backend/internal/webhooks"
 )
 
 // BlogHandler handles blog-related HTTP requests
 type BlogHandler struct {
-	db *gorm.DB
+	db                *gorm.DB
+	views             *viewWriter
+	maxRevisions      int
+	maxFeatured       int
+	siteURL           string
+	webhooks          *webhooks.Dispatcher
+	listCache         *cache.TTLCache
+	countCache        *cache.TTLCache
+	previews          *preview.Signer
+	mailer            *mailer.Mailer
+	strictFields      bool
+	strictPagination  bool
+	similarThreshold  float64
+	excerptLength     int
+	excerptBoundary   models.ExcerptBoundary
+	commentMaxDepth   int
+	validateImageURLs bool
+	defaultPublished  bool
+	seedEnabled       bool
+	imageClient       *http.Client
+	linkClient        *http.Client
+	linkCache         *cache.TTLCache
+	apiKey            string
+	scanner           *scanner.Scanner
 }
 
-// NewBlogHandler creates a new blog handler
-func NewBlogHandler(db *gorm.DB) *BlogHandler {
-	return &BlogHandler{db: db}
+// NewBlogHandler creates a new blog handler. A nil contentScanner disables
+// content scanning.
+func NewBlogHandler(db *gorm.DB, cfg config.Blog, dispatcher *webhooks.Dispatcher, previews *preview.Signer, mail *mailer.Mailer, contentScanner *scanner.Scanner) *BlogHandler {
+	return &BlogHandler{
+		db:                db,
+		views:             newViewWriter(db),
+		maxRevisions:      cfg.MaxRevisions,
+		maxFeatured:       cfg.MaxFeatured,
+		siteURL:           cfg.SiteURL,
+		webhooks:          dispatcher,
+		listCache:         cache.NewTTLCache(cfg.ListCacheTTL),
+		countCache:        cache.NewTTLCache(cfg.CountCacheTTL),
+		previews:          previews,
+		mailer:            mail,
+		strictFields:      cfg.StrictFields,
+		strictPagination:  cfg.StrictPagination,
+		similarThreshold:  cfg.SimilarTitleThreshold,
+		excerptLength:     cfg.ExcerptLength,
+		excerptBoundary:   cfg.ExcerptBoundary,
+		commentMaxDepth:   cfg.CommentMaxDepth,
+		validateImageURLs: cfg.ValidateImageURLs,
+		defaultPublished:  cfg.DefaultPublished,
+		seedEnabled:       cfg.SeedDatabase,
+		imageClient:       &http.Client{Timeout: imageCheckTimeout},
+		linkClient:        newLinkClient(),
+		linkCache:         cache.NewTTLCache(linkCheckCacheTTL),
+		apiKey:            cfg.APIKey,
+		scanner:           contentScanner,
+	}
 }
 
 // GetBlogs handles GET /api/v1/blogs
@@ -29,37 +90,103 @@ func NewBlogHandler(db *gorm.DB) *BlogHandler {
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(10)
-// @Param search query string false "Search term"
+// @Param limit query int false "Items per page, up to MAX_PAGE_SIZE" default(10)
+// @Param search query string false "Search term; results are ordered by relevance"
 // @Param featured query bool false "Filter by featured posts"
-// @Param published query bool false "Filter by published posts" default(true)
+// @Param published query string false "Filter by published posts; all lists drafts too and requires the API key" Enums(true, false, all) default(true)
+// @Param author query string false "Filter by author name"
+// @Param tag query string false "Filter by tag"
+// @Param tags query string false "Filter by comma-separated tags, e.g. react,accessibility"
+// @Param tag_mode query string false "Match any or all of the tags" Enums(any, all) default(any)
+// @Param lang query string false "Filter by ISO 639-1 language code"
+// @Param from query string false "Published on or after (RFC3339)"
+// @Param to query string false "Published on or before (RFC3339)"
+// @Param fields query string false "Comma-separated response fields to include, e.g. title,slug,excerpt"
+// @Param max_tags query int false "Tags to return per post; the rest are counted in tag_overflow"
+// @Param since query string false "Reader's last visit (RFC3339); posts published after it get is_new. Future times are ignored."
 // @Success 200 {object} models.BlogListResponse
-// @Failure 400 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /blogs [get]
 func (h *BlogHandler) GetBlogs(c *gin.Context) {
 	// Parse query parameters
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	search := c.Query("search")
 	featuredParam := c.Query("featured")
 	publishedParam := c.DefaultQuery("published", "true")
+	author := strings.TrimSpace(c.Query("author"))
+	tag := strings.TrimSpace(c.Query("tag"))
+	tags := splitTags(c.Query("tags"))
+	tagMode := c.DefaultQuery("tag_mode", "any")
+	lang := models.NormalizeLanguage(c.Query("lang"))
+	fromParam := c.Query("from")
+	toParam := c.Query("to")
+
+	// Drafts are listed alongside published posts only for authenticated callers,
+	// checked before the cache so a cached admin listing is never served publicly
+	allStatuses := publishedParam == models.PublishedAll
+	if allStatuses && !middleware.HasAPIKey(c, h.apiKey) {
+		c.Header("WWW-Authenticate", `Bearer realm="api"`)
+		apierror.Respond(c, http.StatusUnauthorized, "Unauthorized", "published=all requires a valid API key")
+		return
+	}
 
-	// Validate pagination parameters
-	if page < 1 {
-		page = 1
+	page, limit, ok := h.parsePagination(c)
+	if !ok {
+		return
 	}
-	if limit < 1 || limit > 100 {
-		limit = 10
+
+	fields, ok := h.parseFields(c)
+	if !ok {
+		return
+	}
+
+	// Return every tag unless ?max_tags= caps them
+	maxTags := -1
+	if value := c.Query("max_tags"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid max_tags", "max_tags must be a non-negative integer")
+			return
+		}
+		maxTags = parsed
+	}
+
+	// Mark posts published since the reader's last visit; a time in the future
+	// would mark nothing, so it is ignored
+	var since time.Time
+	if value := c.Query("since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid since date", "since must be an RFC3339 timestamp, e.g. 2024-01-02T15:04:05Z")
+			return
+		}
+		if !parsed.After(time.Now()) {
+			since = parsed
+		}
+	}
+
+	// Serve repeated non-search listings from the cache. Last-visit times differ
+	// for every reader, so listings marked with them are not cached.
+	cacheable := search == "" && since.IsZero() && h.listCache.Enabled()
+	cacheKey := listCacheKey(c)
+	if cacheable && h.serveCachedList(c, cacheKey) {
+		return
 	}
 
 	// Build query
-	query := h.db.Model(&models.Blog{})
+	query := requestDB(c, h.db).Model(&models.Blog{})
 
 	// Filter by published status
-	if published, err := strconv.ParseBool(publishedParam); err == nil {
+	published, err := strconv.ParseBool(publishedParam)
+	if err == nil {
 		query = query.Where("published = ?", published)
+	} else if !allStatuses {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid published filter", "published must be true, false or all")
+		return
 	}
+	unfiltered := err == nil && published && featuredParam == "" && search == "" && author == "" &&
+		tag == "" && len(tags) == 0 && lang == "" && fromParam == "" && toParam == ""
 
 	// Filter by featured status
 	if featuredParam != "" {
@@ -70,19 +197,58 @@ func (h *BlogHandler) GetBlogs(c *gin.Context) {
 
 	// Search functionality
 	if search != "" {
-		searchTerm := "%" + strings.ToLower(search) + "%"
-		query = query.Where(
-			"LOWER(title) LIKE ? OR LOWER(content) LIKE ? OR LOWER(excerpt) LIKE ? OR LOWER(tags) LIKE ?",
-			searchTerm, searchTerm, searchTerm, searchTerm,
-		)
+		query = whereMatchesSearch(query, search)
+	}
+
+	// Filter by author
+	if author != "" {
+		query = query.Where("LOWER(author) = ?", strings.ToLower(author))
+	}
+
+	// Filter by tag, matching whole tags only
+	if tag != "" {
+		query = whereHasTag(query, tag)
 	}
 
-	// Get total count
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to count blogs",
-		})
+	// Filter by several tags, requiring any or all of them
+	if tagMode != "any" && tagMode != "all" {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid tag mode", "tag_mode must be any or all")
+		return
+	}
+	if len(tags) > 0 {
+		query = whereHasTags(query, tags, tagMode == "all")
+	}
+
+	// Filter by language
+	if lang != "" {
+		if !models.IsValidLanguage(lang) {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid language", "lang must be a two-letter ISO 639-1 code, e.g. es")
+			return
+		}
+		query = query.Where("language = ?", lang)
+	}
+
+	// Filter by publish date range
+	if fromParam != "" {
+		from, err := time.Parse(time.RFC3339, fromParam)
+		if err != nil {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid from date", "from must be an RFC3339 timestamp, e.g. 2024-01-02T15:04:05Z")
+			return
+		}
+		query = query.Where("published_at >= ?", from)
+	}
+	if toParam != "" {
+		to, err := time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid to date", "to must be an RFC3339 timestamp, e.g. 2024-01-02T15:04:05Z")
+			return
+		}
+		query = query.Where("published_at <= ?", to)
+	}
+
+	// Get total count, reusing a recent count for the unfiltered published listing
+	total, ok := h.countBlogs(c, query, unfiltered)
+	if !ok {
 		return
 	}
 
@@ -90,15 +256,20 @@ func (h *BlogHandler) GetBlogs(c *gin.Context) {
 	offset := (page - 1) * limit
 	totalPages := int(math.Ceil(float64(total) / float64(limit)))
 
-	// Fetch blogs
+	// Fetch blogs, most relevant first when searching. The id tiebreaker keeps
+	// the order stable so consecutive pages never repeat or skip posts.
+	ordered := query.Order("created_at DESC").Order("id DESC")
+	if search != "" {
+		ordered = orderBySearchRank(query, search)
+	}
+
 	var blogs []models.Blog
-	if err := query.Order("created_at DESC").
+	if err := ordered.
 		Offset(offset).
 		Limit(limit).
 		Find(&blogs).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to fetch blogs",
-		})
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blogs")
 		return
 	}
 
@@ -106,6 +277,10 @@ func (h *BlogHandler) GetBlogs(c *gin.Context) {
 	blogResponses := make([]models.BlogResponse, len(blogs))
 	for i, blog := range blogs {
 		blogResponses[i] = blog.ToResponse(false) // Don't include full content in list
+		blogResponses[i].LimitTags(maxTags)
+		if !since.IsZero() {
+			blogResponses[i].MarkNew(since)
+		}
 	}
 
 	// Prepare response
@@ -117,57 +292,292 @@ func (h *BlogHandler) GetBlogs(c *gin.Context) {
 		TotalPages: totalPages,
 		HasNext:    page < totalPages,
 		HasPrev:    page > 1,
+		Links:      paginationLinks(c, page, totalPages),
+	}
+
+	// Trim posts to the requested fields
+	var payload interface{} = response
+	if fields != nil {
+		trimmed, err := trimBlogList(response, fields)
+		if err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to encode blogs")
+			return
+		}
+		payload = trimmed
 	}
 
 	// Set accessibility headers
 	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
 	c.Header("X-Page", strconv.Itoa(page))
-	c.Header("X-Per-Page", strconv.Itoa(limit))
 
-	c.JSON(http.StatusOK, response)
+	if !cacheable {
+		c.Header("X-Cache", "BYPASS")
+		c.JSON(http.StatusOK, payload)
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to encode blogs")
+		return
+	}
+	h.storeCachedList(c, cacheKey, body, "X-Total-Count", "X-Page", "X-Per-Page", "Link")
+
+	c.Header("X-Cache", "MISS")
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
 }
 
 // GetBlogBySlug handles GET /api/v1/blogs/:slug
 // @Summary Get a single blog post by slug
-// @Description Retrieve a blog post by its slug and increment view count
+// @Description Retrieve a blog post by its slug and increment view count. A valid preview_token also returns unpublished drafts.
 // @Tags blogs
 // @Accept json
 // @Produce json
 // @Param slug path string true "Blog slug"
-// @Success 200 {object} models.BlogResponse
-// @Failure 404 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Param preview_token query string false "Draft preview token"
+// @Param fields query string false "Comma-separated response fields to include, e.g. title,slug,content"
+// @Param reduced_motion query bool false "Remove autoplay and loop from media and flag GIF images; changes are listed in X-Reduced-Motion-Altered"
+// @Success 200 {object} models.BlogDetailResponse
+// @Header 200 {string} X-Reduced-Motion-Altered "Changes made for reduced_motion, e.g. video autoplay=1, img animated-gif=2, or none"
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 410 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /blogs/{slug} [get]
 func (h *BlogHandler) GetBlogBySlug(c *gin.Context) {
 	slug := c.Param("slug")
 
-	var blog models.Blog
-	if err := h.db.Where("slug = ? AND published = ?", slug, true).First(&blog).Error; err != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": "Blog post not found",
-			})
+	fields, ok := h.parseFields(c)
+	if !ok {
+		return
+	}
+
+	blog, ok := h.findBlogBySlug(c, slug)
+	if !ok {
+		return
+	}
+
+	// Increment view count asynchronously so the response isn't held up;
+	// draft previews are not counted
+	if blog.Published {
+		h.views.Record(blog.ID)
+	}
+
+	setBlogHeaders(c, blog)
+
+	// Clients honouring prefers-reduced-motion get markup that does not move on its own
+	if reducedMotion, _ := strconv.ParseBool(c.Query("reduced_motion")); reducedMotion {
+		content, changes := models.ReducedMotionHTML(blog.Content)
+		blog.Content = content
+		altered := make([]string, len(changes))
+		for i, change := range changes {
+			altered[i] = change.String()
+		}
+		if len(altered) == 0 {
+			altered = append(altered, "none")
+		}
+		c.Header("X-Reduced-Motion-Altered", strings.Join(altered, ", "))
+	}
+
+	response := models.BlogDetailResponse{
+		BlogResponse: blog.ToResponse(true), // Include full content for single blog view
+		Prev:         h.adjacentPost(c, blog, false),
+		Next:         h.adjacentPost(c, blog, true),
+		Series:       h.seriesContext(c, blog),
+	}
+
+	// List the other published language variants of this post
+	var variants []models.Blog
+	if err := requestDB(c, h.db).Select("language, slug").
+		Where("translation_group = ? AND id <> ? AND published = ?", blog.TranslationGroup, blog.ID, true).
+		Order("language ASC").
+		Find(&variants).Error; err != nil {
+		c.Error(err)
+	}
+	response.Translations = make([]models.Translation, len(variants))
+	for i, variant := range variants {
+		response.Translations[i] = models.Translation{Language: variant.Language, Slug: variant.Slug}
+	}
+
+	// Attach social sharing and structured data metadata
+	meta := blog.OpenGraph(h.siteURL)
+	response.Meta = &meta
+	if jsonLD, err := blog.JSONLD(h.siteURL); err == nil {
+		response.JSONLD = jsonLD
+	}
+
+	if fields != nil {
+		trimmed, err := selectFields(response, fields)
+		if err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to encode blog post")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to fetch blog post",
-		})
+		c.JSON(http.StatusOK, trimmed)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// HeadBlogBySlug handles HEAD /api/v1/blogs/:slug
+// @Summary Get blog post headers
+// @Description Return the status and SEO headers of a blog post without a body or counting a view
+// @Tags blogs
+// @Param slug path string true "Blog slug"
+// @Param preview_token query string false "Draft preview token"
+// @Success 200
+// @Failure 403
+// @Failure 404
+// @Failure 410
+// @Router /blogs/{slug} [head]
+func (h *BlogHandler) HeadBlogBySlug(c *gin.Context) {
+	blog, ok := h.findBlogBySlug(c, c.Param("slug"))
+	if !ok {
 		return
 	}
 
-	// Increment view count
-	if err := h.db.Model(&blog).UpdateColumn("view_count", gorm.Expr("view_count + ?", 1)).Error; err != nil {
-		// Log error but don't fail the request
-		// log.Printf("Failed to increment view count for blog %d: %v", blog.ID, err)
+	setBlogHeaders(c, blog)
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Status(http.StatusOK)
+}
+
+// findBlogBySlug loads a published post by slug, or a draft when a valid
+// preview token is supplied, writing an error response on failure
+func (h *BlogHandler) findBlogBySlug(c *gin.Context, slug string) (models.Blog, bool) {
+	// Redirect uppercase or trailing-slash variants to the canonical URL
+	if canonical := models.CanonicalSlug(slug); canonical != slug && h.slugExists(c, canonical) {
+		redirectToSlug(c, canonical)
+		return models.Blog{}, false
+	}
+
+	if token := c.Query(previewTokenParam); token != "" {
+		return h.getPreviewBlog(c, slug, token)
 	}
 
-	// Set SEO and accessibility headers
+	var blog models.Blog
+	if err := requestDB(c, h.db).Where("slug = ? AND published = ?", slug, true).First(&blog).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			h.respondMissingBlog(c, slug)
+			return blog, false
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return blog, false
+	}
+	return blog, true
+}
+
+// slugExists reports whether slug names a post the request may read: any post
+// when a preview token is sent, otherwise only published ones
+func (h *BlogHandler) slugExists(c *gin.Context, slug string) bool {
+	query := requestDB(c, h.db).Model(&models.Blog{}).Where("slug = ?", slug)
+	if c.Query(previewTokenParam) == "" {
+		query = query.Where("published = ?", true)
+	}
+
+	var count int
+	if err := query.Count(&count).Error; err != nil {
+		c.Error(err)
+		return false
+	}
+	return count > 0
+}
+
+// redirectToSlug permanently redirects to the same route with the slug
+// segment replaced, keeping the query string
+func redirectToSlug(c *gin.Context, slug string) {
+	path := strings.TrimRight(c.Request.URL.Path, "/")
+	location := path[:strings.LastIndex(path, "/")+1] + url.PathEscape(slug)
+	if c.Request.URL.RawQuery != "" {
+		location += "?" + c.Request.URL.RawQuery
+	}
+	c.Redirect(http.StatusMovedPermanently, location)
+}
+
+// setBlogHeaders sets the SEO, accessibility and caching headers for a single post
+func setBlogHeaders(c *gin.Context, blog models.Blog) {
 	c.Header("X-Meta-Title", blog.MetaTitle)
 	c.Header("X-Meta-Description", blog.MetaDesc)
 	c.Header("X-Reading-Time", strconv.Itoa(blog.ReadingTime))
+	c.Header("ETag", blogETag(blog))
+	c.Header("Last-Modified", blog.UpdatedAt.UTC().Format(http.TimeFormat))
+}
 
-	response := blog.ToResponse(true) // Include full content for single blog view
-	c.JSON(http.StatusOK, response)
+// respondMissingBlog responds 410 Gone for a published post that was since
+// deleted, so crawlers drop it, and 404 for slugs that never existed or are
+// unpublished so drafts stay hidden
+func (h *BlogHandler) respondMissingBlog(c *gin.Context, slug string) {
+	var deleted models.Blog
+	err := requestDB(c, h.db).Unscoped().Select("id").
+		Where("slug = ? AND published = ? AND deleted_at IS NOT NULL", slug, true).
+		First(&deleted).Error
+	if err == nil {
+		apierror.Respond(c, http.StatusGone, "Blog post no longer available", "This post has been removed and will not return")
+		return
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		c.Error(err)
+	}
+
+	apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+}
+
+// GetBlogStats handles GET /api/v1/blogs/:slug/stats
+// @Summary Get content statistics for a blog post
+// @Description Retrieve word count, reading time, structure counts, and readability for a published post
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param slug path string true "Blog slug"
+// @Success 200 {object} models.ContentStats
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{slug}/stats [get]
+func (h *BlogHandler) GetBlogStats(c *gin.Context) {
+	slug := c.Param("slug")
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id, content").Where("slug = ? AND published = ?", slug, true).First(&blog).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.CalculateContentStats(blog.Content))
+}
+
+// GetBlogSections handles GET /api/v1/blogs/:slug/sections
+// @Summary Get a blog post split into sections
+// @Description Split a published post's content at its top-level headings, in order, for per-section rendering and reading progress
+// @Tags blogs
+// @Produce json
+// @Param slug path string true "Blog slug"
+// @Success 200 {array} models.ContentSection
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{slug}/sections [get]
+func (h *BlogHandler) GetBlogSections(c *gin.Context) {
+	slug := c.Param("slug")
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id, content").Where("slug = ? AND published = ?", slug, true).First(&blog).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SplitSections(blog.Content))
 }
 
 // CreateBlog handles POST /api/v1/blogs
@@ -177,61 +587,304 @@ func (h *BlogHandler) GetBlogBySlug(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param blog body models.CreateBlogRequest true "Blog data"
-// @Success 201 {object} models.BlogResponse
-// @Failure 400 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Param allow_duplicate query bool false "Allow content identical to an existing post"
+// @Param strict query bool false "Reject content flagged by the content scanner instead of warning"
+// @Param Idempotency-Key header string false "Key making retries return the original response"
+// @Success 201 {object} models.CreateBlogResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /blogs [post]
 func (h *BlogHandler) CreateBlog(c *gin.Context) {
+	// Replay the original response when a retried request reuses its key
+	key, ok := idempotencyKey(c)
+	if !ok {
+		return
+	}
+	if key != "" && h.replayIdempotentCreate(c, key) {
+		return
+	}
+
 	var req models.CreateBlogRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid request data",
-			"details": err.Error(),
-		})
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	// Strip markup outside the sanitizer policy before anything else reads it
+	req.Content = models.SanitizeHTML(req.Content)
+
+	// Reject posts with no meaningful text once markup is removed
+	if !h.validateVisibleContent(c, req.Content) {
 		return
 	}
 
-	// Generate slug if not provided
-	slug := models.GenerateSlug(req.Title)
-	
-	// Check if slug already exists
-	var existingBlog models.Blog
-	if !h.db.Where("slug = ?", slug).First(&existingBlog).RecordNotFound() {
-		// Append timestamp to make slug unique
-		slug = slug + "-" + strconv.FormatInt(time.Now().Unix(), 10)
+	tags, ok := h.normalizeTags(c, req.Tags)
+	if !ok {
+		return
+	}
+
+	featuredImage, ok := h.validateFeaturedImage(c, req.FeaturedImage)
+	if !ok {
+		return
+	}
+	featuredImageAlt := models.SanitizeString(req.FeaturedImageAlt)
+	if !h.validateFeaturedImageAlt(c, featuredImage, featuredImageAlt) {
+		return
+	}
+
+	// Validate language and translation linkage
+	language := models.NormalizeLanguage(req.Language)
+	if language == "" {
+		language = models.DefaultLanguage
+	}
+	if !models.IsValidLanguage(language) {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid language", "language must be a two-letter ISO 639-1 code, e.g. es")
+		return
+	}
+	translationGroup := strings.TrimSpace(req.TranslationGroup)
+	if translationGroup != "" {
+		if !models.IsValidTranslationGroup(translationGroup) {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid translation group", "translation_group must be the UUID of an existing post's translation group")
+			return
+		}
+		var variants []models.Blog
+		if err := requestDB(c, h.db).Select("id, language, slug").Where("translation_group = ?", translationGroup).Find(&variants).Error; err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch translations")
+			return
+		}
+		if len(variants) == 0 {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid translation group", "translation_group must be the UUID of an existing post's translation group")
+			return
+		}
+		for _, variant := range variants {
+			if variant.Language == language {
+				apierror.RespondWith(c, http.StatusConflict, "A translation in this language already exists",
+					gin.H{"conflicting_slug": variant.Slug})
+				return
+			}
+		}
+	}
+
+	// Place the post in its series, after the last part unless an order is given
+	var seriesID *uint
+	seriesOrder := 0
+	if req.SeriesID != 0 {
+		order, ok := h.resolveSeriesOrder(c, req.SeriesID, req.SeriesOrder)
+		if !ok {
+			return
+		}
+		seriesID, seriesOrder = &req.SeriesID, order
+	}
+
+	// Reject exact duplicates of existing content unless explicitly allowed
+	if allowDuplicate, _ := strconv.ParseBool(c.Query("allow_duplicate")); !allowDuplicate {
+		var duplicate models.Blog
+		err := requestDB(c, h.db).Select("id, slug").
+			Where("content_hash = ?", models.ContentHash(models.SanitizeString(req.Content))).
+			First(&duplicate).Error
+		if err == nil {
+			apierror.RespondWith(c, http.StatusConflict, "A blog post with identical content already exists",
+				gin.H{"conflicting_slug": duplicate.Slug})
+			return
+		}
+		if !gorm.IsRecordNotFoundError(err) {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to check for duplicate content")
+			return
+		}
+	}
+
+	// Warn about, or with ?strict=true reject, personal data and profanity
+	contentWarnings, ok := h.scanContent(c, req.Title, req.Excerpt, req.Content)
+	if !ok {
+		return
+	}
+
+	// Warn about, but still allow, titles close to existing ones
+	similarPosts := h.findSimilarPosts(c, models.SanitizeString(req.Title))
+
+	// Generate slug, suffixing a number if it is already taken
+	slug, err := h.availableSlug(c, models.GenerateSlug(req.Title), 0)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to generate a unique slug")
+		return
 	}
 
 	// Generate excerpt if not provided
-	excerpt := req.Excerpt
+	excerpt := models.SanitizeString(req.Excerpt)
+	if !h.validateExcerpt(c, excerpt) {
+		return
+	}
 	if excerpt == "" {
-		excerpt = models.GenerateExcerpt(req.Content, 300)
+		excerpt = models.GenerateExcerpt(req.Content, h.excerptLength, h.excerptBoundary)
+	}
+
+	// Generate SEO metadata if not provided
+	metaTitle := models.SanitizeString(req.MetaTitle)
+	if metaTitle == "" {
+		metaTitle = models.GenerateMetaTitle(models.SanitizeString(req.Title))
+	}
+	metaDesc := models.SanitizeString(req.MetaDesc)
+	if metaDesc == "" {
+		metaDesc = models.GenerateMetaDesc(models.SanitizeString(excerpt))
+	}
+
+	// Fall back to the configured default when published is omitted
+	published := h.defaultPublished
+	if req.Published != nil {
+		published = *req.Published
+	}
+
+	allowComments := true
+	if req.AllowComments != nil {
+		allowComments = *req.AllowComments
 	}
 
 	// Create blog post
 	blog := models.Blog{
-		Title:     models.SanitizeString(req.Title),
-		Slug:      slug,
-		Content:   models.SanitizeString(req.Content),
-		Excerpt:   models.SanitizeString(excerpt),
-		Author:    models.SanitizeString(req.Author),
-		Published: req.Published,
-		Featured:  req.Featured,
-		Tags:      models.SanitizeString(req.Tags),
-		MetaTitle: models.SanitizeString(req.MetaTitle),
-		MetaDesc:  models.SanitizeString(req.MetaDesc),
+		Title:            models.SanitizeString(req.Title),
+		Slug:             slug,
+		Content:          models.SanitizeString(req.Content),
+		Excerpt:          models.SanitizeString(excerpt),
+		Author:           models.SanitizeString(req.Author),
+		Published:        published,
+		Featured:         req.Featured,
+		Tags:             tags,
+		FeaturedImage:    featuredImage,
+		FeaturedImageAlt: featuredImageAlt,
+		MetaTitle:        metaTitle,
+		MetaDesc:         metaDesc,
+		Language:         language,
+		TranslationGroup: translationGroup,
+		SeriesID:         seriesID,
+		SeriesOrder:      seriesOrder,
 	}
 
-	if err := h.db.Create(&blog).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to create blog post",
-		})
+	tx := requestDB(c, h.db).Begin()
+	if err := tx.Create(&blog).Error; err != nil {
+		tx.Rollback()
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to create blog post")
 		return
 	}
 
-	response := blog.ToResponse(true)
+	// gorm skips false for columns with a default, so write it separately
+	if !allowComments {
+		if err := tx.Model(&blog).UpdateColumn("allow_comments", false).Error; err != nil {
+			tx.Rollback()
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to create blog post")
+			return
+		}
+	}
+
+	if key != "" {
+		if err := saveIdempotencyKey(tx, key, blog.ID); err != nil {
+			tx.Rollback()
+			// A concurrent request with the same key may have won the race
+			if h.replayIdempotentCreate(c, key) {
+				return
+			}
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to create blog post")
+			return
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to create blog post")
+		return
+	}
+
+	h.invalidateListCache()
+	h.webhooks.Dispatch(webhooks.EventBlogCreated, blog.ToResponse(false))
+	if blog.Published {
+		h.webhooks.Dispatch(webhooks.EventBlogPublished, blog.ToResponse(false))
+		h.notifySubscribers(blog)
+	}
+
+	response := models.CreateBlogResponse{
+		BlogResponse:    blog.ToResponse(true),
+		SimilarPosts:    similarPosts,
+		ContentWarnings: contentWarnings,
+		SEOWarnings:     models.SEOWarnings(blog.MetaTitle, blog.MetaDesc),
+	}
+	c.Header("ETag", blogETag(blog))
 	c.JSON(http.StatusCreated, response)
 }
 
+// scanContent runs the content scanner over the submitted text fields. Findings
+// are returned as warnings, or reject the request when ?strict=true is set.
+func (h *BlogHandler) scanContent(c *gin.Context, fields ...string) ([]scanner.Finding, bool) {
+	if h.scanner == nil {
+		return nil, true
+	}
+
+	findings := h.scanner.Scan(html.UnescapeString(models.VisibleText(strings.Join(fields, "\n"))))
+	if len(findings) == 0 {
+		return nil, true
+	}
+	if strict, _ := strconv.ParseBool(c.Query("strict")); strict {
+		apierror.RespondWith(c, http.StatusUnprocessableEntity, "Content contains personal data or profanity",
+			gin.H{"content_warnings": findings})
+		return nil, false
+	}
+	return findings, true
+}
+
+// findSimilarPosts returns existing posts whose titles overlap title beyond the
+// configured threshold. Lookup failures are recorded but never block creation.
+func (h *BlogHandler) findSimilarPosts(c *gin.Context, title string) []models.SimilarPost {
+	if h.similarThreshold <= 0 {
+		return nil
+	}
+
+	var posts []models.Blog
+	if err := requestDB(c, h.db).Select("id, slug, title").Find(&posts).Error; err != nil {
+		c.Error(err)
+		return nil
+	}
+	return models.FindSimilarTitles(title, posts, h.similarThreshold)
+}
+
+// validateVisibleContent rejects content whose visible text is too short, writing a 400 response
+func (h *BlogHandler) validateVisibleContent(c *gin.Context, content string) bool {
+	if models.VisibleTextLength(content) < models.MinVisibleContentLength {
+		apierror.Respond(c, http.StatusBadRequest, "Content is too short", "content must contain at least "+strconv.Itoa(models.MinVisibleContentLength)+" characters of visible text")
+		return false
+	}
+	return true
+}
+
+// validateExcerpt rejects excerpts longer than the excerpt column, counting
+// characters rather than bytes, writing a 400 response
+func (h *BlogHandler) validateExcerpt(c *gin.Context, excerpt string) bool {
+	if length := utf8.RuneCountInString(excerpt); length > models.ExcerptMaxLength {
+		apierror.Respond(c, http.StatusBadRequest, "Excerpt is too long", "excerpt must be at most "+strconv.Itoa(models.ExcerptMaxLength)+" characters, got "+strconv.Itoa(length))
+		return false
+	}
+	return true
+}
+
+// normalizeTags validates and canonicalizes a tag string, writing a 400 response when invalid
+func (h *BlogHandler) normalizeTags(c *gin.Context, raw string) (string, bool) {
+	tags, err := models.NormalizeTags(models.SanitizeString(raw))
+	if err != nil {
+		extra := gin.H{}
+		if tagErr, ok := err.(*models.TagValidationError); ok && len(tagErr.Invalid) > 0 {
+			extra["invalid_tags"] = tagErr.Invalid
+		}
+		apierror.RespondWith(c, http.StatusBadRequest, "Invalid tags", extra, err.Error())
+		return "", false
+	}
+	return tags, true
+}
+
 // UpdateBlog handles PUT /api/v1/blogs/:id
 // @Summary Update a blog post
 // @Description Update an existing blog post
@@ -240,56 +893,91 @@ func (h *BlogHandler) CreateBlog(c *gin.Context) {
 // @Produce json
 // @Param id path int true "Blog ID"
 // @Param blog body models.UpdateBlogRequest true "Updated blog data"
-// @Success 200 {object} models.BlogResponse
-// @Failure 400 {object} gin.H
-// @Failure 404 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Param strict query bool false "Reject content flagged by the content scanner instead of warning"
+// @Success 200 {object} models.UpdateBlogResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /blogs/{id} [put]
 func (h *BlogHandler) UpdateBlog(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid blog ID",
-		})
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
 		return
 	}
 
 	var req models.UpdateBlogRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid request data",
-			"details": err.Error(),
-		})
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	if req.Content != nil {
+		content := models.SanitizeHTML(*req.Content)
+		req.Content = &content
+		if !h.validateVisibleContent(c, content) {
+			return
+		}
+	}
+	if req.Language != nil && !models.IsValidLanguage(models.NormalizeLanguage(*req.Language)) {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid language", "language must be a two-letter ISO 639-1 code, e.g. es")
+		return
+	}
+
+	// Warn about, or with ?strict=true reject, personal data and profanity
+	scanned := []string{}
+	for _, field := range []*string{req.Title, req.Excerpt, req.Content} {
+		if field != nil {
+			scanned = append(scanned, *field)
+		}
+	}
+	contentWarnings, ok := h.scanContent(c, scanned...)
+	if !ok {
 		return
 	}
 
 	var blog models.Blog
-	if err := h.db.First(&blog, id).Error; err != nil {
+	if err := requestDB(c, h.db).First(&blog, id).Error; err != nil {
 		if gorm.IsRecordNotFoundError(err) {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": "Blog post not found",
-			})
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to fetch blog post",
-		})
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	// Reject edits based on a stale copy of the post
+	if !checkVersion(c, blog, req.Version) {
 		return
 	}
 
+	wasPublished := blog.Published
+
 	// Update fields if provided
 	updates := make(map[string]interface{})
-	
+
 	if req.Title != nil {
 		updates["title"] = models.SanitizeString(*req.Title)
-		// Regenerate slug if title changed
-		updates["slug"] = models.GenerateSlug(*req.Title)
+		// Regenerate slug if title changed, suffixing a number if another post has it
+		slug, err := h.availableSlug(c, models.GenerateSlug(*req.Title), blog.ID)
+		if err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to generate a unique slug")
+			return
+		}
+		updates["slug"] = slug
 	}
 	if req.Content != nil {
 		updates["content"] = models.SanitizeString(*req.Content)
 	}
 	if req.Excerpt != nil {
-		updates["excerpt"] = models.SanitizeString(*req.Excerpt)
+		excerpt := models.SanitizeString(*req.Excerpt)
+		if !h.validateExcerpt(c, excerpt) {
+			return
+		}
+		updates["excerpt"] = excerpt
 	}
 	if req.Author != nil {
 		updates["author"] = models.SanitizeString(*req.Author)
@@ -300,8 +988,39 @@ func (h *BlogHandler) UpdateBlog(c *gin.Context) {
 	if req.Featured != nil {
 		updates["featured"] = *req.Featured
 	}
+	if req.AllowComments != nil {
+		updates["allow_comments"] = *req.AllowComments
+	}
 	if req.Tags != nil {
-		updates["tags"] = models.SanitizeString(*req.Tags)
+		tags, ok := h.normalizeTags(c, *req.Tags)
+		if !ok {
+			return
+		}
+		updates["tags"] = tags
+	}
+	if req.FeaturedImage != nil {
+		featuredImage, ok := h.validateFeaturedImage(c, *req.FeaturedImage)
+		if !ok {
+			return
+		}
+		updates["featured_image"] = featuredImage
+	}
+	if req.FeaturedImageAlt != nil {
+		updates["featured_image_alt"] = models.SanitizeString(*req.FeaturedImageAlt)
+	}
+
+	// The resulting image must still carry alt text
+	if req.FeaturedImage != nil || req.FeaturedImageAlt != nil {
+		featuredImage, featuredImageAlt := blog.FeaturedImage, blog.FeaturedImageAlt
+		if value, ok := updates["featured_image"].(string); ok {
+			featuredImage = value
+		}
+		if value, ok := updates["featured_image_alt"].(string); ok {
+			featuredImageAlt = value
+		}
+		if !h.validateFeaturedImageAlt(c, featuredImage, featuredImageAlt) {
+			return
+		}
 	}
 	if req.MetaTitle != nil {
 		updates["meta_title"] = models.SanitizeString(*req.MetaTitle)
@@ -310,66 +1029,227 @@ func (h *BlogHandler) UpdateBlog(c *gin.Context) {
 		updates["meta_desc"] = models.SanitizeString(*req.MetaDesc)
 	}
 
-	if err := h.db.Model(&blog).Updates(updates).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to update blog post",
-		})
+	if req.Language != nil {
+		updates["language"] = models.NormalizeLanguage(*req.Language)
+	}
+
+	// Move the post between series; joining a new series without an order appends it
+	if req.SeriesID != nil && *req.SeriesID == 0 {
+		updates["series_id"] = nil
+		updates["series_order"] = 0
+	} else if req.SeriesID != nil || req.SeriesOrder != nil {
+		seriesID := blog.SeriesID
+		if req.SeriesID != nil {
+			seriesID = req.SeriesID
+		}
+		if seriesID == nil {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid series", "series_order requires the post to be in a series")
+			return
+		}
+		order := 0
+		if req.SeriesOrder != nil {
+			order = *req.SeriesOrder
+		} else if blog.SeriesID != nil && *blog.SeriesID == *seriesID {
+			order = blog.SeriesOrder
+		}
+		order, ok := h.resolveSeriesOrder(c, *seriesID, order)
+		if !ok {
+			return
+		}
+		updates["series_id"] = *seriesID
+		updates["series_order"] = order
+	}
+
+	// Regenerate SEO metadata when it would otherwise remain empty
+	if metaTitle, ok := updates["meta_title"].(string); (ok && metaTitle == "") || (!ok && blog.MetaTitle == "") {
+		title := blog.Title
+		if newTitle, ok := updates["title"].(string); ok {
+			title = newTitle
+		}
+		updates["meta_title"] = models.GenerateMetaTitle(title)
+	}
+	if metaDesc, ok := updates["meta_desc"].(string); (ok && metaDesc == "") || (!ok && blog.MetaDesc == "") {
+		excerpt := blog.Excerpt
+		if newExcerpt, ok := updates["excerpt"].(string); ok {
+			excerpt = newExcerpt
+		}
+		updates["meta_desc"] = models.GenerateMetaDesc(excerpt)
+	}
+
+	tx := requestDB(c, h.db).Begin()
+
+	// Snapshot the previous version when revisable fields change
+	if req.Title != nil || req.Content != nil || req.Excerpt != nil {
+		if err := h.saveRevision(tx, &blog); err != nil {
+			tx.Rollback()
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to save revision")
+			return
+		}
+	}
+
+	// Guard on the version read above so concurrent edits can't both win
+	updates["version"] = gorm.Expr("version + 1")
+	result := tx.Model(&blog).Where("version = ?", blog.Version).Updates(updates)
+	if result.Error != nil {
+		tx.Rollback()
+		c.Error(result.Error)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to update blog post")
+		return
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		var current models.Blog
+		if err := requestDB(c, h.db).Select("version").First(&current, id).Error; err != nil {
+			c.Error(err)
+		}
+		respondVersionConflict(c, current.Version)
+		return
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to update blog post")
 		return
 	}
 
 	// Fetch updated blog
-	if err := h.db.First(&blog, id).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to fetch updated blog post",
-		})
+	if err := requestDB(c, h.db).First(&blog, id).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch updated blog post")
 		return
 	}
 
-	response := blog.ToResponse(true)
+	h.invalidateListCache()
+	h.webhooks.Dispatch(webhooks.EventBlogUpdated, blog.ToResponse(false))
+	if blog.Published && !wasPublished {
+		h.webhooks.Dispatch(webhooks.EventBlogPublished, blog.ToResponse(false))
+		h.notifySubscribers(blog)
+	}
+
+	response := models.UpdateBlogResponse{
+		BlogResponse:    blog.ToResponse(true),
+		ContentWarnings: contentWarnings,
+		SEOWarnings:     models.SEOWarnings(blog.MetaTitle, blog.MetaDesc),
+	}
+	c.Header("ETag", blogETag(blog))
 	c.JSON(http.StatusOK, response)
 }
 
 // DeleteBlog handles DELETE /api/v1/blogs/:id
 // @Summary Delete a blog post
-// @Description Delete a blog post by ID
+// @Description Soft delete a blog post by ID
 // @Tags blogs
 // @Accept json
 // @Produce json
 // @Param id path int true "Blog ID"
 // @Success 204 "No Content"
-// @Failure 400 {object} gin.H
-// @Failure 404 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /blogs/{id} [delete]
 func (h *BlogHandler) DeleteBlog(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid blog ID",
-		})
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
 		return
 	}
 
 	var blog models.Blog
-	if err := h.db.First(&blog, id).Error; err != nil {
+	if err := requestDB(c, h.db).First(&blog, id).Error; err != nil {
 		if gorm.IsRecordNotFoundError(err) {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": "Blog post not found",
-			})
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to fetch blog post",
-		})
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
 		return
 	}
 
-	if err := h.db.Delete(&blog).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to delete blog post",
-		})
+	if err := requestDB(c, h.db).Delete(&blog).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to delete blog post")
 		return
 	}
 
+	h.invalidateListCache()
+	h.webhooks.Dispatch(webhooks.EventBlogDeleted, blog.ToResponse(false))
+
 	c.Status(http.StatusNoContent)
 }
+
+// SetFeatured handles PATCH /api/v1/blogs/:id/featured
+// @Summary Toggle featured status
+// @Description Update only the featured flag of a blog post, enforcing MAX_FEATURED when set
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Param featured body models.SetFeaturedRequest true "Featured flag"
+// @Success 200 {object} models.BlogResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/featured [patch]
+func (h *BlogHandler) SetFeatured(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	var req models.SetFeaturedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+	if req.Featured == nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "featured is required")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	// Enforce the featured limit only when newly featuring a post
+	if *req.Featured && !blog.Featured && h.maxFeatured > 0 {
+		var featuredCount int64
+		if err := requestDB(c, h.db).Model(&models.Blog{}).Where("featured = ?", true).Count(&featuredCount).Error; err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to count featured blogs")
+			return
+		}
+		if featuredCount >= int64(h.maxFeatured) {
+			apierror.RespondWith(c, http.StatusConflict, "Maximum number of featured posts reached",
+				gin.H{"max_featured": h.maxFeatured})
+			return
+		}
+	}
+
+	if err := requestDB(c, h.db).Model(&blog).UpdateColumns(map[string]interface{}{
+		"featured": *req.Featured,
+		"version":  gorm.Expr("version + 1"),
+	}).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to update blog post")
+		return
+	}
+
+	blog.Version++
+
+	h.invalidateListCache()
+	h.webhooks.Dispatch(webhooks.EventBlogUpdated, blog.ToResponse(false))
+
+	response := blog.ToResponse(true)
+	c.Header("ETag", blogETag(blog))
+	c.JSON(http.StatusOK, response)
+}
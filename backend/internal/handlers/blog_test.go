@@ -0,0 +1,472 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/database"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestGetBlogBySlugMissingPosts(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+
+	createTestBlog(t, db, models.Blog{Title: "Live post", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Draft post"})
+	removed := createTestBlog(t, db, models.Blog{Title: "Removed post", Published: true})
+	removedDraft := createTestBlog(t, db, models.Blog{Title: "Removed draft"})
+	db.Delete(&removed)
+	db.Delete(&removedDraft)
+
+	tests := []struct {
+		slug string
+		want int
+	}{
+		{"live-post", http.StatusOK},
+		{"never-existed", http.StatusNotFound},
+		{"draft-post", http.StatusNotFound},
+		{"removed-post", http.StatusGone},
+		{"removed-draft", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + tt.slug})
+		if w.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.slug, w.Code, tt.want)
+			continue
+		}
+		if tt.want == http.StatusOK {
+			continue
+		}
+		var resp apierror.Response
+		decode(t, w, &resp)
+		if want := apierror.CodeFor(tt.want); resp.Code != want {
+			t.Errorf("%s: code = %q, want %q", tt.slug, resp.Code, want)
+		}
+		if tt.want == http.StatusGone && len(resp.Details) == 0 {
+			t.Errorf("%s: 410 body does not explain the removal: %+v", tt.slug, resp)
+		}
+	}
+}
+
+func TestUpdateBlogRecomputesReadingTime(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	blog := createTestBlog(t, db, models.Blog{})
+	if blog.ReadingTime != 1 {
+		t.Fatalf("initial reading time = %d, want 1", blog.ReadingTime)
+	}
+
+	// 1000 words at the default 200 words per minute
+	content := "<p>" + strings.Repeat("word ", 1000) + "</p>"
+	w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{
+		"content": content,
+		"version": blog.Version,
+	}})
+	expectStatus(t, w, http.StatusOK)
+
+	var updated models.BlogResponse
+	decode(t, w, &updated)
+	if updated.ReadingTime != 5 {
+		t.Errorf("response reading time = %d, want 5", updated.ReadingTime)
+	}
+	var stored models.Blog
+	db.First(&stored, blog.ID)
+	if stored.ReadingTime != 5 {
+		t.Errorf("stored reading time = %d, want 5", stored.ReadingTime)
+	}
+
+	// Edits that leave the content alone keep the stored reading time
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{
+		"excerpt": "A different excerpt",
+		"version": stored.Version,
+	}})
+	expectStatus(t, w, http.StatusOK)
+	db.First(&stored, blog.ID)
+	if stored.ReadingTime != 5 {
+		t.Errorf("reading time after excerpt edit = %d, want 5", stored.ReadingTime)
+	}
+}
+
+func TestHeadBlogBySlug(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	router.HEAD("/blogs/:slug", h.HeadBlogBySlug)
+
+	blog := createTestBlog(t, db, models.Blog{Title: "Crawled post", Published: true, MetaTitle: "Crawled post | Blog"})
+	createTestBlog(t, db, models.Blog{Title: "Hidden draft"})
+
+	w := serve(router, testRequest{method: http.MethodHead, path: "/blogs/crawled-post"})
+	expectStatus(t, w, http.StatusOK)
+	if w.Body.Len() != 0 {
+		t.Errorf("HEAD wrote a body: %q", w.Body.String())
+	}
+	get := serve(router, testRequest{method: http.MethodGet, path: "/blogs/crawled-post"})
+	expectStatus(t, get, http.StatusOK)
+	for _, name := range []string{"X-Meta-Title", "X-Reading-Time", "ETag", "Last-Modified"} {
+		if w.Header().Get(name) == "" || w.Header().Get(name) != get.Header().Get(name) {
+			t.Errorf("%s = %q, want %q as on GET", name, w.Header().Get(name), get.Header().Get(name))
+		}
+	}
+	if got := w.Header().Get("X-Meta-Title"); got != "Crawled post | Blog" {
+		t.Errorf("X-Meta-Title = %q, want %q", got, "Crawled post | Blog")
+	}
+
+	for slug, want := range map[string]int{"hidden-draft": http.StatusNotFound, "never-existed": http.StatusNotFound, "Crawled-Post": http.StatusMovedPermanently} {
+		if w := serve(router, testRequest{method: http.MethodHead, path: "/blogs/" + slug}); w.Code != want {
+			t.Errorf("HEAD %s: status = %d, want %d", slug, w.Code, want)
+		}
+	}
+
+	// Views are written in order, so once the GET's view lands any view
+	// queued by the earlier HEAD would have landed too
+	var views int
+	for deadline := time.Now().Add(2 * time.Second); views == 0 && time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		db.Model(&models.PostView{}).Where("blog_id = ?", blog.ID).Count(&views)
+	}
+	var stored models.Blog
+	db.First(&stored, blog.ID)
+	if views != 1 || stored.ViewCount != 1 {
+		t.Errorf("after one HEAD and one GET: %d views recorded, view_count %d; want 1 and 1", views, stored.ViewCount)
+	}
+}
+
+func TestCreateBlogDefaultPublished(t *testing.T) {
+	for _, defaultPublished := range []bool{false, true} {
+		h, _ := newTestHandler(t, func(cfg *config.Blog) { cfg.DefaultPublished = defaultPublished })
+		router := gin.New()
+		router.POST("/blogs", h.CreateBlog)
+
+		tests := []struct {
+			name      string
+			published interface{} // nil omits the field
+			want      bool
+		}{
+			{"omitted", nil, defaultPublished},
+			{"explicit true", true, true},
+			{"explicit false", false, false},
+		}
+		for i, tt := range tests {
+			body := gin.H{
+				"title":   "Publish policy " + tt.name,
+				"content": "<p>Publish policy case " + strconv.Itoa(i) + ".</p>" + testContent,
+				"author":  "Test Author",
+			}
+			if tt.published != nil {
+				body["published"] = tt.published
+			}
+			w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: body})
+			expectStatus(t, w, http.StatusCreated)
+			var created models.BlogResponse
+			decode(t, w, &created)
+			if created.Published != tt.want {
+				t.Errorf("DEFAULT_PUBLISHED=%v, %s: published = %v, want %v", defaultPublished, tt.name, created.Published, tt.want)
+			}
+			if tt.want != (created.PublishedAt != nil) {
+				t.Errorf("DEFAULT_PUBLISHED=%v, %s: published_at = %v", defaultPublished, tt.name, created.PublishedAt)
+			}
+		}
+	}
+}
+
+func TestGetBlogsHeadingCount(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	if _, _, err := database.Seed(db, false); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?limit=50"})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.BlogListResponse
+	decode(t, w, &resp)
+	counts := make(map[string]int, len(resp.Blogs))
+	for _, blog := range resp.Blogs {
+		if blog.Content != "" {
+			t.Errorf("%s: list response includes content", blog.Slug)
+		}
+		counts[blog.Title] = blog.HeadingCount
+	}
+
+	// Two to three h2 sections, with and without h3 subsections
+	for title, want := range map[string]int{
+		"The Future of Web Accessibility: AI-Powered Inclusive Design":    3,
+		"Dark Mode Accessibility: Beyond Just Inverting Colors":           7,
+		"WCAG 2.2: What's New and How to Implement the Latest Guidelines": 11,
+	} {
+		if got, ok := counts[title]; !ok || got != want {
+			t.Errorf("%q: heading_count = %d (listed %v), want %d", title, got, ok, want)
+		}
+	}
+
+	var blog models.Blog
+	db.Where("published = ?", true).First(&blog)
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{
+		"content": "<h2>Only section</h2>" + testContent,
+	}, headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	var updated models.BlogResponse
+	decode(t, w, &updated)
+	if updated.HeadingCount != 1 {
+		t.Errorf("heading_count after replacing the content = %d, want 1", updated.HeadingCount)
+	}
+}
+
+func TestWriteHandlersReportEveryInvalidField(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", requireTestAPIKey(), h.CreateBlog)
+	router.PUT("/blogs/:id", requireTestAPIKey(), h.UpdateBlog)
+	router.PATCH("/blogs/:id/featured", requireTestAPIKey(), h.SetFeatured)
+	router.POST("/blogs/batch-delete", requireTestAPIKey(), h.BatchDeleteBlogs)
+	blog := createTestBlog(t, db, models.Blog{Title: "Existing post", Published: true})
+	id := itoa(blog.ID)
+
+	tests := []struct {
+		method  string
+		path    string
+		body    string
+		details []string
+	}{
+		{http.MethodPost, "/blogs", `{"content":"short","meta_title":"` + strings.Repeat("t", 61) + `","language":"english"}`, []string{
+			"title is required",
+			"content must be at least 10 characters",
+			"author is required",
+			"meta_title must be at most 60 characters",
+			"language must be exactly 2 characters",
+		}},
+		{http.MethodPut, "/blogs/" + id, `{"title":"","excerpt":"` + strings.Repeat("e", 501) + `","featured_image":"nope"}`, []string{
+			"title must be at least 1 character",
+			"excerpt must be at most 500 characters",
+			"featured_image must be a valid URL",
+		}},
+		{http.MethodPatch, "/blogs/" + id + "/featured", `{}`, []string{"featured is required"}},
+		{http.MethodPost, "/blogs/batch-delete", `{"ids":[]}`, []string{"ids must be at least 1 item"}},
+		{http.MethodPost, "/blogs/batch-delete", `{"ids":"1,2"}`, []string{"ids must be an array"}},
+		{http.MethodPost, "/blogs", `{"title":`, []string{"request body must be valid JSON"}},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: tt.method, path: tt.path, body: tt.body, headers: map[string]string{"If-Match": "*"}, apiKey: true})
+		expectStatus(t, w, http.StatusBadRequest)
+		var resp apierror.Response
+		decode(t, w, &resp)
+		if resp.Error != "Invalid request data" || strings.Join(resp.Details, "; ") != strings.Join(tt.details, "; ") {
+			t.Errorf("%s %s: error %q, details %q; want Invalid request data, %q", tt.method, tt.path, resp.Error, resp.Details, tt.details)
+		}
+		if strings.Contains(w.Body.String(), "json:") || strings.Contains(w.Body.String(), "Key: ") {
+			t.Errorf("%s %s: response exposes parser internals: %s", tt.method, tt.path, w.Body.String())
+		}
+	}
+}
+
+func TestUpdateBlogPublishCycle(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", requireTestAPIKey(), h.CreateBlog)
+	router.PUT("/blogs/:id", requireTestAPIKey(), h.UpdateBlog)
+
+	update := func(id uint, body gin.H) models.BlogResponse {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(id), body: body, headers: map[string]string{"If-Match": "*"}, apiKey: true})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.UpdateBlogResponse
+		decode(t, w, &resp)
+		return resp.BlogResponse
+	}
+	stored := func(id uint) *time.Time {
+		t.Helper()
+		var blog models.Blog
+		db.First(&blog, id)
+		return blog.PublishedAt
+	}
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", apiKey: true, body: gin.H{
+		"title": "Cycle post", "content": testContent, "author": "Jane Doe", "published": false,
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var created models.CreateBlogResponse
+	decode(t, w, &created)
+	if created.PublishedAt != nil {
+		t.Fatalf("draft published_at = %v, want none", created.PublishedAt)
+	}
+
+	// Publishing a draft through the update sets the date
+	before := time.Now().Add(-time.Second)
+	published := update(created.ID, gin.H{"published": true})
+	if !published.Published || published.PublishedAt == nil || published.PublishedAt.Before(before) {
+		t.Fatalf("after publish: published %v, published_at %v", published.Published, published.PublishedAt)
+	}
+	if at := stored(created.ID); at == nil || !at.Equal(*published.PublishedAt) {
+		t.Errorf("stored published_at = %v, want %v", at, published.PublishedAt)
+	}
+	firstPublished := *published.PublishedAt
+
+	// Editing a published post keeps its original date
+	time.Sleep(10 * time.Millisecond)
+	edited := update(created.ID, gin.H{"title": "Cycle post, edited"})
+	if edited.PublishedAt == nil || !edited.PublishedAt.Equal(firstPublished) {
+		t.Errorf("after edit: published_at = %v, want %v", edited.PublishedAt, firstPublished)
+	}
+	edited = update(created.ID, gin.H{"published": true, "excerpt": "Saved as published again"})
+	if edited.PublishedAt == nil || !edited.PublishedAt.Equal(firstPublished) {
+		t.Errorf("after saving published again: published_at = %v, want %v", edited.PublishedAt, firstPublished)
+	}
+
+	unpublished := update(created.ID, gin.H{"published": false})
+	if unpublished.Published || unpublished.PublishedAt != nil || stored(created.ID) != nil {
+		t.Errorf("after unpublish: published %v, published_at %v, stored %v; want a draft without a date",
+			unpublished.Published, unpublished.PublishedAt, stored(created.ID))
+	}
+	if edited := update(created.ID, gin.H{"excerpt": "Edited while a draft"}); edited.PublishedAt != nil {
+		t.Errorf("editing a draft set published_at %v", edited.PublishedAt)
+	}
+
+	// Republishing dates the post anew
+	time.Sleep(10 * time.Millisecond)
+	republished := update(created.ID, gin.H{"published": true})
+	if republished.PublishedAt == nil || !republished.PublishedAt.After(firstPublished) {
+		t.Errorf("after republish: published_at = %v, want later than %v", republished.PublishedAt, firstPublished)
+	}
+	if at := stored(created.ID); at == nil || !at.Equal(*republished.PublishedAt) {
+		t.Errorf("stored published_at = %v, want %v", at, republished.PublishedAt)
+	}
+}
+
+func TestGetBlogSections(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug/sections", h.GetBlogSections)
+
+	createTestBlog(t, db, models.Blog{Title: "Sectioned post", Published: true,
+		Content: "<p>Why it matters.</p><h2>Contrast</h2><p>Use strong contrast.</p><h3>Tools</h3><p>Check it.</p><h2>Focus</h2><p>Show focus.</p>"})
+	createTestBlog(t, db, models.Blog{Title: "Flat post", Published: true, Content: testContent})
+	createTestBlog(t, db, models.Blog{Title: "Draft post", Content: "<h2>Hidden</h2><p>Not yet published content.</p>"})
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/sectioned-post/sections"})
+	expectStatus(t, w, http.StatusOK)
+	var sections []models.ContentSection
+	decode(t, w, &sections)
+	want := []models.ContentSection{
+		{Anchor: "introduction", HTML: "<p>Why it matters.</p>"},
+		{Anchor: "contrast", Heading: "Contrast", HTML: "<h2>Contrast</h2><p>Use strong contrast.</p><h3>Tools</h3><p>Check it.</p>"},
+		{Anchor: "focus", Heading: "Focus", HTML: "<h2>Focus</h2><p>Show focus.</p>"},
+	}
+	if !reflect.DeepEqual(sections, want) {
+		t.Errorf("sections = %+v, want %+v", sections, want)
+	}
+
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/flat-post/sections"})
+	expectStatus(t, w, http.StatusOK)
+	decode(t, w, &sections)
+	if want := []models.ContentSection{{Anchor: "introduction", HTML: testContent}}; !reflect.DeepEqual(sections, want) {
+		t.Errorf("flat sections = %+v, want %+v", sections, want)
+	}
+
+	for _, slug := range []string{"draft-post", "missing-post"} {
+		expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + slug + "/sections"}), http.StatusNotFound)
+	}
+}
+
+func TestBlogResponseAudioLength(t *testing.T) {
+	t.Cleanup(func() { models.SetSpeakingWPM(models.DefaultSpeakingWPM) })
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	createTestBlog(t, db, models.Blog{Title: "Listening post", Published: true, Content: "<p>" + strings.Repeat("word ", 450) + "</p>"})
+
+	audioLength := func() int {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/listening-post"})
+		expectStatus(t, w, http.StatusOK)
+		var resp struct {
+			AudioLength *int `json:"audio_length_seconds"`
+		}
+		decode(t, w, &resp)
+		if resp.AudioLength == nil {
+			t.Fatal("response lacks audio_length_seconds")
+		}
+		return *resp.AudioLength
+	}
+
+	// 450 words at the default 150 words per minute
+	if got := audioLength(); got != 180 {
+		t.Errorf("audio_length_seconds = %d, want 180", got)
+	}
+	models.SetSpeakingWPM(180)
+	if got := audioLength(); got != 150 {
+		t.Errorf("audio_length_seconds at 180 wpm = %d, want 150", got)
+	}
+}
+
+func TestGetBlogBySlugReducedMotion(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	media := `<p>Watch <video src="/intro.mp4" autoplay loop></video></p><img src="/cat.gif" alt="Dancing cat">`
+	createTestBlog(t, db, models.Blog{Title: "Moving pictures", Published: true, Content: testContent + media})
+	createTestBlog(t, db, models.Blog{Title: "Still pictures", Published: true})
+
+	get := func(path string) (string, string) {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: path})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogDetailResponse
+		decode(t, w, &resp)
+		return resp.Content, w.Header().Get("X-Reduced-Motion-Altered")
+	}
+
+	// Without the option the stored markup is returned as is
+	if content, altered := get("/blogs/moving-pictures"); content != testContent+media || altered != "" {
+		t.Errorf("default content = %q, header %q; want the stored content and no header", content, altered)
+	}
+
+	content, altered := get("/blogs/moving-pictures?reduced_motion=true")
+	want := testContent + `<p>Watch <video src="/intro.mp4" controls="controls"></video></p>` +
+		`<img src="/cat.gif" alt="Dancing cat" data-animated="true">`
+	if content != want {
+		t.Errorf("reduced motion content = %q, want %q", content, want)
+	}
+	if want := "video autoplay=1, video loop=1, img animated-gif=1"; altered != want {
+		t.Errorf("X-Reduced-Motion-Altered = %q, want %q", altered, want)
+	}
+
+	if content, altered := get("/blogs/still-pictures?reduced_motion=true"); content != testContent || altered != "none" {
+		t.Errorf("still content = %q, header %q; want it unchanged and none", content, altered)
+	}
+}
+
+func TestCreateBlogExcerptBoundary(t *testing.T) {
+	content := "<p>Captions help deaf readers and everyone else.</p><p>They also help in noisy places like trains.</p>"
+	for boundary, want := range map[models.ExcerptBoundary]string{
+		models.ExcerptBoundaryWord:     "Captions help deaf readers and everyone else. They also...",
+		models.ExcerptBoundarySentence: "Captions help deaf readers and everyone else.",
+	} {
+		h, _ := newTestHandler(t, func(cfg *config.Blog) {
+			cfg.ExcerptLength = 60
+			cfg.ExcerptBoundary = boundary
+		})
+		router := gin.New()
+		router.POST("/blogs", requireTestAPIKey(), h.CreateBlog)
+
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", apiKey: true,
+			body: map[string]interface{}{"title": "Why captions", "content": content, "author": "Test Author"}})
+		expectStatus(t, w, http.StatusCreated)
+		var created models.CreateBlogResponse
+		decode(t, w, &created)
+		if created.Excerpt != want {
+			t.Errorf("%s boundary: excerpt = %q, want %q", boundary, created.Excerpt, want)
+		}
+	}
+}
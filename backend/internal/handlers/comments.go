@@ -0,0 +1,344 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// commentOrder maps the sort query parameter to an ORDER BY clause
+var commentOrder = map[string]string{
+	"newest": "created_at DESC, id DESC",
+	"oldest": "created_at ASC, id ASC",
+}
+
+// GetComments handles GET /api/v1/blogs/:slug/comments
+// @Summary List comments on a blog post
+// @Description Retrieve the approved comments of a published post as threads, paginated by top-level comment, newest first unless sort=oldest. Replies are nested oldest first up to COMMENT_MAX_DEPTH levels; deeper replies are collapsed into the last level.
+// @Tags comments
+// @Produce json
+// @Param slug path string true "Blog slug"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (1-100)" default(10)
+// @Param sort query string false "newest or oldest" default(newest)
+// @Success 200 {object} models.CommentListResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{slug}/comments [get]
+func (h *BlogHandler) GetComments(c *gin.Context) {
+	page, limit, ok := h.parsePagination(c)
+	if !ok {
+		return
+	}
+	order, ok := parseCommentSort(c)
+	if !ok {
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id, allow_comments").Where("slug = ? AND published = ?", c.Param("slug"), true).First(&blog).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	// Posts with comments turned off show an empty thread list
+	if !blog.AllowComments {
+		respondCommentPage(c, commentPage{page: page, limit: limit}, []models.CommentResponse{}, false)
+		return
+	}
+
+	// Page through threads by their top-level comments
+	query := requestDB(c, h.db).Model(&models.Comment{}).
+		Where("blog_id = ? AND status = ? AND parent_id IS NULL", blog.ID, models.CommentApproved)
+	result, ok := fetchCommentPage(c, query, order, page, limit)
+	if !ok {
+		return
+	}
+
+	var replies []models.Comment
+	if len(result.comments) > 0 {
+		rootIDs := make([]uint, len(result.comments))
+		for i, root := range result.comments {
+			rootIDs[i] = root.ID
+		}
+		var err error
+		if replies, err = threadReplies(requestDB(c, h.db), blog.ID, rootIDs); err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch comments")
+			return
+		}
+	}
+
+	respondCommentPage(c, result, models.BuildCommentThreads(result.comments, replies, h.commentMaxDepth), true)
+}
+
+// threadReplies loads the approved replies in the threads under rootIDs, so a
+// page of comments reads only its own threads. Replies to held comments are
+// left out, as they could not be shown anyway.
+func threadReplies(db *gorm.DB, blogID uint, rootIDs []uint) ([]models.Comment, error) {
+	var replies []models.Comment
+	err := db.Raw(`WITH RECURSIVE thread(id) AS (
+			SELECT id FROM comments WHERE blog_id = ? AND status = ? AND parent_id IN (?)
+			UNION
+			SELECT comments.id FROM comments JOIN thread ON comments.parent_id = thread.id
+			WHERE comments.status = ?
+		)
+		SELECT * FROM comments WHERE id IN (SELECT id FROM thread)`,
+		blogID, models.CommentApproved, rootIDs, models.CommentApproved).
+		Scan(&replies).Error
+	return replies, err
+}
+
+// CreateComment handles POST /api/v1/blogs/:id/comments
+// @Summary Post a comment
+// @Description Submit a plain-text comment or reply on a published post; it is held as pending until a moderator approves it
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Param comment body models.CreateCommentRequest true "Comment data"
+// @Success 201 {object} models.CommentResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/comments [post]
+func (h *BlogHandler) CreateComment(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	var req models.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	author := models.CommentText(req.Author)
+	content := models.CommentText(req.Content)
+	if author == "" || utf8.RuneCountInString(author) > models.CommentAuthorMaxLength {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "author must be between 1 and "+strconv.Itoa(models.CommentAuthorMaxLength)+" characters")
+		return
+	}
+	if content == "" || utf8.RuneCountInString(content) > models.CommentContentMaxLength {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "content must be between 1 and "+strconv.Itoa(models.CommentContentMaxLength)+" characters")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id, allow_comments").Where("published = ?", true).First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	if !blog.AllowComments {
+		apierror.Respond(c, http.StatusForbidden, "Comments are disabled for this post")
+		return
+	}
+
+	// Replies must answer a comment on the same post
+	if req.ParentID != nil {
+		var parent models.Comment
+		err := requestDB(c, h.db).Select("id, blog_id").First(&parent, *req.ParentID).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch comments")
+			return
+		}
+		if err != nil || parent.BlogID != blog.ID {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid parent comment", "parent_id must be a comment on the same post")
+			return
+		}
+	}
+
+	comment := models.Comment{
+		BlogID:   blog.ID,
+		ParentID: req.ParentID,
+		Author:   author,
+		Content:  content,
+		Status:   models.CommentPending,
+	}
+	if err := requestDB(c, h.db).Create(&comment).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to create comment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment.ToResponse(true))
+}
+
+// GetModerationComments handles GET /api/v1/admin/comments
+// @Summary List comments for moderation
+// @Description Retrieve comments across all posts with pagination, filtered by status (default pending)
+// @Tags comments
+// @Produce json
+// @Security ApiKeyAuth
+// @Param status query string false "pending, approved or rejected" default(pending)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (1-100)" default(10)
+// @Param sort query string false "newest or oldest" default(newest)
+// @Success 200 {object} models.CommentListResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/comments [get]
+func (h *BlogHandler) GetModerationComments(c *gin.Context) {
+	page, limit, ok := h.parsePagination(c)
+	if !ok {
+		return
+	}
+	order, ok := parseCommentSort(c)
+	if !ok {
+		return
+	}
+
+	status := strings.ToLower(c.DefaultQuery("status", models.CommentPending))
+	if !models.IsValidCommentStatus(status) {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid status", "status must be pending, approved or rejected")
+		return
+	}
+
+	query := requestDB(c, h.db).Model(&models.Comment{}).Where("status = ?", status)
+	result, ok := fetchCommentPage(c, query, order, page, limit)
+	if !ok {
+		return
+	}
+
+	comments := make([]models.CommentResponse, len(result.comments))
+	for i, comment := range result.comments {
+		comments[i] = comment.ToResponse(true)
+	}
+	respondCommentPage(c, result, comments, true)
+}
+
+// ModerateComment handles PATCH /api/v1/admin/comments/:id
+// @Summary Moderate a comment
+// @Description Approve, reject or return a comment to pending
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Comment ID"
+// @Param status body models.ModerateCommentRequest true "New status"
+// @Success 200 {object} models.CommentResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/comments/{id} [patch]
+func (h *BlogHandler) ModerateComment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid comment ID")
+		return
+	}
+
+	var req models.ModerateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+	if !models.IsValidCommentStatus(req.Status) {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid status", "status must be pending, approved or rejected")
+		return
+	}
+
+	var comment models.Comment
+	if err := requestDB(c, h.db).First(&comment, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Comment not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch comments")
+		return
+	}
+
+	if err := requestDB(c, h.db).Model(&comment).Update("status", req.Status).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to update comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, comment.ToResponse(true))
+}
+
+// parseCommentSort reads the sort query parameter, writing a 400 response for unknown values
+func parseCommentSort(c *gin.Context) (string, bool) {
+	order, ok := commentOrder[strings.ToLower(c.DefaultQuery("sort", "newest"))]
+	if !ok {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid sort", "sort must be newest or oldest")
+		return "", false
+	}
+	return order, true
+}
+
+// commentPage is one page of comments with its pagination totals
+type commentPage struct {
+	comments   []models.Comment
+	total      int64
+	page       int
+	limit      int
+	totalPages int
+}
+
+// fetchCommentPage counts and pages through the comments matched by query,
+// writing a 500 response on failure
+func fetchCommentPage(c *gin.Context, query *gorm.DB, order string, page, limit int) (commentPage, bool) {
+	result := commentPage{page: page, limit: limit}
+	if err := query.Count(&result.total).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to count comments")
+		return result, false
+	}
+
+	offset := (page - 1) * limit
+	result.totalPages = int(math.Ceil(float64(result.total) / float64(limit)))
+
+	if err := query.Order(order).
+		Offset(offset).
+		Limit(limit).
+		Find(&result.comments).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch comments")
+		return result, false
+	}
+	return result, true
+}
+
+// respondCommentPage writes a paginated comment list
+func respondCommentPage(c *gin.Context, result commentPage, comments []models.CommentResponse, enabled bool) {
+	c.Header("X-Total-Count", strconv.FormatInt(result.total, 10))
+	c.Header("X-Page", strconv.Itoa(result.page))
+	c.JSON(http.StatusOK, models.CommentListResponse{
+		CommentsEnabled: enabled,
+		Comments:        comments,
+		Total:           result.total,
+		Page:            result.page,
+		Limit:           result.limit,
+		TotalPages:      result.totalPages,
+		HasNext:         result.page < result.totalPages,
+		HasPrev:         result.page > 1,
+		Links:           paginationLinks(c, result.page, result.totalPages),
+	})
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+// createTestComment inserts a comment, approved unless a status is given
+func createTestComment(t *testing.T, db *gorm.DB, comment models.Comment) models.Comment {
+	t.Helper()
+	if comment.Author == "" {
+		comment.Author = "Reader"
+	}
+	if comment.Content == "" {
+		comment.Content = "Thanks for writing this."
+	}
+	if comment.Status == "" {
+		comment.Status = models.CommentApproved
+	}
+	if err := db.Create(&comment).Error; err != nil {
+		t.Fatalf("create comment: %v", err)
+	}
+	return comment
+}
+
+// commentContents lists the content of each comment
+func commentContents(comments []models.CommentResponse) []string {
+	contents := make([]string, len(comments))
+	for i, comment := range comments {
+		contents[i] = comment.Content
+	}
+	return contents
+}
+
+func TestGetCommentsPagination(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug/comments", h.GetComments)
+	router.GET("/admin/comments", h.GetModerationComments)
+
+	blog := createTestBlog(t, db, models.Blog{Title: "Busy post", Published: true, AllowComments: true})
+	other := createTestBlog(t, db, models.Blog{Title: "Other post", Published: true, AllowComments: true})
+	draft := createTestBlog(t, db, models.Blog{Title: "Draft post", AllowComments: true})
+	start := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	for i := 1; i <= 5; i++ {
+		createTestComment(t, db, models.Comment{BlogID: blog.ID, Content: "Approved " + strconv.Itoa(i), CreatedAt: start.Add(time.Duration(i) * time.Minute)})
+	}
+	createTestComment(t, db, models.Comment{BlogID: blog.ID, Content: "Pending", Status: models.CommentPending, CreatedAt: start.Add(time.Hour)})
+	createTestComment(t, db, models.Comment{BlogID: blog.ID, Content: "Rejected", Status: models.CommentRejected, CreatedAt: start.Add(time.Hour)})
+	createTestComment(t, db, models.Comment{BlogID: other.ID, Content: "Elsewhere", CreatedAt: start.Add(time.Hour)})
+
+	tests := []struct {
+		query    string
+		contents []string
+		hasPrev  bool
+		hasNext  bool
+	}{
+		{"limit=2", []string{"Approved 5", "Approved 4"}, false, true},
+		{"limit=2&page=2", []string{"Approved 3", "Approved 2"}, true, true},
+		{"limit=2&page=3", []string{"Approved 1"}, true, false},
+		{"limit=2&page=4", []string{}, true, false},
+		{"limit=2&sort=oldest", []string{"Approved 1", "Approved 2"}, false, true},
+		{"limit=2&page=3&sort=oldest", []string{"Approved 5"}, true, false},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/busy-post/comments?" + tt.query})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.CommentListResponse
+		decode(t, w, &resp)
+		if got := commentContents(resp.Comments); !reflect.DeepEqual(got, tt.contents) {
+			t.Errorf("%s: comments = %v, want %v", tt.query, got, tt.contents)
+		}
+		if resp.Total != 5 || resp.TotalPages != 3 || resp.Limit != 2 || resp.HasPrev != tt.hasPrev || resp.HasNext != tt.hasNext {
+			t.Errorf("%s: total %d, pages %d, limit %d, prev %v, next %v", tt.query, resp.Total, resp.TotalPages, resp.Limit, resp.HasPrev, resp.HasNext)
+		}
+		if !resp.CommentsEnabled || w.Header().Get("X-Total-Count") != "5" {
+			t.Errorf("%s: comments_enabled %v, X-Total-Count %q", tt.query, resp.CommentsEnabled, w.Header().Get("X-Total-Count"))
+		}
+		for _, comment := range resp.Comments {
+			if comment.Status != "" {
+				t.Errorf("%s: reader listing exposes status %q", tt.query, comment.Status)
+			}
+		}
+	}
+
+	for path, want := range map[string]int{
+		"/blogs/busy-post/comments?sort=popular": http.StatusBadRequest,
+		"/blogs/no-such-post/comments":           http.StatusNotFound,
+		"/blogs/" + draft.Slug + "/comments":     http.StatusNotFound,
+	} {
+		w := serve(router, testRequest{method: http.MethodGet, path: path})
+		if w.Code != want {
+			t.Errorf("%s: status = %d, want %d", path, w.Code, want)
+		}
+	}
+
+	// Moderators filter by status across posts
+	for query, want := range map[string][]string{
+		"":                                  {"Pending"},
+		"?status=" + models.CommentRejected: {"Rejected"},
+		"?status=" + models.CommentApproved: {"Elsewhere", "Approved 5", "Approved 4", "Approved 3", "Approved 2", "Approved 1"},
+	} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/admin/comments" + query})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.CommentListResponse
+		decode(t, w, &resp)
+		if got := commentContents(resp.Comments); !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: comments = %v, want %v", query, got, want)
+		}
+		for _, comment := range resp.Comments {
+			if comment.Status == "" {
+				t.Errorf("%q: moderation listing omits the status", query)
+			}
+		}
+	}
+	if w := serve(router, testRequest{method: http.MethodGet, path: "/admin/comments?status=spam"}); w.Code != http.StatusBadRequest {
+		t.Errorf("unknown status: status = %d, want 400", w.Code)
+	}
+}
+
+func TestCreateCommentReplies(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs/:id/comments", h.CreateComment)
+
+	blog := createTestBlog(t, db, models.Blog{Title: "Threaded post", Published: true, AllowComments: true})
+	other := createTestBlog(t, db, models.Blog{Title: "Other post", Published: true, AllowComments: true})
+	root := createTestComment(t, db, models.Comment{BlogID: blog.ID})
+	foreign := createTestComment(t, db, models.Comment{BlogID: other.ID})
+	path := "/blogs/" + itoa(blog.ID) + "/comments"
+
+	w := serve(router, testRequest{method: http.MethodPost, path: path, body: gin.H{
+		"author": "Replier", "content": "I agree!", "parent_id": root.ID,
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var reply models.CommentResponse
+	decode(t, w, &reply)
+	if reply.ParentID == nil || *reply.ParentID != root.ID || reply.Status != models.CommentPending {
+		t.Errorf("reply = %+v, want a pending reply to comment %d", reply, root.ID)
+	}
+
+	for name, parentID := range map[string]uint{"parent on another post": foreign.ID, "unknown parent": 9999} {
+		w := serve(router, testRequest{method: http.MethodPost, path: path, body: gin.H{
+			"author": "Replier", "content": "Wrong thread", "parent_id": parentID,
+		}})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", name, w.Code)
+		}
+	}
+	var count int
+	db.Model(&models.Comment{}).Where("content = ?", "Wrong thread").Count(&count)
+	if count != 0 {
+		t.Errorf("%d replies with an invalid parent were saved", count)
+	}
+}
+
+func TestGetCommentsThreads(t *testing.T) {
+	// node is the shape of a thread, ignoring IDs and timestamps
+	type node struct {
+		Content string
+		Replies []node
+	}
+	var toNodes func([]models.CommentResponse) []node
+	toNodes = func(comments []models.CommentResponse) []node {
+		var nodes []node
+		for _, comment := range comments {
+			nodes = append(nodes, node{Content: comment.Content, Replies: toNodes(comment.Replies)})
+		}
+		return nodes
+	}
+
+	tests := []struct {
+		depth int
+		want  []node
+	}{
+		{3, []node{{Content: "Root", Replies: []node{
+			{Content: "First reply", Replies: []node{{Content: "Reply to first"}}},
+			{Content: "Second reply"},
+		}}}},
+		// Deeper replies collapse into the last level in chronological order
+		{1, []node{{Content: "Root", Replies: []node{
+			{Content: "First reply"}, {Content: "Reply to first"}, {Content: "Second reply"},
+		}}}},
+	}
+	for _, tt := range tests {
+		h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.CommentMaxDepth = tt.depth })
+		router := gin.New()
+		router.GET("/blogs/:slug/comments", h.GetComments)
+
+		blog := createTestBlog(t, db, models.Blog{Title: "Threaded post", Published: true, AllowComments: true})
+		at := func(minutes int) time.Time {
+			return time.Date(2024, time.June, 1, 12, minutes, 0, 0, time.UTC)
+		}
+		root := createTestComment(t, db, models.Comment{BlogID: blog.ID, Content: "Root", CreatedAt: at(0)})
+		first := createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &root.ID, Content: "First reply", CreatedAt: at(1)})
+		createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &first.ID, Content: "Reply to first", CreatedAt: at(2)})
+		createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &root.ID, Content: "Second reply", CreatedAt: at(3)})
+		createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &root.ID, Content: "Unmoderated reply", Status: models.CommentPending, CreatedAt: at(4)})
+
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/threaded-post/comments"})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.CommentListResponse
+		decode(t, w, &resp)
+		if got := toNodes(resp.Comments); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("COMMENT_MAX_DEPTH=%d: threads = %+v, want %+v", tt.depth, got, tt.want)
+		}
+		// Replies do not count as threads
+		if resp.Total != 1 {
+			t.Errorf("COMMENT_MAX_DEPTH=%d: total = %d, want 1 thread", tt.depth, resp.Total)
+		}
+	}
+}
+
+func TestCommentsDisabledPost(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	router.GET("/blogs/:slug/comments", h.GetComments)
+	router.POST("/blogs/:slug/comments", h.CreateComment)
+
+	create := func(title string, allowComments interface{}) models.BlogResponse {
+		body := gin.H{"title": title, "content": "<p>" + title + " content.</p>" + testContent, "author": "Test Author", "published": true}
+		if allowComments != nil {
+			body["allow_comments"] = allowComments
+		}
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: body})
+		expectStatus(t, w, http.StatusCreated)
+		var created models.BlogResponse
+		decode(t, w, &created)
+		return created
+	}
+	open := create("Open post", nil)
+	notice := create("Legal notice", false)
+	if !open.AllowComments || notice.AllowComments {
+		t.Fatalf("allow_comments = %v omitted and %v when false, want true and false", open.AllowComments, notice.AllowComments)
+	}
+
+	comment := gin.H{"author": "Reader", "content": "First!"}
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/" + itoa(notice.ID) + "/comments", body: comment})
+	expectStatus(t, w, http.StatusForbidden)
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs/" + itoa(open.ID) + "/comments", body: comment})
+	expectStatus(t, w, http.StatusCreated)
+
+	// Comments left before they were turned off are hidden too
+	createTestComment(t, db, models.Comment{BlogID: notice.ID, Content: "Old comment"})
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/legal-notice/comments"})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.CommentListResponse
+	decode(t, w, &resp)
+	if resp.CommentsEnabled || len(resp.Comments) != 0 || resp.Total != 0 {
+		t.Errorf("disabled post listing = enabled %v with %d comments, total %d; want disabled and empty", resp.CommentsEnabled, len(resp.Comments), resp.Total)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(notice.ID), body: gin.H{"allow_comments": true},
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs/" + itoa(notice.ID) + "/comments", body: comment})
+	expectStatus(t, w, http.StatusCreated)
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/legal-notice/comments"})
+	decode(t, w, &resp)
+	if !resp.CommentsEnabled || resp.Total != 1 {
+		t.Errorf("re-enabled post listing = enabled %v, total %d; want enabled with the old comment", resp.CommentsEnabled, resp.Total)
+	}
+}
+
+func TestThreadRepliesLoadsOnlyPageThreads(t *testing.T) {
+	_, db := newTestHandler(t, nil)
+	blog := createTestBlog(t, db, models.Blog{Title: "Threaded post", Published: true, AllowComments: true})
+	other := createTestBlog(t, db, models.Blog{Title: "Other post", Content: "<p>Another post with enough readable content to be stored.</p>", Published: true, AllowComments: true})
+
+	onPage := createTestComment(t, db, models.Comment{BlogID: blog.ID, Content: "Root on this page"})
+	reply := createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &onPage.ID, Content: "Reply"})
+	createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &reply.ID, Content: "Nested reply"})
+	held := createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &onPage.ID, Content: "Held reply", Status: models.CommentPending})
+	createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &held.ID, Content: "Reply to held reply"})
+
+	offPage := createTestComment(t, db, models.Comment{BlogID: blog.ID, Content: "Root on another page"})
+	createTestComment(t, db, models.Comment{BlogID: blog.ID, ParentID: &offPage.ID, Content: "Reply on another page"})
+	otherRoot := createTestComment(t, db, models.Comment{BlogID: other.ID, Content: "Root on another post"})
+	createTestComment(t, db, models.Comment{BlogID: other.ID, ParentID: &otherRoot.ID, Content: "Reply on another post"})
+
+	replies, err := threadReplies(db, blog.ID, []uint{onPage.ID})
+	if err != nil {
+		t.Fatalf("threadReplies: %v", err)
+	}
+	contents := make(map[string]bool, len(replies))
+	for _, reply := range replies {
+		contents[reply.Content] = true
+	}
+	if want := map[string]bool{"Reply": true, "Nested reply": true}; !reflect.DeepEqual(contents, want) {
+		t.Errorf("replies = %v, want %v", contents, want)
+	}
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// blogETag derives the entity tag of a post from its edit version
+func blogETag(blog models.Blog) string {
+	return `"v` + strconv.Itoa(blog.Version) + `"`
+}
+
+// parseETagVersion extracts the version from an If-Match value such as "v3",
+// W/"v3" or a bare 3
+func parseETagVersion(value string) (int, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+	value = strings.TrimPrefix(strings.Trim(value, `"`), "v")
+	version, err := strconv.Atoi(value)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
+
+// checkVersion compares the version the client expects, taken from If-Match
+// or the version body field, against the stored post. It writes 428 when no
+// version was sent, 400 when it is malformed and 409 when it is stale.
+func checkVersion(c *gin.Context, blog models.Blog, bodyVersion *int) bool {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+
+	var expected int
+	switch {
+	case ifMatch == "*":
+		return true
+	case ifMatch != "":
+		version, ok := parseETagVersion(ifMatch)
+		if !ok {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid If-Match header", `If-Match must be the post's ETag, e.g. "v3"`)
+			return false
+		}
+		expected = version
+	case bodyVersion != nil:
+		expected = *bodyVersion
+	default:
+		apierror.RespondWith(c, http.StatusPreconditionRequired, "Version required",
+			gin.H{"current_version": blog.Version},
+			"Send the post's ETag in If-Match or its version in the request body")
+		return false
+	}
+
+	if expected != blog.Version {
+		respondVersionConflict(c, blog.Version)
+		return false
+	}
+	return true
+}
+
+// respondVersionConflict writes a 409 for an edit based on a stale version
+func respondVersionConflict(c *gin.Context, currentVersion int) {
+	c.Header("ETag", `"v`+strconv.Itoa(currentVersion)+`"`)
+	apierror.RespondWith(c, http.StatusConflict, "Blog post was modified by someone else",
+		gin.H{"current_version": currentVersion},
+		"Reload the post and reapply your changes")
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestUpdateBlogRejectsStaleVersion(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	blog := createTestBlog(t, db, models.Blog{Title: "Shared draft", Published: true})
+	path := "/blogs/" + itoa(blog.ID)
+
+	// Both editors load the post at the same version
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/shared-draft"})
+	expectStatus(t, w, http.StatusOK)
+	etag := w.Header().Get("ETag")
+	if etag != `"v1"` {
+		t.Fatalf("ETag = %q, want \"v1\"", etag)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"title": "First editor's title"},
+		headers: map[string]string{"If-Match": etag}})
+	expectStatus(t, w, http.StatusOK)
+	var updated models.BlogResponse
+	decode(t, w, &updated)
+	if updated.Version != 2 || w.Header().Get("ETag") != `"v2"` {
+		t.Errorf("after the first edit: version %d, ETag %q; want 2 and \"v2\"", updated.Version, w.Header().Get("ETag"))
+	}
+
+	// The second editor's changes are based on the version just replaced
+	for name, req := range map[string]testRequest{
+		"stale If-Match":     {body: gin.H{"title": "Second editor's title"}, headers: map[string]string{"If-Match": etag}},
+		"stale weak ETag":    {body: gin.H{"title": "Second editor's title"}, headers: map[string]string{"If-Match": `W/"v1"`}},
+		"stale body version": {body: gin.H{"title": "Second editor's title", "version": 1}},
+	} {
+		req.method, req.path = http.MethodPut, path
+		w := serve(router, req)
+		expectStatus(t, w, http.StatusConflict)
+		var resp map[string]interface{}
+		decode(t, w, &resp)
+		if resp["current_version"] != float64(2) || w.Header().Get("ETag") != `"v2"` {
+			t.Errorf("%s: current_version %v, ETag %q; want 2 and \"v2\"", name, resp["current_version"], w.Header().Get("ETag"))
+		}
+	}
+
+	var stored models.Blog
+	db.First(&stored, blog.ID)
+	if stored.Title != "First editor's title" || stored.Version != 2 {
+		t.Errorf("stored post = %q at version %d, want the first editor's title at version 2", stored.Title, stored.Version)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"title": "Unversioned title"}})
+	expectStatus(t, w, http.StatusPreconditionRequired)
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"title": "Garbled title"},
+		headers: map[string]string{"If-Match": "latest"}})
+	expectStatus(t, w, http.StatusBadRequest)
+
+	// After reloading, the second editor's change applies
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"title": "Second editor's title", "version": 2}})
+	expectStatus(t, w, http.StatusOK)
+	decode(t, w, &updated)
+	if updated.Title != "Second editor's title" || updated.Version != 3 {
+		t.Errorf("after reloading: %q at version %d, want the second editor's title at version 3", updated.Title, updated.Version)
+	}
+}
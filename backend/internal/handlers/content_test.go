@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+// tagHeavyContents are well over the raw HTML minimum but carry little or no visible text
+var tagHeavyContents = map[string]string{
+	"empty paragraphs":      strings.Repeat("<p></p>", 20),
+	"nested empty elements": `<div class="wrapper"><section><article><p><span></span></p></article></section></div>`,
+	"whitespace only":       "<p>" + strings.Repeat(" \n\t", 30) + "</p><br><br><hr>",
+	"short text":            `<h2 class="title"><strong><em>Too short</em></strong></h2>` + strings.Repeat("<p> </p>", 10),
+}
+
+func TestCreateBlogRejectsTextEmptyContent(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+
+	for name, content := range tagHeavyContents {
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+			"title":   "Tag heavy " + name,
+			"content": content,
+			"author":  "Test Author",
+		}})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400; body: %s", name, w.Code, w.Body.String())
+		}
+	}
+	var count int
+	db.Model(&models.Blog{}).Count(&count)
+	if count != 0 {
+		t.Errorf("%d posts created, want 0", count)
+	}
+
+	// Exactly the minimum of visible text is accepted, however much markup wraps it
+	content := "<div><p><strong>" + strings.Repeat("a", models.MinVisibleContentLength) + "</strong></p></div>"
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title":   "Just enough text",
+		"content": content,
+		"author":  "Test Author",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+}
+
+func TestUpdateBlogRejectsTextEmptyContent(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	blog := createTestBlog(t, db, models.Blog{})
+
+	for name, content := range tagHeavyContents {
+		w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{
+			"content": content,
+			"version": blog.Version,
+		}})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400; body: %s", name, w.Code, w.Body.String())
+		}
+	}
+	var stored models.Blog
+	db.First(&stored, blog.ID)
+	if stored.Content != blog.Content {
+		t.Errorf("content = %q, want it unchanged", stored.Content)
+	}
+}
+
+func TestBlogExcerptLengthCountsCharacters(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+
+	// Each character takes two or more bytes, so these exceed the limit in bytes
+	tests := []struct {
+		name    string
+		excerpt string
+		want    int
+	}{
+		{"accented at the limit", strings.Repeat("é", models.ExcerptMaxLength), http.StatusCreated},
+		{"emoji at the limit", strings.Repeat("😀", models.ExcerptMaxLength), http.StatusCreated},
+		{"accented over the limit", strings.Repeat("é", models.ExcerptMaxLength+1), http.StatusBadRequest},
+		{"CJK over the limit", strings.Repeat("字", models.ExcerptMaxLength+1), http.StatusBadRequest},
+	}
+	for i, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+			"title":   "Multibyte excerpt " + tt.name,
+			"content": "<p>" + strings.Repeat("Post number "+strconv.Itoa(i)+" has readable content. ", 3) + "</p>",
+			"excerpt": tt.excerpt,
+			"author":  "Test Author",
+		}})
+		if w.Code != tt.want {
+			t.Errorf("create %s: status = %d, want %d; body: %s", tt.name, w.Code, tt.want, w.Body.String())
+			continue
+		}
+		if tt.want != http.StatusCreated {
+			continue
+		}
+		var resp models.BlogResponse
+		decode(t, w, &resp)
+		if resp.Excerpt != tt.excerpt {
+			t.Errorf("create %s: stored excerpt has %d characters, want %d", tt.name, len([]rune(resp.Excerpt)), models.ExcerptMaxLength)
+		}
+	}
+
+	blog := createTestBlog(t, db, models.Blog{Title: "Edited excerpt"})
+	for excerpt, want := range map[string]int{
+		strings.Repeat("ü", models.ExcerptMaxLength):   http.StatusOK,
+		strings.Repeat("ü", models.ExcerptMaxLength+1): http.StatusBadRequest,
+	} {
+		w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{"excerpt": excerpt},
+			headers: map[string]string{"If-Match": "*"}})
+		if w.Code != want {
+			t.Errorf("update with %d characters: status = %d, want %d", len([]rune(excerpt)), w.Code, want)
+		}
+	}
+}
+
+func TestCreateBlogExcerptHonorsConfiguredLength(t *testing.T) {
+	content := "<p>" + strings.Repeat("Inclusive design helps everyone who reads the web. ", 20) + "</p>"
+	for _, length := range []int{120, models.DefaultExcerptLength, models.ExcerptMaxLength} {
+		h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ExcerptLength = length })
+		router := gin.New()
+		router.POST("/blogs", h.CreateBlog)
+
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+			"title":   "Excerpt at " + strconv.Itoa(length),
+			"content": content,
+			"author":  "Test Author",
+		}})
+		expectStatus(t, w, http.StatusCreated)
+		var stored models.Blog
+		db.First(&stored)
+		if n := len([]rune(stored.Excerpt)); n > length || n < length-20 {
+			t.Errorf("EXCERPT_LENGTH=%d: excerpt has %d characters, want just under %d", length, n, length)
+		}
+	}
+}
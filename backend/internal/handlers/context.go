@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/database"
+)
+
+// requestDBKey is the gin context key caching the request-scoped database handle
+const requestDBKey = "request_db"
+
+// requestDB returns a handle on db bound to the request context, so queries
+// are cancelled when the client goes away or the request times out
+func requestDB(c *gin.Context, db *gorm.DB) *gorm.DB {
+	if bound, ok := c.Get(requestDBKey); ok {
+		return bound.(*gorm.DB)
+	}
+	bound := database.WithContext(db, c.Request.Context())
+	c.Set(requestDBKey, bound)
+	return bound
+}
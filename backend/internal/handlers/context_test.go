@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/middleware"
+)
+
+// blockingQuery counts forever until the query is interrupted
+const blockingQuery = "WITH RECURSIVE forever(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM forever) SELECT COUNT(*) FROM forever"
+
+func TestRequestTimeoutCancelsBlockingQuery(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.Use(middleware.Timeout(100 * time.Millisecond))
+	router.GET("/slow", func(c *gin.Context) {
+		var n int
+		if err := requestDB(c, db).Raw(blockingQuery).Row().Scan(&n); err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blogs")
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"count": n})
+	})
+	router.GET("/blogs", h.GetBlogs)
+
+	start := time.Now()
+	w := serve(router, testRequest{method: http.MethodGet, path: "/slow"})
+	elapsed := time.Since(start)
+	expectStatus(t, w, http.StatusServiceUnavailable)
+	if elapsed > 5*time.Second {
+		t.Errorf("blocking query ran for %v after the 100ms deadline", elapsed)
+	}
+	var resp apierror.Response
+	decode(t, w, &resp)
+	if resp.Error != "Request timed out" || resp.Code != apierror.CodeFor(http.StatusServiceUnavailable) {
+		t.Errorf("response = %+v, want a request timeout error", resp)
+	}
+
+	// Requests that finish in time are untouched, and the pool is still usable
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs"})
+	expectStatus(t, w, http.StatusOK)
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/middleware"
+	"technoprise-blog-backend/internal/models"
+)
+
+// CSPReportMaxBytes caps the size of a single CSP report payload
+const CSPReportMaxBytes int64 = 16 << 10 // 16KB
+
+// cspLog writes CSP violations as JSON lines alongside the access log
+var cspLog = log.New(os.Stdout, "", 0)
+
+// cspLogEntry is a single structured CSP violation log line
+type cspLogEntry struct {
+	Time      string `json:"time"`
+	Level     string `json:"level"`
+	Type      string `json:"type"`
+	RequestID string `json:"request_id"`
+	ClientIP  string `json:"client_ip"`
+	models.CSPReport
+}
+
+// CSPReportHandler collects Content-Security-Policy violation reports
+type CSPReportHandler struct {
+	db    *gorm.DB
+	store bool
+}
+
+// NewCSPReportHandler creates a CSP report handler. When store is true,
+// reports are also saved to the database for later review.
+func NewCSPReportHandler(db *gorm.DB, store bool) *CSPReportHandler {
+	return &CSPReportHandler{db: db, store: store}
+}
+
+// Collect handles POST /api/v1/csp-report
+// @Summary Collect CSP violation reports
+// @Description Accept legacy application/csp-report payloads and Reporting API arrays, logging each violation
+// @Tags security
+// @Accept json
+// @Param report body object true "CSP violation report"
+// @Success 204
+// @Failure 400 {object} apierror.Response
+// @Failure 413 {object} apierror.Response
+// @Failure 429 {object} apierror.Response
+// @Router /csp-report [post]
+func (h *CSPReportHandler) Collect(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+
+	reports, err := models.ParseCSPReports(body)
+	if err != nil {
+		details := "report must be valid JSON"
+		if err == models.ErrEmptyCSPReport {
+			details = err.Error()
+		}
+		apierror.Respond(c, http.StatusBadRequest, "Invalid CSP report", details)
+		return
+	}
+
+	now := time.Now().UTC()
+	for i := range reports {
+		report := &reports[i]
+		if report.UserAgent == "" {
+			report.UserAgent = c.Request.UserAgent()
+		}
+		report.Clamp()
+		report.CreatedAt = now
+
+		if h.store {
+			if err := requestDB(c, h.db).Create(report).Error; err != nil {
+				c.Error(err)
+			}
+		}
+
+		line, err := json.Marshal(cspLogEntry{
+			Time:      now.Format(time.RFC3339Nano),
+			Level:     "warn",
+			Type:      "csp_violation",
+			RequestID: middleware.GetRequestID(c),
+			ClientIP:  c.ClientIP(),
+			CSPReport: *report,
+		})
+		if err == nil {
+			cspLog.Println(string(line))
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+const legacyCSPReportBody = `{"csp-report": {
+	"document-uri": "https://blog.example.com/blog/post",
+	"referrer": "https://search.example.com/",
+	"blocked-uri": "https://evil.example.com/script.js",
+	"violated-directive": "script-src-elem",
+	"disposition": "enforce",
+	"source-file": "https://blog.example.com/app.js",
+	"line-number": 12,
+	"column-number": 4,
+	"status-code": 200,
+	"script-sample": "alert(1)"
+}}`
+
+const reportingAPIBody = `[
+	{"type": "csp-violation", "user_agent": "TestBrowser/1.0", "body": {
+		"documentURL": "https://blog.example.com/",
+		"blockedURL": "inline",
+		"effectiveDirective": "style-src-attr",
+		"disposition": "report",
+		"lineNumber": 3
+	}},
+	{"type": "deprecation", "body": {"id": "old-api"}},
+	{"type": "csp-violation", "body": {
+		"documentURL": "https://blog.example.com/about",
+		"blockedURL": "https://fonts.example.com/font.woff2",
+		"effectiveDirective": "font-src"
+	}}
+]`
+
+func TestCollectCSPReports(t *testing.T) {
+	db := newTestDB(t)
+	h := NewCSPReportHandler(db, true)
+	router := gin.New()
+	router.POST("/csp-report", h.Collect)
+
+	var logged bytes.Buffer
+	cspLog.SetOutput(&logged)
+	defer cspLog.SetOutput(os.Stdout)
+
+	tests := []struct {
+		name string
+		body string
+		want []models.CSPReport
+	}{
+		{"legacy wrapper", legacyCSPReportBody, []models.CSPReport{{
+			DocumentURI:        "https://blog.example.com/blog/post",
+			Referrer:           "https://search.example.com/",
+			BlockedURI:         "https://evil.example.com/script.js",
+			ViolatedDirective:  "script-src-elem",
+			EffectiveDirective: "script-src-elem",
+			Disposition:        "enforce",
+			SourceFile:         "https://blog.example.com/app.js",
+			LineNumber:         12,
+			ColumnNumber:       4,
+			StatusCode:         200,
+			Sample:             "alert(1)",
+			UserAgent:          "LegacyBrowser/1.0",
+		}}},
+		{"reporting API array", reportingAPIBody, []models.CSPReport{{
+			DocumentURI:        "https://blog.example.com/",
+			BlockedURI:         "inline",
+			ViolatedDirective:  "style-src-attr",
+			EffectiveDirective: "style-src-attr",
+			Disposition:        "report",
+			LineNumber:         3,
+			UserAgent:          "TestBrowser/1.0",
+		}, {
+			DocumentURI:        "https://blog.example.com/about",
+			BlockedURI:         "https://fonts.example.com/font.woff2",
+			ViolatedDirective:  "font-src",
+			EffectiveDirective: "font-src",
+			UserAgent:          "LegacyBrowser/1.0",
+		}}},
+	}
+	for _, tt := range tests {
+		db.Delete(&models.CSPReport{})
+		logged.Reset()
+
+		w := serve(router, testRequest{method: http.MethodPost, path: "/csp-report", body: tt.body,
+			headers: map[string]string{"Content-Type": "application/csp-report", "User-Agent": "LegacyBrowser/1.0"}})
+		expectStatus(t, w, http.StatusNoContent)
+
+		var stored []models.CSPReport
+		db.Order("id").Find(&stored)
+		if len(stored) != len(tt.want) {
+			t.Fatalf("%s: stored %d reports, want %d", tt.name, len(stored), len(tt.want))
+		}
+		lines := strings.Split(strings.TrimSpace(logged.String()), "\n")
+		if len(lines) != len(tt.want) {
+			t.Fatalf("%s: logged %d lines, want %d: %q", tt.name, len(lines), len(tt.want), logged.String())
+		}
+		for i, want := range tt.want {
+			got := stored[i]
+			want.ID, want.CreatedAt = got.ID, got.CreatedAt
+			if got != want {
+				t.Errorf("%s: report %d = %+v, want %+v", tt.name, i, got, want)
+			}
+
+			var entry map[string]interface{}
+			if err := json.Unmarshal([]byte(lines[i]), &entry); err != nil {
+				t.Fatalf("%s: log line %q is not JSON: %v", tt.name, lines[i], err)
+			}
+			if entry["type"] != "csp_violation" || entry["effective_directive"] != want.EffectiveDirective {
+				t.Errorf("%s: log line = %v", tt.name, entry)
+			}
+		}
+	}
+
+	for _, body := range []string{`not json`, `{}`, `{"csp-report": {}}`, `[]`, `[{"type": "deprecation", "body": {}}]`} {
+		w := serve(router, testRequest{method: http.MethodPost, path: "/csp-report", body: body})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", body, w.Code)
+		}
+	}
+}
+
+func TestCollectCSPReportsWithoutStore(t *testing.T) {
+	db := newTestDB(t)
+	router := gin.New()
+	router.POST("/csp-report", NewCSPReportHandler(db, false).Collect)
+
+	var logged bytes.Buffer
+	cspLog.SetOutput(&logged)
+	defer cspLog.SetOutput(os.Stdout)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/csp-report", body: legacyCSPReportBody})
+	expectStatus(t, w, http.StatusNoContent)
+	var count int
+	db.Model(&models.CSPReport{}).Count(&count)
+	if count != 0 || logged.Len() == 0 {
+		t.Errorf("stored %d reports and logged %d bytes, want none stored and a log line", count, logged.Len())
+	}
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// AutosaveBlog handles PUT /api/v1/blogs/:id/autosave
+// @Summary Autosave a working copy of a blog post
+// @Description Store the editor's unvalidated working copy, replacing any previous autosave. The live post is not changed and no webhooks are sent.
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Param draft body models.AutosaveRequest true "Working copy"
+// @Success 200 {object} models.BlogDraftResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/autosave [put]
+func (h *BlogHandler) AutosaveBlog(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	var req models.AutosaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id").First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	// Save updates the existing draft or inserts the first one
+	draft := models.NewBlogDraft(blog.ID, req)
+	if err := requestDB(c, h.db).Save(&draft).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to save draft")
+		return
+	}
+
+	c.JSON(http.StatusOK, draft.ToResponse())
+}
+
+// GetBlogDraft handles GET /api/v1/blogs/:id/draft
+// @Summary Get the autosaved working copy of a blog post
+// @Description Retrieve the latest autosave of a post, which may differ from the live post
+// @Tags drafts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Success 200 {object} models.BlogDraftResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/draft [get]
+func (h *BlogHandler) GetBlogDraft(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	var draft models.BlogDraft
+	if err := requestDB(c, h.db).Where("blog_id = ?", id).First(&draft).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Draft not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch draft")
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, draft.ToResponse())
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/webhooks"
+)
+
+func TestAutosaveBlog(t *testing.T) {
+	var deliveries int32
+	hooks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&deliveries, 1)
+	}))
+	defer hooks.Close()
+
+	h, db := newTestHandler(t, nil)
+	h.webhooks = webhooks.NewDispatcher([]string{hooks.URL}, "test-webhook-secret")
+	router := gin.New()
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	router.PUT("/blogs/:id/autosave", requireTestAPIKey(), h.AutosaveBlog)
+	router.GET("/blogs/:slug/draft", requireTestAPIKey(), h.GetBlogDraft)
+	live := createTestBlog(t, db, models.Blog{Title: "Live post", Tags: "css", Published: true})
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + itoa(live.ID) + "/draft", apiKey: true})
+	expectStatus(t, w, http.StatusNotFound)
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(live.ID) + "/autosave", body: gin.H{"title": "Unauthorized"}})
+	expectStatus(t, w, http.StatusUnauthorized)
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/999/autosave", body: gin.H{"title": "Nowhere"}, apiKey: true})
+	expectStatus(t, w, http.StatusNotFound)
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/abc/autosave", body: gin.H{"title": "Nowhere"}, apiKey: true})
+	expectStatus(t, w, http.StatusBadRequest)
+
+	// Working copies are stored without validation: a blank title and a single word pass
+	longContent := "<p>" + strings.Repeat("word ", 1000) + "</p>"
+	autosave := func(body gin.H) models.BlogDraftResponse {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(live.ID) + "/autosave", body: body, apiKey: true})
+		expectStatus(t, w, http.StatusOK)
+		var draft models.BlogDraftResponse
+		decode(t, w, &draft)
+		return draft
+	}
+	first := autosave(gin.H{"title": "", "content": "<p>Half</p><script>alert(1)</script>", "tags": "a, " + strings.Repeat("x", 80)})
+	if first.BlogID != live.ID || first.Title != "" || strings.Contains(first.Content, "script") {
+		t.Errorf("first autosave = %+v", first)
+	}
+	second := autosave(gin.H{"title": "Work in progress", "content": longContent, "excerpt": "Being rewritten"})
+
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + itoa(live.ID) + "/draft", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	if cacheControl := w.Header().Get("Cache-Control"); cacheControl != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", cacheControl)
+	}
+	var draft models.BlogDraftResponse
+	decode(t, w, &draft)
+	if draft.Title != "Work in progress" || draft.Content != longContent || draft.Excerpt != "Being rewritten" || draft.Tags != "" {
+		t.Errorf("draft = %+v, want the second autosave only", draft)
+	}
+	if draft.SavedAt.IsZero() || draft.SavedAt.Before(first.SavedAt) || !draft.SavedAt.Equal(second.SavedAt) {
+		t.Errorf("saved_at = %v, want the second autosave's %v", draft.SavedAt, second.SavedAt)
+	}
+	var drafts int
+	db.Model(&models.BlogDraft{}).Count(&drafts)
+	if drafts != 1 {
+		t.Errorf("drafts stored = %d, want 1", drafts)
+	}
+
+	// The live post, its reading time and its version are untouched
+	var stored models.Blog
+	db.First(&stored, live.ID)
+	if stored.Title != "Live post" || stored.Content != testContent || stored.Tags != "css" || stored.ReadingTime != live.ReadingTime ||
+		stored.Version != live.Version || !stored.Published {
+		t.Errorf("live post changed by autosave: %+v", stored)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	if n := atomic.LoadInt32(&deliveries); n != 0 {
+		t.Fatalf("autosave sent %d webhooks", n)
+	}
+	// A real update does send one, so the check above is meaningful
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(live.ID), body: gin.H{"excerpt": "Edited live"},
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&deliveries) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if atomic.LoadInt32(&deliveries) == 0 {
+		t.Error("update sent no webhook")
+	}
+}
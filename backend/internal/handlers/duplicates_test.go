@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestCreateBlogDuplicateContent(t *testing.T) {
+	h, _ := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+
+	original := "<p>Screen readers announce headings, so use them to structure every long article.</p>"
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Structure with headings", "content": original, "author": "Test Author",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+
+	tests := []struct {
+		name    string
+		query   string
+		title   string
+		content string
+		want    int
+	}{
+		{"exact duplicate", "", "Copied post", original, http.StatusConflict},
+		{"markup, case and whitespace differences", "", "Reformatted copy",
+			"<div><h2>Screen READERS announce headings,</h2>\n<p>so use them   to structure every long article.</p></div>", http.StatusConflict},
+		{"changed word", "", "Near duplicate",
+			"<p>Screen readers announce headings, so use them to structure every short article.</p>", http.StatusCreated},
+		{"extra sentence", "", "Extended version",
+			original + "<p>Skipping levels confuses readers.</p>", http.StatusCreated},
+		{"duplicate allowed", "?allow_duplicate=true", "Deliberate repost", original, http.StatusCreated},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs" + tt.query, body: gin.H{
+			"title": tt.title, "content": tt.content, "author": "Test Author",
+		}})
+		if w.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d; body: %s", tt.name, w.Code, tt.want, w.Body.String())
+			continue
+		}
+		if tt.want == http.StatusConflict {
+			var resp map[string]interface{}
+			decode(t, w, &resp)
+			if resp["conflicting_slug"] != "structure-with-headings" {
+				t.Errorf("%s: conflicting_slug = %v, want structure-with-headings", tt.name, resp["conflicting_slug"])
+			}
+		}
+	}
+}
+
+func TestCreateBlogSimilarTitleWarning(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold float64
+		title     string
+		want      []string
+	}{
+		{"same words", models.DefaultSimilarTitleThreshold, "building accessible React components!", []string{"building-accessible-react-components"}},
+		{"reordered with an extra word", models.DefaultSimilarTitleThreshold, "Accessible React components: building guide", []string{"building-accessible-react-components"}},
+		{"distinct title", models.DefaultSimilarTitleThreshold, "Dark mode colour palettes", nil},
+		{"above a stricter threshold", 0.9, "Accessible React components: building guide", nil},
+		{"warnings disabled", 0, "Building Accessible React Components", nil},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.SimilarTitleThreshold = tt.threshold })
+			router := gin.New()
+			router.POST("/blogs", h.CreateBlog)
+			createTestBlog(t, db, models.Blog{Title: "Building Accessible React Components", Published: true})
+
+			w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+				"title":   tt.title,
+				"content": "<p>Similar title case " + strconv.Itoa(i) + " has different content.</p>" + testContent,
+				"author":  "Test Author",
+			}})
+			expectStatus(t, w, http.StatusCreated)
+			var resp models.CreateBlogResponse
+			decode(t, w, &resp)
+			var slugs []string
+			for _, post := range resp.SimilarPosts {
+				slugs = append(slugs, post.Slug)
+			}
+			if !reflect.DeepEqual(slugs, tt.want) {
+				t.Errorf("similar_posts = %+v, want slugs %v", resp.SimilarPosts, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestSetFeaturedLimit(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.MaxFeatured = 2 })
+	router := gin.New()
+	router.PATCH("/blogs/:id/featured", h.SetFeatured)
+
+	featured := createTestBlog(t, db, models.Blog{Title: "Already featured", Featured: true})
+	second := createTestBlog(t, db, models.Blog{Title: "Second"})
+	third := createTestBlog(t, db, models.Blog{Title: "Third"})
+	setFeatured := func(id uint, value bool) int {
+		w := serve(router, testRequest{method: http.MethodPatch, path: "/blogs/" + itoa(id) + "/featured", body: gin.H{"featured": value}})
+		if w.Code == http.StatusOK {
+			var resp models.BlogResponse
+			decode(t, w, &resp)
+			if resp.Featured != value {
+				t.Errorf("post %d: response featured = %v, want %v", id, resp.Featured, value)
+			}
+		}
+		return w.Code
+	}
+
+	// One below the limit, featuring another post is allowed
+	if status := setFeatured(second.ID, true); status != http.StatusOK {
+		t.Fatalf("feature at 1 of 2: status = %d, want 200", status)
+	}
+	// At the limit it is rejected
+	if status := setFeatured(third.ID, true); status != http.StatusConflict {
+		t.Errorf("feature at 2 of 2: status = %d, want 409", status)
+	}
+	// Re-featuring a featured post and unfeaturing never count against it
+	if status := setFeatured(featured.ID, true); status != http.StatusOK {
+		t.Errorf("re-feature at 2 of 2: status = %d, want 200", status)
+	}
+	if status := setFeatured(featured.ID, false); status != http.StatusOK {
+		t.Errorf("unfeature: status = %d, want 200", status)
+	}
+	// Unfeaturing frees a slot
+	if status := setFeatured(third.ID, true); status != http.StatusOK {
+		t.Errorf("feature after unfeature: status = %d, want 200", status)
+	}
+
+	var count int
+	db.Model(&models.Blog{}).Where("featured = ?", true).Count(&count)
+	if count != 2 {
+		t.Errorf("featured posts = %d, want 2", count)
+	}
+}
+
+func TestSetFeaturedWithoutLimit(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.MaxFeatured = 0 })
+	router := gin.New()
+	router.PATCH("/blogs/:id/featured", h.SetFeatured)
+
+	for _, title := range []string{"One", "Two", "Three"} {
+		blog := createTestBlog(t, db, models.Blog{Title: title})
+		w := serve(router, testRequest{method: http.MethodPatch, path: "/blogs/" + itoa(blog.ID) + "/featured", body: gin.H{"featured": true}})
+		expectStatus(t, w, http.StatusOK)
+	}
+
+	w := serve(router, testRequest{method: http.MethodPatch, path: "/blogs/1/featured", body: gin.H{}})
+	expectStatus(t, w, http.StatusBadRequest)
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// feedItemLimit is the number of latest posts included in a feed
+const feedItemLimit = 20
+
+// GetTagFeed handles GET /api/v1/tags/:tag/feed.rss
+// @Summary Get the RSS feed for a tag
+// @Description RSS 2.0 feed of the latest published posts carrying the tag
+// @Tags tags
+// @Produce xml
+// @Param tag path string true "Tag name"
+// @Success 200 {object} models.RSS
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /tags/{tag}/feed.rss [get]
+func (h *BlogHandler) GetTagFeed(c *gin.Context) {
+	tag := strings.TrimSpace(c.Param("tag"))
+
+	var blogs []models.Blog
+	if tag != "" {
+		if err := whereHasTag(requestDB(c, h.db).Where("published = ?", true), tag).
+			Order("published_at DESC").
+			Limit(feedItemLimit).
+			Find(&blogs).Error; err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blogs")
+			return
+		}
+	}
+	if len(blogs) == 0 {
+		apierror.Respond(c, http.StatusNotFound, "Tag not found")
+		return
+	}
+
+	name := tagDisplayName(blogs[0], tag)
+	feed := models.NewRSSFeed(
+		models.FeedTitle+": "+name,
+		strings.TrimRight(h.siteURL, "/")+"/blog?tag="+url.QueryEscape(name),
+		"The latest posts tagged "+name,
+		h.feedSelfURL(c),
+		h.siteURL,
+		blogs,
+	)
+	respondFeed(c, feed)
+}
+
+// GetAuthorFeed handles GET /api/v1/authors/:slug/feed.rss
+// @Summary Get the RSS feed for an author
+// @Description RSS 2.0 feed of the latest published posts by the author, identified by the slug of their name as listed by /authors
+// @Tags authors
+// @Produce xml
+// @Param slug path string true "Author slug, e.g. jane-doe"
+// @Success 200 {object} models.RSS
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /authors/{slug}/feed.rss [get]
+func (h *BlogHandler) GetAuthorFeed(c *gin.Context) {
+	authors, err := h.publishedAuthors(c)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch authors")
+		return
+	}
+
+	slug := models.CanonicalSlug(c.Param("slug"))
+	author := ""
+	for _, candidate := range authors {
+		if candidate.Slug == slug {
+			author = candidate.Author
+			break
+		}
+	}
+	if slug == "" || author == "" {
+		apierror.Respond(c, http.StatusNotFound, "Author not found")
+		return
+	}
+
+	var blogs []models.Blog
+	if err := requestDB(c, h.db).Where("published = ? AND author = ?", true, author).
+		Order("published_at DESC").
+		Limit(feedItemLimit).
+		Find(&blogs).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blogs")
+		return
+	}
+
+	feed := models.NewRSSFeed(
+		models.FeedTitle+": "+author,
+		strings.TrimRight(h.siteURL, "/")+"/blog?author="+url.QueryEscape(author),
+		"The latest posts by "+author,
+		h.feedSelfURL(c),
+		h.siteURL,
+		blogs,
+	)
+	respondFeed(c, feed)
+}
+
+// feedSelfURL is the feed's own absolute URL under SITE_URL. Feeds are cached
+// and shared by readers, so the link must not come from the request's Host.
+func (h *BlogHandler) feedSelfURL(c *gin.Context) string {
+	return strings.TrimRight(h.siteURL, "/") + c.Request.URL.Path
+}
+
+// respondFeed writes an RSS document
+func respondFeed(c *gin.Context, feed models.RSS) {
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to encode feed")
+		return
+	}
+	c.Data(http.StatusOK, "application/rss+xml; charset=utf-8", append([]byte(xml.Header), body...))
+}
+
+// tagDisplayName returns the tag as spelled on the post, falling back to the
+// requested form
+func tagDisplayName(blog models.Blog, tag string) string {
+	for _, candidate := range blog.ToResponse(false).Tags {
+		if strings.EqualFold(candidate, tag) {
+			return candidate
+		}
+	}
+	return tag
+}
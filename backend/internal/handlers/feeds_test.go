@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+// decodeFeed parses an RSS response
+func decodeFeed(t *testing.T, w *httptest.ResponseRecorder) models.RSS {
+	t.Helper()
+	if got := w.Header().Get("Content-Type"); got != "application/rss+xml; charset=utf-8" {
+		t.Errorf("Content-Type = %q, want application/rss+xml; charset=utf-8", got)
+	}
+	if !strings.HasPrefix(w.Body.String(), xml.Header) {
+		t.Errorf("feed does not start with the XML declaration: %.60q", w.Body.String())
+	}
+	var feed models.RSS
+	if err := xml.Unmarshal(w.Body.Bytes(), &feed); err != nil {
+		t.Fatalf("decode feed %q: %v", w.Body.String(), err)
+	}
+	return feed
+}
+
+// feedTitles lists the titles of a feed's items
+func feedTitles(feed models.RSS) []string {
+	titles := []string{}
+	for _, item := range feed.Channel.Items {
+		titles = append(titles, item.Title)
+	}
+	return titles
+}
+
+func TestGetTagFeed(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/api/v1/tags/:tag/feed.rss", h.GetTagFeed)
+
+	createTestBlog(t, db, models.Blog{Title: "Screen readers", Tags: "Accessibility, audio", Published: true, PublishedAt: timeAt(time.March, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Colour contrast", Tags: "design, accessibility", Published: true, PublishedAt: timeAt(time.March, 5)})
+	createTestBlog(t, db, models.Blog{Title: "Release notes", Tags: "news", Published: true, PublishedAt: timeAt(time.March, 9)})
+	createTestBlog(t, db, models.Blog{Title: "Accessibility audit", Tags: "accessibility-audits", Published: true, PublishedAt: timeAt(time.March, 7)})
+	createTestBlog(t, db, models.Blog{Title: "Draft checklist", Tags: "accessibility"})
+	createTestBlog(t, db, models.Blog{Title: "Design only draft", Tags: "drafts"})
+
+	// Absolute links come from SITE_URL, whatever Host the request claimed
+	w := serve(router, testRequest{method: http.MethodGet, path: "/api/v1/tags/accessibility/feed.rss", host: "attacker.example"})
+	expectStatus(t, w, http.StatusOK)
+	feed := decodeFeed(t, w)
+
+	// Only published posts carrying the whole tag appear, newest first
+	if got, want := feedTitles(feed), []string{"Colour contrast", "Screen readers"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+	channel := feed.Channel
+	if want := models.FeedTitle + ": accessibility"; channel.Title != want {
+		t.Errorf("title = %q, want %q", channel.Title, want)
+	}
+	// The channel link and atom:link share a local name, so check them in the markup
+	if want := "<link>" + strings.TrimRight(h.siteURL, "/") + "/blog?tag=accessibility</link>"; !strings.Contains(w.Body.String(), want) {
+		t.Errorf("feed lacks channel link %s", want)
+	}
+	if want := `<atom:link href="` + strings.TrimRight(h.siteURL, "/") + `/api/v1/tags/accessibility/feed.rss" rel="self"`; !strings.Contains(w.Body.String(), want) {
+		t.Errorf("feed lacks self link %s", want)
+	}
+	if strings.Contains(w.Body.String(), "attacker.example") {
+		t.Error("feed links use the request Host")
+	}
+	item := channel.Items[0]
+	if want := models.BlogURL(h.siteURL, "colour-contrast"); item.Link != want || item.GUID.Value != want {
+		t.Errorf("item link = %q, guid = %q, want %q", item.Link, item.GUID.Value, want)
+	}
+	if want := timeAt(time.March, 5).Format(time.RFC1123Z); item.PubDate != want {
+		t.Errorf("pubDate = %q, want %q", item.PubDate, want)
+	}
+	if !reflect.DeepEqual(item.Categories, []string{"design", "accessibility"}) {
+		t.Errorf("categories = %v", item.Categories)
+	}
+
+	// Tags match case-insensitively; the title uses the newest post's spelling
+	w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/tags/AUDIO/feed.rss"})
+	expectStatus(t, w, http.StatusOK)
+	feed = decodeFeed(t, w)
+	if got := feedTitles(feed); !reflect.DeepEqual(got, []string{"Screen readers"}) {
+		t.Errorf("audio items = %v", got)
+	}
+	if want := models.FeedTitle + ": audio"; feed.Channel.Title != want {
+		t.Errorf("audio title = %q, want %q", feed.Channel.Title, want)
+	}
+
+	for _, tag := range []string{"drafts", "missing", "%20"} {
+		w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/tags/" + tag + "/feed.rss"})
+		expectStatus(t, w, http.StatusNotFound)
+	}
+}
+
+func TestGetTagFeedLimit(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/api/v1/tags/:tag/feed.rss", h.GetTagFeed)
+
+	for day := 1; day <= feedItemLimit+3; day++ {
+		createTestBlog(t, db, models.Blog{Title: "Post " + itoa(uint(day)), Tags: "weekly", Published: true, PublishedAt: timeAt(time.January, day)})
+	}
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/api/v1/tags/weekly/feed.rss"})
+	expectStatus(t, w, http.StatusOK)
+	titles := feedTitles(decodeFeed(t, w))
+	if len(titles) != feedItemLimit || titles[0] != "Post 23" || titles[feedItemLimit-1] != "Post 4" {
+		t.Errorf("items = %v, want the %d newest posts", titles, feedItemLimit)
+	}
+}
+
+func TestGetAuthorFeed(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/api/v1/authors/:slug/feed.rss", h.GetAuthorFeed)
+
+	createTestBlog(t, db, models.Blog{Title: "Screen readers", Author: "Jane Doe", Published: true, PublishedAt: timeAt(time.March, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Colour contrast", Author: "Jane Doe", Published: true, PublishedAt: timeAt(time.March, 5)})
+	createTestBlog(t, db, models.Blog{Title: "Release notes", Author: "John Smith", Published: true, PublishedAt: timeAt(time.March, 9)})
+	createTestBlog(t, db, models.Blog{Title: "Draft checklist", Author: "Jane Doe"})
+	createTestBlog(t, db, models.Blog{Title: "Unpublished only", Author: "Drafty Writer"})
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/api/v1/authors/jane-doe/feed.rss", host: "attacker.example"})
+	expectStatus(t, w, http.StatusOK)
+	feed := decodeFeed(t, w)
+
+	// Only the author's published posts appear, newest first
+	if got, want := feedTitles(feed), []string{"Colour contrast", "Screen readers"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+	if want := models.FeedTitle + ": Jane Doe"; feed.Channel.Title != want {
+		t.Errorf("title = %q, want %q", feed.Channel.Title, want)
+	}
+	if want := "<link>" + strings.TrimRight(h.siteURL, "/") + "/blog?author=Jane+Doe</link>"; !strings.Contains(w.Body.String(), want) {
+		t.Errorf("feed lacks channel link %s", want)
+	}
+	if want := `<atom:link href="` + strings.TrimRight(h.siteURL, "/") + `/api/v1/authors/jane-doe/feed.rss" rel="self"`; !strings.Contains(w.Body.String(), want) {
+		t.Errorf("feed lacks self link %s", want)
+	}
+	if strings.Contains(w.Body.String(), "attacker.example") {
+		t.Error("feed links use the request Host")
+	}
+
+	// Slugs match case-insensitively
+	w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/authors/John-Smith/feed.rss"})
+	expectStatus(t, w, http.StatusOK)
+	if got := feedTitles(decodeFeed(t, w)); !reflect.DeepEqual(got, []string{"Release notes"}) {
+		t.Errorf("John Smith items = %v", got)
+	}
+
+	for _, slug := range []string{"drafty-writer", "nobody", "%20"} {
+		w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/authors/" + slug + "/feed.rss"})
+		expectStatus(t, w, http.StatusNotFound)
+	}
+}
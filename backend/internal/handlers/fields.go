@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// blogResponseFields is the set of JSON field names selectable via ?fields=
+var blogResponseFields = jsonFieldNames(reflect.TypeOf(models.BlogDetailResponse{}))
+
+// trimmedBlogList is a list response whose posts have been trimmed to the
+// requested fields; its Blogs field shadows the embedded one
+type trimmedBlogList struct {
+	models.BlogListResponse
+	Blogs []map[string]interface{} `json:"blogs"`
+}
+
+// jsonFieldNames returns the JSON names of a struct type's exported fields,
+// including those promoted from embedded structs
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name := range jsonFieldNames(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// parseFields reads ?fields= into a set of selected field names, always
+// including id. It returns nil when no selection was requested. Unknown names
+// are ignored, or rejected with a 400 when STRICT_FIELDS is enabled.
+func (h *BlogHandler) parseFields(c *gin.Context) (map[string]bool, bool) {
+	raw := strings.TrimSpace(c.Query("fields"))
+	if raw == "" {
+		return nil, true
+	}
+
+	fields := map[string]bool{"id": true}
+	var unknown []string
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !blogResponseFields[name] {
+			unknown = append(unknown, name)
+			continue
+		}
+		fields[name] = true
+	}
+
+	if len(unknown) > 0 && h.strictFields {
+		valid := make([]string, 0, len(blogResponseFields))
+		for name := range blogResponseFields {
+			valid = append(valid, name)
+		}
+		sort.Strings(valid)
+
+		apierror.RespondWith(c, http.StatusBadRequest, "Invalid fields", gin.H{
+			"unknown_fields": unknown,
+			"valid_fields":   valid,
+		}, "Unknown fields: "+strings.Join(unknown, ", "))
+		return nil, false
+	}
+
+	return fields, true
+}
+
+// selectFields encodes a response and keeps only the selected top-level fields
+func selectFields(v interface{}, fields map[string]bool) (map[string]interface{}, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]interface{}
+	if err := json.Unmarshal(body, &all); err != nil {
+		return nil, err
+	}
+
+	trimmed := make(map[string]interface{}, len(fields))
+	for name, value := range all {
+		if fields[name] {
+			trimmed[name] = value
+		}
+	}
+	return trimmed, nil
+}
+
+// trimBlogList applies a field selection to every post in a list response
+func trimBlogList(response models.BlogListResponse, fields map[string]bool) (trimmedBlogList, error) {
+	trimmed := trimmedBlogList{
+		BlogListResponse: response,
+		Blogs:            make([]map[string]interface{}, len(response.Blogs)),
+	}
+	for i, blog := range response.Blogs {
+		selected, err := selectFields(blog, fields)
+		if err != nil {
+			return trimmed, err
+		}
+		trimmed.Blogs[i] = selected
+	}
+	return trimmed, nil
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+// keys returns the sorted keys of a decoded JSON object
+func keys(object map[string]interface{}) []string {
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestFieldSelection(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	createTestBlog(t, db, models.Blog{Title: "Selected post", Published: true})
+
+	tests := []struct {
+		fields string
+		want   []string
+	}{
+		{"title,slug,excerpt,reading_time", []string{"excerpt", "id", "reading_time", "slug", "title"}},
+		{"TITLE, , slug", []string{"id", "slug", "title"}},
+		{"id", []string{"id"}},
+		{"title,no_such_field", []string{"id", "title"}},
+		{"tags,view_count", []string{"id", "tags", "view_count"}},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?fields=" + url.QueryEscape(tt.fields)})
+		expectStatus(t, w, http.StatusOK)
+		var list struct {
+			Blogs []map[string]interface{} `json:"blogs"`
+			Total int                      `json:"total"`
+		}
+		decode(t, w, &list)
+		if len(list.Blogs) != 1 || list.Total != 1 {
+			t.Fatalf("%s: got %d posts of %d", tt.fields, len(list.Blogs), list.Total)
+		}
+		if got := keys(list.Blogs[0]); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("list fields=%s: keys = %v, want %v", tt.fields, got, tt.want)
+		}
+
+		w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/selected-post?fields=" + url.QueryEscape(tt.fields)})
+		expectStatus(t, w, http.StatusOK)
+		var detail map[string]interface{}
+		decode(t, w, &detail)
+		if got := keys(detail); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("detail fields=%s: keys = %v, want %v", tt.fields, got, tt.want)
+		}
+	}
+
+	// Fields only present on single posts can be selected there
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/selected-post?fields=content,meta"})
+	var detail map[string]interface{}
+	decode(t, w, &detail)
+	if got := keys(detail); !reflect.DeepEqual(got, []string{"content", "id", "meta"}) {
+		t.Errorf("detail fields=content,meta: keys = %v", got)
+	}
+}
+
+func TestFieldSelectionStrict(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.StrictFields = true })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	createTestBlog(t, db, models.Blog{Title: "Selected post", Published: true})
+
+	for _, path := range []string{"/blogs?fields=title,bogus", "/blogs/selected-post?fields=title,bogus"} {
+		w := serve(router, testRequest{method: http.MethodGet, path: path})
+		expectStatus(t, w, http.StatusBadRequest)
+		var resp struct {
+			UnknownFields []string `json:"unknown_fields"`
+			ValidFields   []string `json:"valid_fields"`
+		}
+		decode(t, w, &resp)
+		if !reflect.DeepEqual(resp.UnknownFields, []string{"bogus"}) || len(resp.ValidFields) == 0 {
+			t.Errorf("%s: body = %+v", path, resp)
+		}
+	}
+	expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: "/blogs?fields=title,slug"}), http.StatusOK)
+}
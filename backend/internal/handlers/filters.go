@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// normalizedTagsColumn wraps the comma-separated tags column in delimiters so a
+// tag can be matched as a whole item, e.g. ",react,accessibility,"
+const normalizedTagsColumn = "(',' || REPLACE(REPLACE(LOWER(tags), ', ', ','), ' ,', ',') || ',')"
+
+// escapeLike escapes LIKE wildcards so user input is matched literally
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
+
+// tagPattern builds a LIKE pattern matching a single whole tag
+func tagPattern(tag string) string {
+	return "%," + escapeLike(strings.ToLower(strings.TrimSpace(tag))) + ",%"
+}
+
+// whereHasTag restricts a query to posts carrying the given tag
+func whereHasTag(query *gorm.DB, tag string) *gorm.DB {
+	return query.Where(normalizedTagsColumn+` LIKE ? ESCAPE '\'`, tagPattern(tag))
+}
+
+// whereHasTags restricts a query to posts carrying every tag when matchAll is
+// set, or any of them otherwise
+func whereHasTags(query *gorm.DB, tags []string, matchAll bool) *gorm.DB {
+	if matchAll {
+		for _, tag := range tags {
+			query = whereHasTag(query, tag)
+		}
+		return query
+	}
+
+	conditions := make([]string, len(tags))
+	patterns := make([]interface{}, len(tags))
+	for i, tag := range tags {
+		conditions[i] = normalizedTagsColumn + ` LIKE ? ESCAPE '\'`
+		patterns[i] = tagPattern(tag)
+	}
+	return query.Where(strings.Join(conditions, " OR "), patterns...)
+}
+
+// splitTags parses a comma-separated tag list, dropping blanks and duplicates
+func splitTags(value string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// searchPattern builds the LIKE pattern shared by the search filter and its ranking
+func searchPattern(search string) string {
+	return "%" + strings.ToLower(search) + "%"
+}
+
+// whereMatchesSearch restricts a query to posts whose title, content, excerpt
+// or tags contain the search term
+func whereMatchesSearch(query *gorm.DB, search string) *gorm.DB {
+	searchTerm := searchPattern(search)
+	return query.Where(
+		"LOWER(title) LIKE ? OR LOWER(content) LIKE ? OR LOWER(excerpt) LIKE ? OR LOWER(tags) LIKE ?",
+		searchTerm, searchTerm, searchTerm, searchTerm,
+	)
+}
+
+// orderBySearchRank sorts search results by relevance, weighting title matches
+// over tag, excerpt and content matches. Ties fall back to the newest id so
+// pages never overlap; the ranking only orders the rows whereMatchesSearch
+// selected, so counts of the filtered query stay accurate.
+func orderBySearchRank(query *gorm.DB, search string) *gorm.DB {
+	searchTerm := searchPattern(search)
+	return query.
+		Select("blogs.*, (CASE WHEN LOWER(title) LIKE ? THEN 8 ELSE 0 END + "+
+			"CASE WHEN LOWER(tags) LIKE ? THEN 4 ELSE 0 END + "+
+			"CASE WHEN LOWER(excerpt) LIKE ? THEN 2 ELSE 0 END + "+
+			"CASE WHEN LOWER(content) LIKE ? THEN 1 ELSE 0 END) AS search_rank",
+			searchTerm, searchTerm, searchTerm, searchTerm).
+		Order("search_rank DESC").
+		Order("id DESC")
+}
+
+// prefixPattern builds a LIKE pattern matching values that start with prefix
+func prefixPattern(prefix string) string {
+	return escapeLike(strings.ToLower(strings.TrimSpace(prefix))) + "%"
+}
+
+// tagPrefixPattern builds a LIKE pattern matching any tag that starts with prefix
+func tagPrefixPattern(prefix string) string {
+	return "%," + prefixPattern(prefix)
+}
@@ -0,0 +1,279 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestGetBlogsFilters(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+
+	createTestBlog(t, db, models.Blog{Title: "Jane react", Author: "Jane Doe", Tags: "react, accessibility",
+		Published: true, PublishedAt: timeAt(time.January, 10)})
+	createTestBlog(t, db, models.Blog{Title: "Jane reactive", Author: "Jane Doe", Tags: "reactive",
+		Published: true, PublishedAt: timeAt(time.February, 10)})
+	createTestBlog(t, db, models.Blog{Title: "John react", Author: "John Roe", Tags: "React",
+		Published: true, PublishedAt: timeAt(time.March, 10)})
+	createTestBlog(t, db, models.Blog{Title: "Jane draft", Author: "Jane Doe", Tags: "react"})
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"author=jane%20doe", []string{"Jane reactive", "Jane react"}},
+		{"tag=react", []string{"John react", "Jane react"}},
+		{"tag=reactive", []string{"Jane reactive"}},
+		{"tag=rea", []string{}},
+		{"from=2024-02-01T00:00:00Z", []string{"John react", "Jane reactive"}},
+		{"to=2024-02-15T00:00:00Z", []string{"Jane reactive", "Jane react"}},
+		{"from=2024-02-01T00:00:00Z&to=2024-02-15T00:00:00Z", []string{"Jane reactive"}},
+		{"author=Jane%20Doe&tag=react", []string{"Jane react"}},
+		{"author=John%20Roe&tag=react&from=2024-01-01T00:00:00Z&to=2024-12-31T00:00:00Z", []string{"John react"}},
+		{"author=Jane%20Doe&from=2024-03-01T00:00:00Z", []string{}},
+		{"author=Jane%20Doe&tag=react&published=false", []string{"Jane draft"}},
+	}
+	for _, tt := range tests {
+		if got := listTitles(t, router, "/blogs?"+tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: titles = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	for _, query := range []string{"from=yesterday", "to=2024-02-15", "from=2024-02-01T00:00:00Z&to=soon"} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?" + query})
+		expectStatus(t, w, http.StatusBadRequest)
+	}
+}
+
+func TestGetBlogsTagModes(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+
+	for i, post := range []struct{ title, tags string }{
+		{"React and a11y", "react, accessibility"},
+		{"React only", "React"},
+		{"A11y and design", "accessibility,design"},
+		{"All three", "design , react, Accessibility"},
+		{"Reactive", "reactive, accessibility-audits"},
+		{"Wildcards", "100%, c_sharp"},
+		{"Similar wildcards", "1000, cxsharp"},
+	} {
+		createTestBlog(t, db, models.Blog{Title: post.title, Tags: post.tags, Published: true, PublishedAt: timeAt(time.April, i+1)})
+	}
+	createTestBlog(t, db, models.Blog{Title: "Draft with both", Tags: "react, accessibility"})
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"tags=react,accessibility", []string{"All three", "A11y and design", "React only", "React and a11y"}},
+		{"tags=react,accessibility&tag_mode=any", []string{"All three", "A11y and design", "React only", "React and a11y"}},
+		{"tags=react,accessibility&tag_mode=all", []string{"All three", "React and a11y"}},
+		{"tags=REACT,%20Accessibility%20&tag_mode=all", []string{"All three", "React and a11y"}},
+		{"tags=react,accessibility,design&tag_mode=all", []string{"All three"}},
+		{"tags=design,reactive", []string{"Reactive", "All three", "A11y and design"}},
+		{"tags=design,reactive&tag_mode=all", []string{}},
+		{"tags=react,react&tag_mode=all", []string{"All three", "React only", "React and a11y"}},
+		{"tags=react,,&tag_mode=all", []string{"All three", "React only", "React and a11y"}},
+		{"tags=accessibility-audits", []string{"Reactive"}},
+		{"tags=access", []string{}},
+		{"tags=" + url.QueryEscape("100%") + ",c_sharp&tag_mode=all", []string{"Wildcards"}},
+		{"tags=" + url.QueryEscape("10%"), []string{}},
+		{"tags=c_sharp", []string{"Wildcards"}},
+		{"tags=c%25sharp", []string{}},
+		{"tags=react&tag=design", []string{"All three"}},
+	}
+	for _, tt := range tests {
+		if got := listTitles(t, router, "/blogs?"+tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: titles = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	for _, query := range []string{"tags=react&tag_mode=both", "tags=react&tag_mode=ALL"} {
+		expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: "/blogs?" + query}), http.StatusBadRequest)
+	}
+}
+
+func TestGetBlogsPublishedAll(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ListCacheTTL = time.Minute })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	createTestBlog(t, db, models.Blog{Title: "Live", Published: true, PublishedAt: timeAt(1, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Draft"})
+
+	list := func(query string, headers map[string]string, apiKey bool) *httptest.ResponseRecorder {
+		return serve(router, testRequest{method: http.MethodGet, path: "/blogs" + query, headers: headers, apiKey: apiKey})
+	}
+	statuses := func(w *httptest.ResponseRecorder) map[string]bool {
+		t.Helper()
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogListResponse
+		decode(t, w, &resp)
+		published := make(map[string]bool, len(resp.Blogs))
+		for _, blog := range resp.Blogs {
+			published[blog.Slug] = blog.Published
+		}
+		return published
+	}
+
+	// The authenticated listing is filled first, so a cache leak would show below
+	want := map[string]bool{"live": true, "draft": false}
+	if got := statuses(list("?published=all", nil, true)); !reflect.DeepEqual(got, want) {
+		t.Errorf("published=all = %v, want %v", got, want)
+	}
+	bearer := map[string]string{"Authorization": "Bearer " + testAPIKey}
+	if got := statuses(list("?published=all", bearer, false)); !reflect.DeepEqual(got, want) {
+		t.Errorf("published=all with a bearer token = %v, want %v", got, want)
+	}
+
+	for name, headers := range map[string]map[string]string{
+		"no key":    nil,
+		"wrong key": {"X-API-Key": "wrong"},
+	} {
+		w := list("?published=all", headers, false)
+		expectStatus(t, w, http.StatusUnauthorized)
+		if w.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("%s: no WWW-Authenticate header", name)
+		}
+	}
+
+	// Public listings are unchanged
+	if got := statuses(list("", nil, false)); !reflect.DeepEqual(got, map[string]bool{"live": true}) {
+		t.Errorf("default listing = %v, want the published post only", got)
+	}
+	if got := statuses(list("?published=false", nil, false)); !reflect.DeepEqual(got, map[string]bool{"draft": false}) {
+		t.Errorf("published=false = %v, want the draft only", got)
+	}
+	w := list("?published=maybe", nil, false)
+	expectStatus(t, w, http.StatusBadRequest)
+}
+
+func TestGetBlogsMaxTags(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ListCacheTTL = time.Minute })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	createTestBlog(t, db, models.Blog{Title: "One tag", Tags: "a11y", Published: true, PublishedAt: timeAt(time.March, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Many tags", Tags: "a11y, css, design, html", Published: true, PublishedAt: timeAt(time.March, 2)})
+
+	type cardTags struct {
+		Tags        []string `json:"tags"`
+		TagOverflow int      `json:"tag_overflow"`
+	}
+	list := func(query string) []cardTags {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs" + query})
+		expectStatus(t, w, http.StatusOK)
+		var resp struct {
+			Blogs []cardTags `json:"blogs"`
+		}
+		decode(t, w, &resp)
+		return resp.Blogs
+	}
+	for _, tc := range []struct {
+		query string
+		want  []cardTags
+	}{
+		// By default every tag is returned and no overflow is reported
+		{"", []cardTags{{Tags: []string{"a11y", "css", "design", "html"}}, {Tags: []string{"a11y"}}}},
+		{"?max_tags=2", []cardTags{{Tags: []string{"a11y", "css"}, TagOverflow: 2}, {Tags: []string{"a11y"}}}},
+		{"?max_tags=4", []cardTags{{Tags: []string{"a11y", "css", "design", "html"}}, {Tags: []string{"a11y"}}}},
+		{"?max_tags=0", []cardTags{{Tags: []string{}, TagOverflow: 4}, {Tags: []string{}, TagOverflow: 1}}},
+	} {
+		if got := list(tc.query); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q tags = %+v, want %+v", tc.query, got, tc.want)
+		}
+	}
+
+	for _, value := range []string{"-1", "two"} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?max_tags=" + value})
+		expectStatus(t, w, http.StatusBadRequest)
+	}
+}
+
+func TestGetBlogsSinceMarkers(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ListCacheTTL = time.Minute })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	createTestBlog(t, db, models.Blog{Title: "Old post", Published: true, PublishedAt: timeAt(time.March, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Middle post", Published: true, PublishedAt: timeAt(time.March, 5)})
+	createTestBlog(t, db, models.Blog{Title: "New post", Published: true, PublishedAt: timeAt(time.March, 9)})
+
+	type marker struct {
+		Title string `json:"title"`
+		IsNew *bool  `json:"is_new"`
+	}
+	list := func(query string) ([]marker, string) {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs" + query})
+		expectStatus(t, w, http.StatusOK)
+		var resp struct {
+			Blogs []marker `json:"blogs"`
+		}
+		decode(t, w, &resp)
+		return resp.Blogs, w.Header().Get("X-Cache")
+	}
+	flags := func(blogs []marker) map[string]interface{} {
+		got := make(map[string]interface{}, len(blogs))
+		for _, blog := range blogs {
+			if blog.IsNew == nil {
+				got[blog.Title] = nil
+			} else {
+				got[blog.Title] = *blog.IsNew
+			}
+		}
+		return got
+	}
+	titles := func(blogs []marker) []string {
+		got := make([]string, len(blogs))
+		for i, blog := range blogs {
+			got[i] = blog.Title
+		}
+		return got
+	}
+
+	all, _ := list("")
+	if got := flags(all); !reflect.DeepEqual(got, map[string]interface{}{"Old post": nil, "Middle post": nil, "New post": nil}) {
+		t.Errorf("without since: is_new = %v, want it omitted", got)
+	}
+
+	// Posts published exactly at the last visit were already seen
+	for since, want := range map[string]map[string]interface{}{
+		"2024-03-03T00:00:00Z":      {"Old post": false, "Middle post": true, "New post": true},
+		"2024-03-05T12:00:00Z":      {"Old post": false, "Middle post": false, "New post": true},
+		"2024-03-05T14:00:00+02:00": {"Old post": false, "Middle post": false, "New post": true},
+		"2024-02-01T00:00:00Z":      {"Old post": true, "Middle post": true, "New post": true},
+	} {
+		blogs, cache := list("?since=" + url.QueryEscape(since))
+		if got := flags(blogs); !reflect.DeepEqual(got, want) {
+			t.Errorf("since %s: is_new = %v, want %v", since, got, want)
+		}
+		if !reflect.DeepEqual(titles(blogs), titles(all)) {
+			t.Errorf("since %s: order = %v, want %v", since, titles(blogs), titles(all))
+		}
+		if cache != "BYPASS" {
+			t.Errorf("since %s: X-Cache = %q, want BYPASS", since, cache)
+		}
+	}
+
+	// A last visit in the future is ignored rather than marking nothing
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	blogs, _ := list("?since=" + url.QueryEscape(future))
+	if got := flags(blogs); !reflect.DeepEqual(got, map[string]interface{}{"Old post": nil, "Middle post": nil, "New post": nil}) {
+		t.Errorf("future since: is_new = %v, want it omitted", got)
+	}
+
+	for _, value := range []string{"yesterday", "2024-03-05", "1709640000"} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?since=" + value})
+		expectStatus(t, w, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/graphql"
+	"technoprise-blog-backend/internal/i18n"
+	"technoprise-blog-backend/internal/models"
+)
+
+// GraphQL handles GET and POST /api/v1/graphql
+// @Summary Query posts with GraphQL
+// @Description Run a GraphQL query against published posts. The schema is:
+// @Description type Query { blogs(page: Int = 1, limit: Int = 10, search: String, tag: String): BlogList!  blog(slug: String!): Blog  tags: [Tag!]! }
+// @Description type BlogList { blogs: [Blog!]!  pageInfo: PageInfo! }
+// @Description type PageInfo { total: Int!  page: Int!  limit: Int!  totalPages: Int!  hasNext: Boolean!  hasPrev: Boolean! }
+// @Description type Tag { name: String!  count: Int!  weight: Int! }
+// @Description Blog exposes the BlogResponse fields in camelCase. Only queries are supported and introspection is disabled.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body graphql.Request true "GraphQL request"
+// @Success 200 {object} graphql.Response
+// @Failure 400 {object} graphql.Response
+// @Router /graphql [post]
+func (h *BlogHandler) GraphQL(c *gin.Context) {
+	var req graphql.Request
+	if c.Request.Method == http.MethodGet {
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "variables must be a JSON object")
+				return
+			}
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	if strings.TrimSpace(req.Query) == "" {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "query is required")
+		return
+	}
+
+	// Requests that could not be executed at all carry no data
+	response := h.graphqlSchema(c).Execute(req)
+	status := http.StatusOK
+	if response.Data == nil {
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, response)
+}
+
+// graphqlSchema builds the query type for a request, resolving onto the same
+// queries and models as the REST handlers
+func (h *BlogHandler) graphqlSchema(c *gin.Context) *graphql.Schema {
+	return &graphql.Schema{
+		Query: &graphql.Object{
+			Name: "Query",
+			Fields: map[string]*graphql.FieldDef{
+				"blogs": {
+					Type: graphqlBlogListType,
+					Args: []string{"page", "limit", "search", "tag"},
+					Resolve: func(_ interface{}, args map[string]interface{}) (interface{}, error) {
+						return h.resolveGraphQLBlogs(c, args)
+					},
+				},
+				"blog": {
+					Type: graphqlBlogType,
+					Args: []string{"slug"},
+					Resolve: func(_ interface{}, args map[string]interface{}) (interface{}, error) {
+						return h.resolveGraphQLBlog(c, args)
+					},
+				},
+				"tags": {
+					Type: graphqlTagType,
+					Resolve: func(_ interface{}, _ map[string]interface{}) (interface{}, error) {
+						return h.resolveGraphQLTags(c)
+					},
+				},
+			},
+		},
+	}
+}
+
+// resolveGraphQLBlogs pages through published posts like GET /blogs
+func (h *BlogHandler) resolveGraphQLBlogs(c *gin.Context, args map[string]interface{}) (interface{}, error) {
+	page, err := graphql.IntArg(args, "page", 1)
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 {
+		return nil, errors.New("page must be a positive integer")
+	}
+	limit, err := graphql.IntArg(args, "limit", defaultPageLimit)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 1 || limit > maxPageLimit {
+		return nil, errors.New("limit must be an integer between 1 and " + strconv.Itoa(maxPageLimit))
+	}
+	search, err := graphql.StringArg(args, "search", "")
+	if err != nil {
+		return nil, err
+	}
+	tag, err := graphql.StringArg(args, "tag", "")
+	if err != nil {
+		return nil, err
+	}
+
+	query := requestDB(c, h.db).Model(&models.Blog{}).Where("published = ?", true)
+	if search = strings.TrimSpace(search); search != "" {
+		query = whereMatchesSearch(query, search)
+	}
+	if tag = strings.TrimSpace(tag); tag != "" {
+		query = whereHasTag(query, tag)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.Error(err)
+		return nil, errors.New(i18n.T(c, "Failed to count blogs"))
+	}
+
+	var blogs []models.Blog
+	if err := query.Order("created_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&blogs).Error; err != nil {
+		c.Error(err)
+		return nil, errors.New(i18n.T(c, "Failed to fetch blogs"))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	response := models.BlogListResponse{
+		Blogs:      make([]models.BlogResponse, len(blogs)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+	for i, blog := range blogs {
+		response.Blogs[i] = blog.ToResponse(true)
+	}
+	return response, nil
+}
+
+// resolveGraphQLBlog returns a published post by slug, or null, counting a view like GET /blogs/:slug
+func (h *BlogHandler) resolveGraphQLBlog(c *gin.Context, args map[string]interface{}) (interface{}, error) {
+	slug, err := graphql.StringArg(args, "slug", "")
+	if err != nil {
+		return nil, err
+	}
+	if slug == "" {
+		return nil, errors.New(`argument "slug" is required`)
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Where("slug = ? AND published = ?", slug, true).First(&blog).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		c.Error(err)
+		return nil, errors.New(i18n.T(c, "Failed to fetch blog post"))
+	}
+
+	h.views.Record(blog.ID)
+	return blog.ToResponse(true), nil
+}
+
+// resolveGraphQLTags counts tags across published posts like GET /tags/cloud
+func (h *BlogHandler) resolveGraphQLTags(c *gin.Context) (interface{}, error) {
+	var tagLists []string
+	if err := requestDB(c, h.db).Model(&models.Blog{}).
+		Where("published = ? AND tags <> ''", true).
+		Pluck("tags", &tagLists).Error; err != nil {
+		c.Error(err)
+		return nil, errors.New(i18n.T(c, "Failed to fetch tags"))
+	}
+	return models.BuildTagCloud(tagLists), nil
+}
+
+// graphqlScalar builds a field read from the parent value without arguments
+func graphqlScalar(get func(source interface{}) interface{}) *graphql.FieldDef {
+	return &graphql.FieldDef{
+		Resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return get(source), nil
+		},
+	}
+}
+
+// graphqlBlogField builds a scalar field of the Blog type
+func graphqlBlogField(get func(blog models.BlogResponse) interface{}) *graphql.FieldDef {
+	return graphqlScalar(func(source interface{}) interface{} {
+		return get(source.(models.BlogResponse))
+	})
+}
+
+// graphqlTime formats an optional timestamp as RFC3339
+func graphqlTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// graphqlBlogType mirrors BlogResponse with camelCase field names
+var graphqlBlogType = &graphql.Object{
+	Name: "Blog",
+	Fields: map[string]*graphql.FieldDef{
+		"id":                 graphqlBlogField(func(b models.BlogResponse) interface{} { return b.ID }),
+		"title":              graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Title }),
+		"slug":               graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Slug }),
+		"content":            graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Content }),
+		"excerpt":            graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Excerpt }),
+		"author":             graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Author }),
+		"published":          graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Published }),
+		"featured":           graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Featured }),
+		"allowComments":      graphqlBlogField(func(b models.BlogResponse) interface{} { return b.AllowComments }),
+		"tags":               graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Tags }),
+		"metaTitle":          graphqlBlogField(func(b models.BlogResponse) interface{} { return b.MetaTitle }),
+		"metaDescription":    graphqlBlogField(func(b models.BlogResponse) interface{} { return b.MetaDesc }),
+		"readingTime":        graphqlBlogField(func(b models.BlogResponse) interface{} { return b.ReadingTime }),
+		"audioLengthSeconds": graphqlBlogField(func(b models.BlogResponse) interface{} { return b.AudioLength }),
+		"headingCount":       graphqlBlogField(func(b models.BlogResponse) interface{} { return b.HeadingCount }),
+		"richExcerpt":        graphqlBlogField(func(b models.BlogResponse) interface{} { return b.RichExcerpt }),
+		"viewCount":          graphqlBlogField(func(b models.BlogResponse) interface{} { return b.ViewCount }),
+		"version":            graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Version }),
+		"featuredImage":      graphqlBlogField(func(b models.BlogResponse) interface{} { return b.FeaturedImage }),
+		"featuredImageAlt":   graphqlBlogField(func(b models.BlogResponse) interface{} { return b.FeaturedImageAlt }),
+		"language":           graphqlBlogField(func(b models.BlogResponse) interface{} { return b.Language }),
+		"translationGroup":   graphqlBlogField(func(b models.BlogResponse) interface{} { return b.TranslationGroup }),
+		"createdAt":          graphqlBlogField(func(b models.BlogResponse) interface{} { return graphqlTime(&b.CreatedAt) }),
+		"updatedAt":          graphqlBlogField(func(b models.BlogResponse) interface{} { return graphqlTime(&b.UpdatedAt) }),
+		"publishedAt":        graphqlBlogField(func(b models.BlogResponse) interface{} { return graphqlTime(b.PublishedAt) }),
+	},
+}
+
+// graphqlPageInfoType exposes the pagination metadata of a BlogListResponse
+var graphqlPageInfoType = &graphql.Object{
+	Name: "PageInfo",
+	Fields: map[string]*graphql.FieldDef{
+		"total":      graphqlScalar(func(s interface{}) interface{} { return s.(models.BlogListResponse).Total }),
+		"page":       graphqlScalar(func(s interface{}) interface{} { return s.(models.BlogListResponse).Page }),
+		"limit":      graphqlScalar(func(s interface{}) interface{} { return s.(models.BlogListResponse).Limit }),
+		"totalPages": graphqlScalar(func(s interface{}) interface{} { return s.(models.BlogListResponse).TotalPages }),
+		"hasNext":    graphqlScalar(func(s interface{}) interface{} { return s.(models.BlogListResponse).HasNext }),
+		"hasPrev":    graphqlScalar(func(s interface{}) interface{} { return s.(models.BlogListResponse).HasPrev }),
+	},
+}
+
+// graphqlBlogListType is a page of posts with its pagination metadata
+var graphqlBlogListType = &graphql.Object{
+	Name: "BlogList",
+	Fields: map[string]*graphql.FieldDef{
+		"blogs": {
+			Type: graphqlBlogType,
+			Resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return source.(models.BlogListResponse).Blogs, nil
+			},
+		},
+		"pageInfo": {
+			Type: graphqlPageInfoType,
+			Resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return source, nil
+			},
+		},
+	},
+}
+
+// graphqlTagType is a tag cloud entry
+var graphqlTagType = &graphql.Object{
+	Name: "Tag",
+	Fields: map[string]*graphql.FieldDef{
+		"name":   graphqlScalar(func(s interface{}) interface{} { return s.(models.TagCloudEntry).Tag }),
+		"count":  graphqlScalar(func(s interface{}) interface{} { return s.(models.TagCloudEntry).Count }),
+		"weight": graphqlScalar(func(s interface{}) interface{} { return s.(models.TagCloudEntry).Weight }),
+	},
+}
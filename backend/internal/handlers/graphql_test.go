@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+// graphqlResult is the decoded body of a GraphQL response
+type graphqlResult struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string        `json:"message"`
+		Path    []interface{} `json:"path"`
+	} `json:"errors"`
+}
+
+// graphqlBlogList is the shape of a blogs query selecting titles and pageInfo
+type graphqlBlogList struct {
+	Blogs []struct {
+		Title string `json:"title"`
+	} `json:"blogs"`
+	PageInfo struct {
+		Total      int  `json:"total"`
+		Page       int  `json:"page"`
+		Limit      int  `json:"limit"`
+		TotalPages int  `json:"totalPages"`
+		HasNext    bool `json:"hasNext"`
+		HasPrev    bool `json:"hasPrev"`
+	} `json:"pageInfo"`
+}
+
+// postGraphQL sends a GraphQL request and decodes the response
+func postGraphQL(t *testing.T, router http.Handler, query string, variables map[string]interface{}) graphqlResult {
+	t.Helper()
+	w := serve(router, testRequest{method: http.MethodPost, path: "/graphql", body: map[string]interface{}{"query": query, "variables": variables}})
+	expectStatus(t, w, http.StatusOK)
+	var result graphqlResult
+	decode(t, w, &result)
+	return result
+}
+
+func TestGraphQLBlogBySlug(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/graphql", h.GraphQL)
+
+	published := createTestBlog(t, db, models.Blog{
+		Title:       "Screen reader basics",
+		Author:      "Jane Doe",
+		Tags:        "a11y, screen-readers",
+		Published:   true,
+		PublishedAt: timeAt(time.March, 4),
+	})
+	createTestBlog(t, db, models.Blog{Title: "Unfinished draft"})
+
+	query := `query($slug: String!) { blog(slug: $slug) { id title slug author tags published publishedAt readingTime } }`
+	result := postGraphQL(t, router, query, map[string]interface{}{"slug": "screen-reader-basics"})
+	if len(result.Errors) > 0 {
+		t.Fatalf("errors = %+v", result.Errors)
+	}
+	var blog map[string]interface{}
+	if err := json.Unmarshal(result.Data["blog"], &blog); err != nil {
+		t.Fatalf("decode blog: %v", err)
+	}
+	want := map[string]interface{}{
+		"id":          float64(published.ID),
+		"title":       "Screen reader basics",
+		"slug":        "screen-reader-basics",
+		"author":      "Jane Doe",
+		"tags":        []interface{}{"a11y", "screen-readers"},
+		"published":   true,
+		"publishedAt": "2024-03-04T12:00:00Z",
+		"readingTime": float64(published.ReadingTime),
+	}
+	if !reflect.DeepEqual(blog, want) {
+		t.Errorf("blog = %v, want %v", blog, want)
+	}
+
+	// Only the requested fields are returned, in the order they were asked for
+	result = postGraphQL(t, router, `{ blog(slug: "screen-reader-basics") { slug title } }`, nil)
+	if got := string(result.Data["blog"]); got != `{"slug":"screen-reader-basics","title":"Screen reader basics"}` {
+		t.Errorf("blog = %s", got)
+	}
+
+	for _, slug := range []string{"unfinished-draft", "no-such-post"} {
+		result = postGraphQL(t, router, query, map[string]interface{}{"slug": slug})
+		if len(result.Errors) > 0 || string(result.Data["blog"]) != "null" {
+			t.Errorf("%s: blog = %s, errors = %+v, want null without errors", slug, result.Data["blog"], result.Errors)
+		}
+	}
+
+	result = postGraphQL(t, router, `{ blog { title } }`, nil)
+	if len(result.Errors) != 1 || result.Errors[0].Message != `argument "slug" is required` {
+		t.Errorf("errors = %+v, want the missing slug error", result.Errors)
+	}
+
+	// Fetching a post counts a view like the REST endpoint
+	var views int
+	for deadline := time.Now().Add(2 * time.Second); views < 2 && time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		db.Model(&models.PostView{}).Where("blog_id = ?", published.ID).Count(&views)
+	}
+	if views != 2 {
+		t.Errorf("views recorded = %d, want 2", views)
+	}
+}
+
+func TestGraphQLBlogsPagination(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/graphql", h.GraphQL)
+	router.POST("/graphql", h.GraphQL)
+
+	base := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	for i, post := range []struct {
+		title string
+		tags  string
+	}{
+		{"Keyboard navigation", "a11y, keyboard"},
+		{"Colour contrast", "a11y, design"},
+		{"Focus styles", "a11y, keyboard"},
+		{"Release notes", "news"},
+		{"Captions for video", "a11y, media"},
+	} {
+		createTestBlog(t, db, models.Blog{Title: post.title, Tags: post.tags, Published: true, CreatedAt: base.Add(time.Duration(i) * time.Hour)})
+	}
+	createTestBlog(t, db, models.Blog{Title: "Keyboard shortcuts draft", Tags: "keyboard"})
+
+	query := `query($page: Int, $limit: Int, $search: String, $tag: String) {
+		blogs(page: $page, limit: $limit, search: $search, tag: $tag) {
+			blogs { title }
+			pageInfo { total page limit totalPages hasNext hasPrev }
+		}
+	}`
+	tests := []struct {
+		variables map[string]interface{}
+		titles    []string
+		pageInfo  string
+	}{
+		{nil, []string{"Captions for video", "Release notes", "Focus styles", "Colour contrast", "Keyboard navigation"}, `{"total":5,"page":1,"limit":10,"totalPages":1,"hasNext":false,"hasPrev":false}`},
+		{map[string]interface{}{"limit": 2}, []string{"Captions for video", "Release notes"}, `{"total":5,"page":1,"limit":2,"totalPages":3,"hasNext":true,"hasPrev":false}`},
+		{map[string]interface{}{"limit": 2, "page": 2}, []string{"Focus styles", "Colour contrast"}, `{"total":5,"page":2,"limit":2,"totalPages":3,"hasNext":true,"hasPrev":true}`},
+		{map[string]interface{}{"limit": 2, "page": 3}, []string{"Keyboard navigation"}, `{"total":5,"page":3,"limit":2,"totalPages":3,"hasNext":false,"hasPrev":true}`},
+		{map[string]interface{}{"limit": 2, "page": 4}, []string{}, `{"total":5,"page":4,"limit":2,"totalPages":3,"hasNext":false,"hasPrev":true}`},
+		{map[string]interface{}{"tag": "keyboard"}, []string{"Focus styles", "Keyboard navigation"}, `{"total":2,"page":1,"limit":10,"totalPages":1,"hasNext":false,"hasPrev":false}`},
+		{map[string]interface{}{"search": "contrast"}, []string{"Colour contrast"}, `{"total":1,"page":1,"limit":10,"totalPages":1,"hasNext":false,"hasPrev":false}`},
+		{map[string]interface{}{"tag": "keyboard", "limit": 1, "page": 2}, []string{"Keyboard navigation"}, `{"total":2,"page":2,"limit":1,"totalPages":2,"hasNext":false,"hasPrev":true}`},
+	}
+	for _, tt := range tests {
+		result := postGraphQL(t, router, query, tt.variables)
+		if len(result.Errors) > 0 {
+			t.Errorf("%v: errors = %+v", tt.variables, result.Errors)
+			continue
+		}
+		var list graphqlBlogList
+		if err := json.Unmarshal(result.Data["blogs"], &list); err != nil {
+			t.Fatalf("decode blogs: %v", err)
+		}
+		titles := []string{}
+		for _, blog := range list.Blogs {
+			titles = append(titles, blog.Title)
+		}
+		if !reflect.DeepEqual(titles, tt.titles) {
+			t.Errorf("%v: titles = %v, want %v", tt.variables, titles, tt.titles)
+		}
+		pageInfo, _ := json.Marshal(list.PageInfo)
+		if string(pageInfo) != tt.pageInfo {
+			t.Errorf("%v: pageInfo = %s, want %s", tt.variables, pageInfo, tt.pageInfo)
+		}
+	}
+
+	for _, variables := range []map[string]interface{}{{"page": 0}, {"limit": 0}, {"limit": maxPageLimit + 1}, {"limit": 1.5}} {
+		result := postGraphQL(t, router, query, variables)
+		if len(result.Errors) != 1 || string(result.Data["blogs"]) != "null" {
+			t.Errorf("%v: blogs = %s, errors = %+v, want a single argument error", variables, result.Data["blogs"], result.Errors)
+		}
+	}
+
+	// Queries can also be sent as GET parameters
+	params := url.Values{"query": {query}, "variables": {`{"limit":1}`}}
+	w := serve(router, testRequest{method: http.MethodGet, path: "/graphql?" + params.Encode()})
+	expectStatus(t, w, http.StatusOK)
+	var result graphqlResult
+	decode(t, w, &result)
+	var list graphqlBlogList
+	json.Unmarshal(result.Data["blogs"], &list)
+	if len(list.Blogs) != 1 || list.Blogs[0].Title != "Captions for video" || list.PageInfo.TotalPages != 5 {
+		t.Errorf("GET blogs = %s", result.Data["blogs"])
+	}
+
+	for _, req := range []testRequest{
+		{method: http.MethodPost, path: "/graphql", body: map[string]string{"query": "  "}},
+		{method: http.MethodPost, path: "/graphql", body: map[string]string{"query": "{ blogs { "}},
+		{method: http.MethodPost, path: "/graphql", body: map[string]string{"query": "mutation { blogs { blogs { title } } }"}},
+		{method: http.MethodGet, path: "/graphql?query=%7B+tags+%7B+name+%7D+%7D&variables=not-json"},
+	} {
+		expectStatus(t, serve(router, req), http.StatusBadRequest)
+	}
+}
+
+func TestGraphQLTags(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/graphql", h.GraphQL)
+
+	createTestBlog(t, db, models.Blog{Title: "One", Tags: "a11y, keyboard", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Two", Tags: "a11y", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Three", Tags: "drafts-only"})
+
+	result := postGraphQL(t, router, `{ tags { name count } }`, nil)
+	if got := string(result.Data["tags"]); got != `[{"name":"a11y","count":2},{"name":"keyboard","count":1}]` {
+		t.Errorf("tags = %s", got)
+	}
+}
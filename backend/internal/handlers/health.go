@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+)
+
+// readyTimeout bounds how long the readiness probe waits for the database
+const readyTimeout = 2 * time.Second
+
+// BuildInfo identifies the running build; Commit and BuildTime are injected
+// with -ldflags at build time
+type BuildInfo struct {
+	Version   string
+	Commit    string
+	BuildTime string
+	StartedAt time.Time
+}
+
+// HealthHandler handles liveness and readiness probes
+type HealthHandler struct {
+	db    *gorm.DB
+	build BuildInfo
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(db *gorm.DB, build BuildInfo) *HealthHandler {
+	return &HealthHandler{db: db, build: build}
+}
+
+// Health handles GET /api/v1/health
+// @Summary Liveness check
+// @Description Cheap liveness check that does not touch the database, reporting build info, uptime and memory usage
+// @Tags health
+// @Produce json
+// @Success 200 {object} gin.H
+// @Router /health [get]
+func (h *HealthHandler) Health(c *gin.Context) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	uptime := time.Since(h.build.StartedAt)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":         "healthy",
+		"timestamp":      time.Now().UTC(),
+		"version":        h.build.Version,
+		"commit":         h.build.Commit,
+		"build_time":     h.build.BuildTime,
+		"started_at":     h.build.StartedAt.UTC(),
+		"uptime_seconds": int64(uptime.Seconds()),
+		"uptime":         uptime.Round(time.Second).String(),
+		"service":        "TechnoPrise Blog API",
+		"memory": gin.H{
+			"alloc_bytes":       mem.Alloc,
+			"total_alloc_bytes": mem.TotalAlloc,
+			"sys_bytes":         mem.Sys,
+			"heap_objects":      mem.HeapObjects,
+			"num_gc":            mem.NumGC,
+		},
+		"goroutines": runtime.NumGoroutine(),
+	})
+}
+
+// Ready handles GET /api/v1/ready
+// @Summary Readiness check
+// @Description Pings the database and reports connection pool statistics
+// @Tags health
+// @Produce json
+// @Success 200 {object} gin.H
+// @Failure 503 {object} gin.H
+// @Router /ready [get]
+func (h *HealthHandler) Ready(c *gin.Context) {
+	sqlDB := h.db.DB()
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readyTimeout)
+	defer cancel()
+
+	stats := sqlDB.Stats()
+	pool := gin.H{
+		"open_connections": stats.OpenConnections,
+		"in_use":           stats.InUse,
+		"idle":             stats.Idle,
+		"max_open":         stats.MaxOpenConnections,
+	}
+
+	if err := sqlDB.PingContext(ctx); err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status":    "unavailable",
+			"timestamp": time.Now().UTC(),
+			"database":  "unreachable",
+			"details":   err.Error(),
+			"pool":      pool,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":    "ready",
+		"timestamp": time.Now().UTC(),
+		"database":  "reachable",
+		"pool":      pool,
+	})
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestHealth(t *testing.T) {
+	build := BuildInfo{Version: "1.2.0", Commit: "abc1234", BuildTime: "2024-06-01T12:00:00Z", StartedAt: time.Now().Add(-time.Minute)}
+	h := NewHealthHandler(newTestDB(t), build)
+	router := gin.New()
+	router.GET("/health", h.Health)
+
+	check := func() map[string]interface{} {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/health"})
+		expectStatus(t, w, http.StatusOK)
+		var resp map[string]interface{}
+		decode(t, w, &resp)
+		return resp
+	}
+
+	first := check()
+	for key, want := range map[string]string{"status": "healthy", "version": "1.2.0", "commit": "abc1234", "build_time": "2024-06-01T12:00:00Z"} {
+		if first[key] != want {
+			t.Errorf("%s = %v, want %q", key, first[key], want)
+		}
+	}
+	for _, key := range []string{"timestamp", "started_at", "uptime", "goroutines"} {
+		if _, ok := first[key]; !ok {
+			t.Errorf("%s missing from %v", key, first)
+		}
+	}
+	memory, _ := first["memory"].(map[string]interface{})
+	for _, key := range []string{"alloc_bytes", "total_alloc_bytes", "sys_bytes", "heap_objects", "num_gc"} {
+		if _, ok := memory[key]; !ok {
+			t.Errorf("memory.%s missing from %v", key, memory)
+		}
+	}
+	uptime, _ := first["uptime_seconds"].(float64)
+	if uptime < 60 {
+		t.Errorf("uptime_seconds = %v, want at least 60", first["uptime_seconds"])
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+	if later, _ := check()["uptime_seconds"].(float64); later <= uptime {
+		t.Errorf("uptime_seconds went from %v to %v, want it to increase", uptime, later)
+	}
+}
+
+func TestReady(t *testing.T) {
+	db := newTestDB(t)
+	h := NewHealthHandler(db, BuildInfo{StartedAt: time.Now()})
+	router := gin.New()
+	router.GET("/ready", h.Ready)
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/ready"})
+	expectStatus(t, w, http.StatusOK)
+	var resp map[string]interface{}
+	decode(t, w, &resp)
+	if resp["status"] != "ready" || resp["database"] != "reachable" {
+		t.Errorf("open database: response = %v, want ready and reachable", resp)
+	}
+
+	db.Close()
+	w = serve(router, testRequest{method: http.MethodGet, path: "/ready"})
+	expectStatus(t, w, http.StatusServiceUnavailable)
+	resp = nil
+	decode(t, w, &resp)
+	if resp["status"] != "unavailable" || resp["database"] != "unreachable" {
+		t.Errorf("closed database: response = %v, want unavailable and unreachable", resp)
+	}
+	if resp["details"] == "" || resp["details"] == nil {
+		t.Error("closed database: details missing")
+	}
+}
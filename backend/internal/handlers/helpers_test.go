@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/database"
+	"technoprise-blog-backend/internal/mailer"
+	"technoprise-blog-backend/internal/middleware"
+	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/preview"
+	"technoprise-blog-backend/internal/webhooks"
+)
+
+// testAPIKey is the API key accepted by handlers built with newTestHandler
+const testAPIKey = "test-api-key"
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	apierror.ConfigureValidator()
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// newTestDB opens a migrated SQLite database that is removed after the test
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open("sqlite3", filepath.Join(t.TempDir(), "test.db")+"?_busy_timeout=5000")
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	// Background view writes may outlive the test; keep their errors out of the output
+	db.LogMode(false)
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf("migrate database: %v", err)
+	}
+	return db
+}
+
+// testConfig returns the configuration loaded from an empty environment, with
+// the API key set to testAPIKey
+func testConfig(t *testing.T) *config.Config {
+	t.Helper()
+	cfg, err := config.LoadFrom(func(key string) (string, bool) {
+		if key == "API_KEY" {
+			return testAPIKey, true
+		}
+		return "", false
+	})
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	return cfg
+}
+
+// newTestHandler builds a blog handler on a fresh database. configure, when
+// not nil, adjusts the blog configuration first.
+func newTestHandler(t *testing.T, configure func(*config.Blog)) (*BlogHandler, *gorm.DB) {
+	t.Helper()
+	db := newTestDB(t)
+	cfg := testConfig(t).Blog
+	if configure != nil {
+		configure(&cfg)
+	}
+	previews := preview.NewSigner("test-preview-secret", preview.DefaultTTL)
+	h := NewBlogHandler(db, cfg, webhooks.NewDispatcher(nil, ""), previews, mailer.New(mailer.Config{}), nil)
+	return h, db
+}
+
+// requireTestAPIKey is the API key middleware matching newTestHandler
+func requireTestAPIKey() gin.HandlerFunc {
+	return middleware.RequireAPIKey(testAPIKey)
+}
+
+// testRequest describes a request sent with serve
+type testRequest struct {
+	method  string
+	path    string
+	body    interface{} // Encoded as JSON unless it is a string
+	headers map[string]string
+	host    string // Overrides the Host the request is sent to
+	apiKey  bool   // Send testAPIKey
+}
+
+// serve sends req to router and returns the recorded response
+func serve(router http.Handler, req testRequest) *httptest.ResponseRecorder {
+	var body io.Reader
+	switch value := req.body.(type) {
+	case nil:
+	case string:
+		body = bytes.NewBufferString(value)
+	default:
+		encoded, _ := json.Marshal(value)
+		body = bytes.NewBuffer(encoded)
+	}
+
+	r := httptest.NewRequest(req.method, req.path, body)
+	if body != nil {
+		r.Header.Set("Content-Type", "application/json")
+	}
+	if req.host != "" {
+		r.Host = req.host
+	}
+	for name, value := range req.headers {
+		r.Header.Set(name, value)
+	}
+	if req.apiKey {
+		r.Header.Set(middleware.APIKeyHeader, testAPIKey)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w
+}
+
+// decode unmarshals a JSON response body into v
+func decode(t *testing.T, w *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("decode response %q: %v", w.Body.String(), err)
+	}
+}
+
+// expectStatus fails the test when the response status is not want
+func expectStatus(t *testing.T, w *httptest.ResponseRecorder, want int) {
+	t.Helper()
+	if w.Code != want {
+		t.Fatalf("status = %d, want %d; body: %s", w.Code, want, w.Body.String())
+	}
+}
+
+// testContent is post content long enough to pass the visible text minimum
+const testContent = "<p>Accessible design helps every reader, whatever device or assistive technology they use.</p>"
+
+// createTestBlog inserts a post with defaults for any field left empty
+func createTestBlog(t *testing.T, db *gorm.DB, blog models.Blog) models.Blog {
+	t.Helper()
+	if blog.Title == "" {
+		blog.Title = "Test post"
+	}
+	if blog.Slug == "" {
+		blog.Slug = models.GenerateSlug(blog.Title)
+	}
+	if blog.Content == "" {
+		blog.Content = testContent
+	}
+	if blog.Excerpt == "" {
+		blog.Excerpt = "A short test excerpt"
+	}
+	if blog.Author == "" {
+		blog.Author = "Test Author"
+	}
+	if err := db.Create(&blog).Error; err != nil {
+		t.Fatalf("create blog: %v", err)
+	}
+	return blog
+}
+
+// itoa formats an ID for a request path
+func itoa(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// listTitles requests a listing and returns the titles of the posts it holds
+func listTitles(t *testing.T, router http.Handler, path string) []string {
+	t.Helper()
+	w := serve(router, testRequest{method: http.MethodGet, path: path})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.BlogListResponse
+	decode(t, w, &resp)
+	titles := make([]string, len(resp.Blogs))
+	for i, blog := range resp.Blogs {
+		titles[i] = blog.Title
+	}
+	return titles
+}
+
+// timeAt returns a pointer to the UTC time on the given day of 2024
+func timeAt(month time.Month, day int) *time.Time {
+	at := time.Date(2024, month, day, 12, 0, 0, 0, time.UTC)
+	return &at
+}
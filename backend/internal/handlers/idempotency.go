@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+const (
+	// IdempotencyKeyHeader is the header clients use to make a create safely retryable
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// idempotencyTTL is how long a key replays its original response
+	idempotencyTTL = 24 * time.Hour
+
+	// maxIdempotencyKeyLength caps client-supplied keys
+	maxIdempotencyKeyLength = 255
+
+	// createBlogNamespace scopes keys used for blog creation
+	createBlogNamespace = "blogs:create:"
+)
+
+// idempotencyKey reads and validates the Idempotency-Key header, writing a 400 response when invalid
+func idempotencyKey(c *gin.Context) (string, bool) {
+	key := strings.TrimSpace(c.GetHeader(IdempotencyKeyHeader))
+	if len(key) > maxIdempotencyKeyLength {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid Idempotency-Key", "Idempotency-Key must not exceed 255 characters")
+		return "", false
+	}
+	return key, true
+}
+
+// replayIdempotentCreate writes the original 201 response for a key that already created a blog.
+// It reports whether a response was written.
+func (h *BlogHandler) replayIdempotentCreate(c *gin.Context, key string) bool {
+	var record models.IdempotencyKey
+	err := requestDB(c, h.db).Where("key = ? AND expires_at > ?", createBlogNamespace+key, time.Now().UTC()).First(&record).Error
+	if err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			c.Error(err)
+		}
+		return false
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Unscoped().First(&blog, record.BlogID).Error; err != nil {
+		c.Error(err)
+		return false
+	}
+
+	c.Header("Idempotent-Replayed", "true")
+	c.JSON(http.StatusCreated, blog.ToResponse(true))
+	return true
+}
+
+// saveIdempotencyKey records the blog created for key and purges expired keys
+func saveIdempotencyKey(tx *gorm.DB, key string, blogID uint) error {
+	now := time.Now().UTC()
+	if err := tx.Where("expires_at <= ?", now).Delete(&models.IdempotencyKey{}).Error; err != nil {
+		return err
+	}
+
+	record := models.IdempotencyKey{
+		Key:       createBlogNamespace + key,
+		BlogID:    blogID,
+		ExpiresAt: now.Add(idempotencyTTL),
+	}
+	return tx.Create(&record).Error
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestCreateBlogIdempotencyKeys(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+
+	create := func(key, title string) *httptest.ResponseRecorder {
+		return serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+			"title": title, "content": "<p>" + title + ": " + testContent + "</p>", "author": "Test Author",
+		}, headers: map[string]string{IdempotencyKeyHeader: key}})
+	}
+	countPosts := func() int {
+		var count int
+		db.Model(&models.Blog{}).Count(&count)
+		return count
+	}
+
+	// A retry with the same key replays the original response
+	first := create("key-one", "Keyboard navigation")
+	expectStatus(t, first, http.StatusCreated)
+	retry := create("key-one", "Keyboard navigation")
+	expectStatus(t, retry, http.StatusCreated)
+	var original, replayed models.BlogResponse
+	decode(t, first, &original)
+	decode(t, retry, &replayed)
+	if replayed.ID != original.ID || replayed.Slug != original.Slug {
+		t.Errorf("replayed post %d %q, want the original %d %q", replayed.ID, replayed.Slug, original.ID, original.Slug)
+	}
+	if first.Header().Get("Idempotent-Replayed") != "" || retry.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("Idempotent-Replayed = %q then %q, want empty then true",
+			first.Header().Get("Idempotent-Replayed"), retry.Header().Get("Idempotent-Replayed"))
+	}
+	if count := countPosts(); count != 1 {
+		t.Fatalf("%d posts after a replay, want 1", count)
+	}
+
+	// Different keys create different posts
+	expectStatus(t, create("key-two", "Focus indicators"), http.StatusCreated)
+	expectStatus(t, create("key-three", "Skip links"), http.StatusCreated)
+	if count := countPosts(); count != 3 {
+		t.Errorf("%d posts after two new keys, want 3", count)
+	}
+
+	// An expired key no longer replays
+	db.Model(&models.IdempotencyKey{}).Where("key = ?", createBlogNamespace+"key-one").
+		Update("expires_at", time.Now().UTC().Add(-time.Minute))
+	expired := create("key-one", "Reduced motion")
+	expectStatus(t, expired, http.StatusCreated)
+	if expired.Header().Get("Idempotent-Replayed") != "" {
+		t.Error("an expired key was replayed")
+	}
+	if count := countPosts(); count != 4 {
+		t.Errorf("%d posts after an expired key, want 4", count)
+	}
+
+	expectStatus(t, create(strings.Repeat("k", maxIdempotencyKeyLength+1), "Long key"), http.StatusBadRequest)
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// imageCheckTimeout bounds the HEAD request used to verify image URLs
+const imageCheckTimeout = 5 * time.Second
+
+// validateFeaturedImage checks that a featured image is a well-formed http or
+// https URL and, when VALIDATE_IMAGE_URLS is enabled, that it serves an image.
+// It writes a 400 response when invalid; an empty value clears the image.
+func (h *BlogHandler) validateFeaturedImage(c *gin.Context, raw string) (string, bool) {
+	imageURL := strings.TrimSpace(raw)
+	if imageURL == "" {
+		return "", true
+	}
+
+	if len(imageURL) > 2048 || !models.IsValidImageURL(imageURL) {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid featured image", "featured_image must be an absolute http or https URL")
+		return "", false
+	}
+
+	if h.validateImageURLs {
+		if err := h.checkImageURL(imageURL); err != nil {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid featured image", err.Error())
+			return "", false
+		}
+	}
+
+	return imageURL, true
+}
+
+// checkImageURL issues a HEAD request and requires an image content type
+func (h *BlogHandler) checkImageURL(imageURL string) error {
+	resp, err := h.imageClient.Head(imageURL)
+	if err != nil {
+		return fmt.Errorf("featured_image could not be fetched")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("featured_image returned status %d", resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(strings.ToLower(contentType), "image/") {
+		return fmt.Errorf("featured_image is not an image (content type %q)", contentType)
+	}
+	return nil
+}
+
+// validateFeaturedImageAlt limits alt text to 125 characters and requires it
+// whenever a featured image is set, writing a 422 response otherwise
+func (h *BlogHandler) validateFeaturedImageAlt(c *gin.Context, featuredImage, alt string) bool {
+	if length := utf8.RuneCountInString(alt); length > models.FeaturedImageAltMaxLength {
+		apierror.Respond(c, http.StatusUnprocessableEntity, "Featured image alt text too long", fmt.Sprintf("featured_image_alt must be at most %d characters, got %d", models.FeaturedImageAltMaxLength, length))
+		return false
+	}
+	if featuredImage != "" && alt == "" {
+		apierror.Respond(c, http.StatusUnprocessableEntity, "Featured image alt text required", "featured_image_alt must describe the featured image for screen reader users")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// Link check bounds
+const (
+	linkCheckTimeout     = 5 * time.Second  // Per link
+	linkCheckTotalTime   = 20 * time.Second // Per post
+	linkCheckConcurrency = 8
+	linkCheckCacheTTL    = 5 * time.Minute
+)
+
+// newLinkClient returns an HTTP client that reports redirects instead of following them
+func newLinkClient() *http.Client {
+	return &http.Client{
+		Timeout: linkCheckTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// CheckBlogLinks handles GET /api/v1/blogs/:id/link-check
+// @Summary Check a post's links
+// @Description Issue HEAD requests to every link in a post and report each as ok, broken or redirect. Results are cached for five minutes per post version.
+// @Tags blogs
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Success 200 {object} models.LinkCheckResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/link-check [get]
+func (h *BlogHandler) CheckBlogLinks(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id, content, version").First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	// Edits bump the version, so cached results never outlive the content they checked
+	cacheKey := strconv.FormatUint(uint64(blog.ID), 10) + "@" + strconv.Itoa(blog.Version)
+	if cached, ok := h.linkCache.Get(cacheKey); ok {
+		c.Header("X-Cache", "HIT")
+		c.JSON(http.StatusOK, cached)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), linkCheckTotalTime)
+	defer cancel()
+
+	links := models.ExtractLinks(blog.Content)
+	results := make([]models.LinkStatus, len(links))
+	sem := make(chan struct{}, linkCheckConcurrency)
+	var wg sync.WaitGroup
+	for i, link := range links {
+		wg.Add(1)
+		go func(i int, link string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = h.checkLink(ctx, link)
+		}(i, link)
+	}
+	wg.Wait()
+
+	response := models.LinkCheckResponse{
+		BlogID:    blog.ID,
+		Checked:   len(results),
+		Links:     results,
+		CheckedAt: time.Now().UTC(),
+	}
+	for _, result := range results {
+		switch result.Status {
+		case models.LinkBroken:
+			response.Broken++
+		case models.LinkRedirect:
+			response.Redirects++
+		}
+	}
+
+	h.linkCache.Set(cacheKey, response)
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, response)
+}
+
+// checkLink resolves a link against the site URL and checks it with HEAD,
+// retrying with GET for servers that do not support HEAD
+func (h *BlogHandler) checkLink(ctx context.Context, link string) models.LinkStatus {
+	status := models.LinkStatus{URL: link}
+
+	base, err := url.Parse(h.siteURL)
+	if err != nil {
+		base = &url.URL{}
+	}
+	target, err := base.Parse(link)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") {
+		status.Status = models.LinkBroken
+		status.Error = "unsupported or malformed URL"
+		return status
+	}
+
+	resp, err := h.requestLink(ctx, http.MethodHead, target.String())
+	if err == nil && (resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented) {
+		resp.Body.Close()
+		resp, err = h.requestLink(ctx, http.MethodGet, target.String())
+	}
+	if err != nil {
+		status.Status = models.LinkBroken
+		status.Error = "request failed"
+		if ctx.Err() != nil {
+			status.Error = "timed out"
+		}
+		return status
+	}
+	defer resp.Body.Close()
+
+	status.StatusCode = resp.StatusCode
+	switch {
+	case resp.StatusCode >= http.StatusBadRequest:
+		status.Status = models.LinkBroken
+	case resp.StatusCode >= http.StatusMultipleChoices:
+		status.Status = models.LinkRedirect
+		status.Location = resp.Header.Get("Location")
+	default:
+		status.Status = models.LinkOK
+	}
+	return status
+}
+
+// requestLink issues a single link check request
+func (h *BlogHandler) requestLink(ctx context.Context, method, target string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "TechnoPrise-LinkChecker/1.0")
+	return h.linkClient.Do(req)
+}
+
+// GetBrokenInternalLinks handles GET /api/v1/admin/broken-internal-links
+// @Summary Find broken internal links
+// @Description Scan published posts for links to other posts and report those whose slug has no published post, including case and trailing-slash variants that would redirect
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} models.BrokenInternalLinksResponse
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/broken-internal-links [get]
+func (h *BlogHandler) GetBrokenInternalLinks(c *gin.Context) {
+	// Every slug ever used, so links to deleted and draft posts can be told apart from typos
+	var posts []models.Blog
+	if err := requestDB(c, h.db).Unscoped().Select("slug, published, deleted_at").Find(&posts).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog posts")
+		return
+	}
+	reasons := make(map[string]string, len(posts))
+	for _, post := range posts {
+		switch {
+		case post.DeletedAt == nil && post.Published:
+			reasons[post.Slug] = ""
+		case post.DeletedAt == nil:
+			reasons[post.Slug] = models.InternalLinkUnpublished
+		default:
+			if _, ok := reasons[post.Slug]; !ok {
+				reasons[post.Slug] = models.InternalLinkDeleted
+			}
+		}
+	}
+
+	response := models.BrokenInternalLinksResponse{Broken: []models.BrokenInternalLink{}}
+	var lastID uint
+	for {
+		var blogs []models.Blog
+		if err := requestDB(c, h.db).Select("id, slug, content").
+			Where("published = ? AND id > ?", true, lastID).
+			Order("id ASC").
+			Limit(recomputeBatchSize).
+			Find(&blogs).Error; err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog posts")
+			return
+		}
+		if len(blogs) == 0 {
+			break
+		}
+
+		for _, blog := range blogs {
+			for _, link := range models.ExtractLinks(blog.Content) {
+				slug, ok := models.InternalLinkSlug(link, h.siteURL)
+				if !ok {
+					continue
+				}
+				response.Checked++
+
+				reason, known := reasons[slug]
+				if reason == "" && known {
+					continue
+				}
+				// Variants of a live slug are redirected by findBlogBySlug
+				if canonical, ok := reasons[models.CanonicalSlug(slug)]; ok && canonical == "" {
+					continue
+				}
+				if !known {
+					reason = models.InternalLinkMissing
+				}
+				response.Broken = append(response.Broken, models.BrokenInternalLink{
+					BlogID:     blog.ID,
+					BlogSlug:   blog.Slug,
+					Link:       link,
+					TargetSlug: slug,
+					Reason:     reason,
+				})
+			}
+		}
+		response.Scanned += len(blogs)
+		lastID = blogs[len(blogs)-1].ID
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestCheckBlogLinks(t *testing.T) {
+	var requests int32
+	links := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/moved":
+			http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer links.Close()
+
+	// Relative links resolve against the site URL, here the mock server
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.SiteURL = links.URL })
+	router := gin.New()
+	router.GET("/blogs/:slug/link-check", requireTestAPIKey(), h.CheckBlogLinks)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+
+	blog := createTestBlog(t, db, models.Blog{Title: "Links galore", Content: `<p>` +
+		`<a href="` + links.URL + `/ok">fine</a> <a href="` + links.URL + `/missing">gone</a> ` +
+		`<a href="` + links.URL + `/moved">moved</a> <a href="/get-only">relative</a> ` +
+		`<a href="` + links.URL + `/ok">again</a> <a href="#top">anchor</a> ` +
+		`<a href="mailto:team@example.com">mail</a> <a href="ftp://files.example.com/a">ftp</a></p>`})
+	path := "/blogs/" + itoa(blog.ID) + "/link-check"
+
+	w := serve(router, testRequest{method: http.MethodGet, path: path})
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	w = serve(router, testRequest{method: http.MethodGet, path: path, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	if w.Header().Get("X-Cache") != "MISS" {
+		t.Errorf("first check X-Cache = %q, want MISS", w.Header().Get("X-Cache"))
+	}
+	var resp models.LinkCheckResponse
+	decode(t, w, &resp)
+
+	want := []models.LinkStatus{
+		{URL: links.URL + "/ok", Status: models.LinkOK, StatusCode: http.StatusOK},
+		{URL: links.URL + "/missing", Status: models.LinkBroken, StatusCode: http.StatusNotFound},
+		{URL: links.URL + "/moved", Status: models.LinkRedirect, StatusCode: http.StatusMovedPermanently, Location: "/ok"},
+		{URL: "/get-only", Status: models.LinkOK, StatusCode: http.StatusOK},
+		{URL: "ftp://files.example.com/a", Status: models.LinkBroken, Error: "unsupported or malformed URL"},
+	}
+	if resp.BlogID != blog.ID || resp.Checked != len(want) || resp.Broken != 2 || resp.Redirects != 1 {
+		t.Errorf("summary = blog %d, %d checked, %d broken, %d redirects; want %d, %d, 2, 1",
+			resp.BlogID, resp.Checked, resp.Broken, resp.Redirects, blog.ID, len(want))
+	}
+	if len(resp.Links) != len(want) {
+		t.Fatalf("links = %+v, want %+v", resp.Links, want)
+	}
+	for i := range want {
+		if resp.Links[i] != want[i] {
+			t.Errorf("link %d = %+v, want %+v", i, resp.Links[i], want[i])
+		}
+	}
+
+	// Results are cached until the post changes
+	served := atomic.LoadInt32(&requests)
+	w = serve(router, testRequest{method: http.MethodGet, path: path, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	if w.Header().Get("X-Cache") != "HIT" || atomic.LoadInt32(&requests) != served {
+		t.Errorf("repeat check: X-Cache %q after %d new requests, want a cache hit", w.Header().Get("X-Cache"), atomic.LoadInt32(&requests)-served)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{
+		"content": `<p>Only one link now: <a href="` + links.URL + `/ok">fine</a>. ` + testContent + `</p>`,
+	}, headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	w = serve(router, testRequest{method: http.MethodGet, path: path, apiKey: true})
+	decode(t, w, &resp)
+	if w.Header().Get("X-Cache") != "MISS" || resp.Checked != 1 {
+		t.Errorf("after an edit: X-Cache %q with %d links checked, want a fresh check of 1 link", w.Header().Get("X-Cache"), resp.Checked)
+	}
+
+	if w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/9999/link-check", apiKey: true}); w.Code != http.StatusNotFound {
+		t.Errorf("unknown post: status = %d, want 404", w.Code)
+	}
+}
+
+func TestGetBrokenInternalLinks(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.SiteURL = "https://blog.example.com" })
+	router := gin.New()
+	router.GET("/admin/broken-internal-links", requireTestAPIKey(), h.GetBrokenInternalLinks)
+
+	createTestBlog(t, db, models.Blog{Title: "Live post", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Draft post"})
+	deleted := createTestBlog(t, db, models.Blog{Title: "Deleted post", Published: true})
+	db.Delete(&deleted)
+	linking := createTestBlog(t, db, models.Blog{Title: "Linking post", Published: true, Content: `<p>` +
+		`<a href="/blog/live-post">live</a> <a href="https://blog.example.com/blogs/Live-Post/">variant</a> ` +
+		`<a href="/blogs/old-slug">missing</a> <a href="/api/v1/blogs/draft-post">draft</a> ` +
+		`<a href="https://blog.example.com/blog/deleted-post">deleted</a> ` +
+		`<a href="https://other.example.com/blog/old-slug">external</a> <a href="/about">page</a></p>`})
+	// Links in drafts are not scanned
+	createTestBlog(t, db, models.Blog{Title: "Draft with links", Content: `<p><a href="/blog/nowhere">nowhere</a> ` + testContent + `</p>`})
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/admin/broken-internal-links"})
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	w = serve(router, testRequest{method: http.MethodGet, path: "/admin/broken-internal-links", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.BrokenInternalLinksResponse
+	decode(t, w, &resp)
+	if resp.Scanned != 2 || resp.Checked != 5 {
+		t.Errorf("scanned %d posts and checked %d links, want 2 and 5", resp.Scanned, resp.Checked)
+	}
+	want := []models.BrokenInternalLink{
+		{BlogID: linking.ID, BlogSlug: "linking-post", Link: "/blogs/old-slug", TargetSlug: "old-slug", Reason: models.InternalLinkMissing},
+		{BlogID: linking.ID, BlogSlug: "linking-post", Link: "/api/v1/blogs/draft-post", TargetSlug: "draft-post", Reason: models.InternalLinkUnpublished},
+		{BlogID: linking.ID, BlogSlug: "linking-post", Link: "https://blog.example.com/blog/deleted-post", TargetSlug: "deleted-post", Reason: models.InternalLinkDeleted},
+	}
+	if !reflect.DeepEqual(resp.Broken, want) {
+		t.Errorf("broken = %+v, want %+v", resp.Broken, want)
+	}
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+)
+
+// publishedCountKey is the count cache key of the unfiltered published listing
+const publishedCountKey = "published"
+
+// cachedList is a serialized listing response together with its headers
+type cachedList struct {
+	body    []byte
+	headers map[string]string
+}
+
+// listCacheKey identifies a listing request by origin and normalized query
+// string. The scheme and host are included because pagination links are
+// absolute, so a request forwarded as http never fills the cache for https.
+func listCacheKey(c *gin.Context) string {
+	return requestOrigin(c) + "?" + c.Request.URL.Query().Encode()
+}
+
+// serveCachedList writes a cached listing response, reporting whether one was found
+func (h *BlogHandler) serveCachedList(c *gin.Context, key string) bool {
+	value, ok := h.listCache.Get(key)
+	if !ok {
+		return false
+	}
+
+	cached := value.(cachedList)
+	for name, value := range cached.headers {
+		c.Header(name, value)
+	}
+	c.Header("X-Cache", "HIT")
+	c.Data(http.StatusOK, "application/json; charset=utf-8", cached.body)
+	return true
+}
+
+// storeCachedList caches a serialized listing response along with the headers already set on c
+func (h *BlogHandler) storeCachedList(c *gin.Context, key string, body []byte, headerNames ...string) {
+	headers := make(map[string]string, len(headerNames))
+	for _, name := range headerNames {
+		headers[name] = c.Writer.Header().Get(name)
+	}
+	h.listCache.Set(key, cachedList{body: body, headers: headers})
+}
+
+// countBlogs counts the posts matched by query and sets X-Count-Cache. Only the
+// unfiltered published listing uses the count cache; filtered and search
+// queries are always counted live.
+func (h *BlogHandler) countBlogs(c *gin.Context, query *gorm.DB, cacheable bool) (int64, bool) {
+	cacheable = cacheable && h.countCache.Enabled()
+	if cacheable {
+		if value, ok := h.countCache.Get(publishedCountKey); ok {
+			c.Header("X-Count-Cache", "HIT")
+			return value.(int64), true
+		}
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to count blogs")
+		return 0, false
+	}
+
+	if cacheable {
+		h.countCache.Set(publishedCountKey, total)
+		c.Header("X-Count-Cache", "MISS")
+	} else {
+		c.Header("X-Count-Cache", "BYPASS")
+	}
+	return total, true
+}
+
+// invalidateListCache drops cached listings and counts after any write
+func (h *BlogHandler) invalidateListCache() {
+	h.listCache.Purge()
+	h.countCache.Purge()
+}
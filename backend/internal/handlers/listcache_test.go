@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestListCacheSeparatesForwardedSchemes(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ListCacheTTL = time.Minute })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	createTestBlog(t, db, models.Blog{Published: true})
+
+	list := func(proto string) (string, models.BlogListResponse) {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs", headers: map[string]string{"X-Forwarded-Proto": proto}})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogListResponse
+		decode(t, w, &resp)
+		return w.Header().Get("X-Cache"), resp
+	}
+
+	if cache, resp := list("http"); cache != "MISS" || !strings.HasPrefix(resp.Links.First, "http://") {
+		t.Fatalf("first http request: X-Cache %q, first link %q", cache, resp.Links.First)
+	}
+	if cache, resp := list("https"); cache != "MISS" || !strings.HasPrefix(resp.Links.First, "https://") {
+		t.Errorf("https request after http: X-Cache %q, first link %q; want a miss with https links", cache, resp.Links.First)
+	}
+	if cache, resp := list("https"); cache != "HIT" || !strings.HasPrefix(resp.Links.First, "https://") {
+		t.Errorf("repeated https request: X-Cache %q, first link %q; want a hit with https links", cache, resp.Links.First)
+	}
+}
+
+func TestListCacheHitMissAndInvalidation(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ListCacheTTL = time.Minute })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	router.DELETE("/blogs/:id", h.DeleteBlog)
+	blog := createTestBlog(t, db, models.Blog{Title: "Cached post", Published: true})
+
+	list := func(query string) (string, models.BlogListResponse) {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs" + query})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogListResponse
+		decode(t, w, &resp)
+		return w.Header().Get("X-Cache"), resp
+	}
+	expectCache := func(step, query, want string, wantTotal int64) {
+		t.Helper()
+		cache, resp := list(query)
+		if cache != want || resp.Total != wantTotal {
+			t.Errorf("%s: X-Cache %q with %d posts, want %q with %d", step, cache, resp.Total, want, wantTotal)
+		}
+	}
+
+	expectCache("first request", "", "MISS", 1)
+	expectCache("repeat", "", "HIT", 1)
+	expectCache("other query", "?limit=5", "MISS", 1)
+	expectCache("reordered query", "?page=1&limit=10", "MISS", 1)
+	expectCache("same query reordered", "?limit=10&page=1", "HIT", 1)
+	expectCache("search", "?search=cached", "BYPASS", 1)
+	expectCache("repeated search", "?search=cached", "BYPASS", 1)
+
+	// Writes purge every cached listing
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Fresh post", "content": "<p>Fresh post: " + testContent + "</p>", "author": "Test Author", "published": true,
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	expectCache("after create", "", "MISS", 2)
+	expectCache("other query after create", "?limit=5", "MISS", 2)
+
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{"published": false},
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	expectCache("after update", "", "MISS", 1)
+	expectCache("repeat after update", "", "HIT", 1)
+
+	var fresh models.Blog
+	db.Where("slug = ?", "fresh-post").First(&fresh)
+	w = serve(router, testRequest{method: http.MethodDelete, path: "/blogs/" + itoa(fresh.ID)})
+	expectStatus(t, w, http.StatusNoContent)
+	expectCache("after delete", "", "MISS", 0)
+}
+
+func TestListCacheDisabled(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.ListCacheTTL = 0 })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	createTestBlog(t, db, models.Blog{Published: true})
+
+	for i := 0; i < 2; i++ {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs"})
+		if cache := w.Header().Get("X-Cache"); cache != "BYPASS" {
+			t.Errorf("request %d: X-Cache = %q, want BYPASS", i+1, cache)
+		}
+	}
+}
+
+func TestCountCacheInvalidation(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) {
+		cfg.ListCacheTTL = 0
+		cfg.CountCacheTTL = time.Minute
+	})
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	router.POST("/blogs", h.CreateBlog)
+	router.DELETE("/blogs/:id", h.DeleteBlog)
+	createTestBlog(t, db, models.Blog{Title: "Counted post", Tags: "css", Published: true})
+
+	expectCount := func(step, query, want string, wantTotal int64) {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs" + query})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogListResponse
+		decode(t, w, &resp)
+		if cache := w.Header().Get("X-Count-Cache"); cache != want || resp.Total != wantTotal {
+			t.Errorf("%s: X-Count-Cache %q with total %d, want %q with %d", step, cache, resp.Total, want, wantTotal)
+		}
+	}
+
+	expectCount("first request", "", "MISS", 1)
+	expectCount("other page", "?page=2", "HIT", 1)
+
+	// Posts written behind the handler's back are not seen until the count expires...
+	createTestBlog(t, db, models.Blog{Title: "Unseen post", Tags: "css", Published: true})
+	expectCount("direct insert", "", "HIT", 1)
+	// ...but filtered and search listings are always counted live
+	expectCount("tag filter", "?tag=css", "BYPASS", 2)
+	expectCount("search", "?search=post", "BYPASS", 2)
+	expectCount("drafts", "?published=false", "BYPASS", 0)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Created post", "content": "<p>Created post: " + testContent + "</p>", "author": "Test Author", "published": true,
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var created models.CreateBlogResponse
+	decode(t, w, &created)
+	expectCount("after create", "", "MISS", 3)
+	expectCount("repeat after create", "", "HIT", 3)
+
+	w = serve(router, testRequest{method: http.MethodDelete, path: "/blogs/" + itoa(created.ID)})
+	expectStatus(t, w, http.StatusNoContent)
+	expectCount("after delete", "", "MISS", 2)
+}
+
+func TestCountCacheDisabled(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.CountCacheTTL = 0 })
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	createTestBlog(t, db, models.Blog{Published: true})
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs"})
+	expectStatus(t, w, http.StatusOK)
+	if cache := w.Header().Get("X-Count-Cache"); cache != "BYPASS" {
+		t.Errorf("X-Count-Cache = %q, want BYPASS", cache)
+	}
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestGetBlogBySlugMetadata(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.SiteURL = "https://blog.example.com" })
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+
+	createTestBlog(t, db, models.Blog{Title: "Plain post", Slug: "plain-post", Excerpt: "The plain excerpt",
+		Author: "Jane Doe", Tags: "react, accessibility", Published: true, PublishedAt: timeAt(time.March, 5)})
+	createTestBlog(t, db, models.Blog{Title: "Meta post", Slug: "meta-post", MetaTitle: "The meta title",
+		MetaDesc: "The meta description", Content: "<p>Another post body that is long enough to pass validation.</p>", Published: true})
+
+	tests := []struct {
+		slug, headline, description string
+	}{
+		{"plain-post", "Plain post", "The plain excerpt"},
+		{"meta-post", "The meta title", "The meta description"},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + tt.slug})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogResponse
+		decode(t, w, &resp)
+
+		url := "https://blog.example.com/blog/" + tt.slug
+		if resp.Meta == nil {
+			t.Fatalf("%s: meta missing", tt.slug)
+		}
+		if resp.Meta.Title != tt.headline || resp.Meta.Description != tt.description ||
+			resp.Meta.Type != "article" || resp.Meta.URL != url {
+			t.Errorf("%s: meta = %+v", tt.slug, resp.Meta)
+		}
+
+		var posting map[string]interface{}
+		if err := json.Unmarshal([]byte(resp.JSONLD), &posting); err != nil {
+			t.Fatalf("%s: json_ld %q is not valid JSON: %v", tt.slug, resp.JSONLD, err)
+		}
+		for key, want := range map[string]string{
+			"@context":         "https://schema.org",
+			"@type":            "BlogPosting",
+			"headline":         tt.headline,
+			"description":      tt.description,
+			"url":              url,
+			"mainEntityOfPage": url,
+		} {
+			if posting[key] != want {
+				t.Errorf("%s: json_ld %s = %v, want %q", tt.slug, key, posting[key], want)
+			}
+		}
+		author, _ := posting["author"].(map[string]interface{})
+		if author["@type"] != "Person" || author["name"] != resp.Author {
+			t.Errorf("%s: json_ld author = %v, want Person %q", tt.slug, posting["author"], resp.Author)
+		}
+		for _, key := range []string{"datePublished", "dateModified"} {
+			value, _ := posting[key].(string)
+			if _, err := time.Parse(time.RFC3339, value); err != nil {
+				t.Errorf("%s: json_ld %s = %v, want an RFC3339 time", tt.slug, key, posting[key])
+			}
+		}
+	}
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/plain-post"})
+	var resp models.BlogResponse
+	decode(t, w, &resp)
+	if resp.Meta.PublishedTime != "2024-03-05T12:00:00Z" || resp.Meta.Author != "Jane Doe" {
+		t.Errorf("meta published time and author = %q, %q", resp.Meta.PublishedTime, resp.Meta.Author)
+	}
+}
+
+func TestBlogMetaFieldsGeneratedWhenOmitted(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+
+	title := "Designing accessible forms that work with every screen reader on the market today"
+	excerpt := strings.Repeat("Labels, hints and error messages all need to reach assistive technology. ", 4)
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title":   title,
+		"content": testContent,
+		"excerpt": excerpt,
+		"author":  "Test Author",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var created models.BlogResponse
+	decode(t, w, &created)
+	if created.MetaTitle != "Designing accessible forms that work with every screen" {
+		t.Errorf("meta title = %q", created.MetaTitle)
+	}
+	if len(created.MetaDesc) > models.MetaDescMaxLength || !strings.HasPrefix(excerpt, created.MetaDesc) ||
+		!strings.HasSuffix(created.MetaDesc, "Labels, hints") {
+		t.Errorf("meta description = %q, want the excerpt cut after a whole word within %d characters", created.MetaDesc, models.MetaDescMaxLength)
+	}
+
+	// Clearing the meta fields on update regenerates them from the new values
+	var stored models.Blog
+	db.First(&stored, created.ID)
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(created.ID), body: gin.H{
+		"title":            "A shorter title",
+		"excerpt":          "A shorter excerpt.",
+		"meta_title":       "",
+		"meta_description": "",
+		"version":          stored.Version,
+	}})
+	expectStatus(t, w, http.StatusOK)
+	db.First(&stored, created.ID)
+	if stored.MetaTitle != "A shorter title" || stored.MetaDesc != "A shorter excerpt." {
+		t.Errorf("meta after update = %q, %q", stored.MetaTitle, stored.MetaDesc)
+	}
+}
+
+func TestMetaFieldValidationUsesJSONNames(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", requireTestAPIKey(), h.CreateBlog)
+	router.PUT("/blogs/:id", requireTestAPIKey(), h.UpdateBlog)
+	blog := createTestBlog(t, db, models.Blog{Title: "Existing post", Published: true})
+
+	tooLong := strings.Repeat("d", 161)
+	for _, req := range []testRequest{
+		{method: http.MethodPost, path: "/blogs", body: map[string]interface{}{
+			"title": "Meta post", "content": testContent, "author": "Jane Doe", "meta_description": tooLong,
+		}},
+		{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: map[string]interface{}{"meta_description": tooLong}},
+	} {
+		req.apiKey = true
+		req.headers = map[string]string{"If-Match": "*"}
+		w := serve(router, req)
+		expectStatus(t, w, http.StatusBadRequest)
+		var resp struct {
+			Details []string `json:"details"`
+		}
+		decode(t, w, &resp)
+		if len(resp.Details) != 1 || resp.Details[0] != "meta_description must be at most 160 characters" {
+			t.Errorf("%s: details = %q, want meta_description must be at most 160 characters", req.method, resp.Details)
+		}
+		if strings.Contains(w.Body.String(), "MetaDesc") {
+			t.Errorf("%s: response names the Go field: %s", req.method, w.Body.String())
+		}
+	}
+}
+
+func TestBlogSEOWarnings(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+
+	goodTitle := "Designing accessible dark mode themes"                                      // 37 characters
+	goodDesc := "How to pick contrast ratios and colour tokens so dark themes stay readable." // 75 characters
+	warnedFields := func(w *httptest.ResponseRecorder) []string {
+		t.Helper()
+		var resp struct {
+			SEOWarnings []models.SEOWarning `json:"seo_warnings"`
+		}
+		decode(t, w, &resp)
+		fields := []string{}
+		for _, warning := range resp.SEOWarnings {
+			fields = append(fields, warning.Field)
+		}
+		return fields
+	}
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Short meta", "content": testContent, "author": "Test Author", "meta_title": "Dark mode", "meta_description": "Tips",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	if got := warnedFields(w); !reflect.DeepEqual(got, []string{"meta_title", "meta_description"}) {
+		t.Errorf("short meta fields warned on %q, want both", got)
+	}
+	// Warnings never block the save
+	var created models.Blog
+	db.Where("slug = ?", "short-meta").First(&created)
+	if created.MetaTitle != "Dark mode" || created.MetaDesc != "Tips" {
+		t.Errorf("stored meta = %q, %q", created.MetaTitle, created.MetaDesc)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Good meta", "content": testContent + "<p>Good meta.</p>", "author": "Test Author", "meta_title": goodTitle, "meta_description": goodDesc,
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	if strings.Contains(w.Body.String(), "seo_warnings") {
+		t.Errorf("well-sized meta fields produced warnings: %s", w.Body.String())
+	}
+
+	// Updates warn on the stored values after the change
+	path := "/blogs/" + itoa(created.ID)
+	ifMatch := map[string]string{"If-Match": "*"}
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"meta_title": goodTitle}, headers: ifMatch})
+	expectStatus(t, w, http.StatusOK)
+	if got := warnedFields(w); !reflect.DeepEqual(got, []string{"meta_description"}) {
+		t.Errorf("after fixing the title warned on %q, want meta_description", got)
+	}
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"meta_description": goodDesc}, headers: ifMatch})
+	expectStatus(t, w, http.StatusOK)
+	if strings.Contains(w.Body.String(), "seo_warnings") {
+		t.Errorf("well-sized update produced warnings: %s", w.Body.String())
+	}
+
+	// The hard maximum still rejects
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"meta_title": strings.Repeat("t", models.MetaTitleMaxLength+1)}, headers: ifMatch})
+	expectStatus(t, w, http.StatusBadRequest)
+}
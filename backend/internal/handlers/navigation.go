@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/models"
+)
+
+// adjacentPost returns the published post immediately before or after blog by
+// publish time, breaking ties by ID, or nil at either end. Unpublished posts
+// have no neighbours.
+func (h *BlogHandler) adjacentPost(c *gin.Context, blog models.Blog, next bool) *models.AdjacentPost {
+	if !blog.Published || blog.PublishedAt == nil {
+		return nil
+	}
+
+	condition := "published_at < ? OR (published_at = ? AND id < ?)"
+	order := "published_at DESC, id DESC"
+	if next {
+		condition = "published_at > ? OR (published_at = ? AND id > ?)"
+		order = "published_at ASC, id ASC"
+	}
+
+	var adjacent models.Blog
+	err := requestDB(c, h.db).Select("slug, title").
+		Where("published = ?", true).
+		Where(condition, blog.PublishedAt, blog.PublishedAt, blog.ID).
+		Order(order).
+		First(&adjacent).Error
+	if err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			c.Error(err)
+		}
+		return nil
+	}
+
+	return &models.AdjacentPost{Slug: adjacent.Slug, Title: adjacent.Title}
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestGetBlogBySlugNavigation(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+
+	// second-a and second-b share a publish time, so their IDs order them
+	createTestBlog(t, db, models.Blog{Title: "First", Published: true, PublishedAt: timeAt(time.January, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Second A", Published: true, PublishedAt: timeAt(time.February, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Second B", Published: true, PublishedAt: timeAt(time.February, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Middle draft", Content: "<p>A draft between published posts, which navigation skips.</p>"})
+	createTestBlog(t, db, models.Blog{Title: "Last", Published: true, PublishedAt: timeAt(time.March, 1)})
+
+	tests := []struct {
+		slug, prev, next string
+	}{
+		{"first", "", "second-a"},
+		{"second-a", "first", "second-b"},
+		{"second-b", "second-a", "last"},
+		{"last", "second-b", ""},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + tt.slug})
+		expectStatus(t, w, http.StatusOK)
+		var detail models.BlogDetailResponse
+		decode(t, w, &detail)
+		if got := adjacentSlug(detail.Prev); got != tt.prev {
+			t.Errorf("%s: prev = %q, want %q", tt.slug, got, tt.prev)
+		}
+		if got := adjacentSlug(detail.Next); got != tt.next {
+			t.Errorf("%s: next = %q, want %q", tt.slug, got, tt.next)
+		}
+
+		// The missing direction is null rather than omitted
+		var raw map[string]interface{}
+		decode(t, w, &raw)
+		for _, key := range []string{"prev", "next"} {
+			if _, ok := raw[key]; !ok {
+				t.Errorf("%s: %s missing from the response", tt.slug, key)
+			}
+		}
+	}
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/first"})
+	var detail models.BlogDetailResponse
+	decode(t, w, &detail)
+	if detail.Next == nil || detail.Next.Title != "Second A" {
+		t.Errorf("first: next = %+v, want the Second A title", detail.Next)
+	}
+}
+
+// adjacentSlug returns the slug of a navigation link, or "" when there is none
+func adjacentSlug(post *models.AdjacentPost) string {
+	if post == nil {
+		return ""
+	}
+	return post.Slug
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// requestBaseURL returns the absolute URL of the current request without its query string
+func requestBaseURL(c *gin.Context) string {
+	return requestOrigin(c) + c.Request.URL.Path
+}
+
+// requestOrigin returns the scheme and host the current request was made to
+func requestOrigin(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host
+}
+
+// pageURL builds the URL of the given page, preserving the current query parameters
+func pageURL(base string, query url.Values, page int) string {
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = values
+	}
+	params.Set("page", strconv.Itoa(page))
+	return base + "?" + params.Encode()
+}
+
+// paginationLinks builds hypermedia links for a paginated response and sets the RFC 5988 Link header
+func paginationLinks(c *gin.Context, page, totalPages int) models.PaginationLinks {
+	if totalPages < 1 {
+		totalPages = 1
+	}
+
+	base := requestBaseURL(c)
+	query := c.Request.URL.Query()
+
+	links := models.PaginationLinks{
+		First: pageURL(base, query, 1),
+		Last:  pageURL(base, query, totalPages),
+	}
+	if page > 1 {
+		links.Prev = pageURL(base, query, min(page-1, totalPages))
+	}
+	if page < totalPages {
+		links.Next = pageURL(base, query, page+1)
+	}
+
+	header := []string{
+		`<` + links.First + `>; rel="first"`,
+	}
+	if links.Prev != "" {
+		header = append(header, `<`+links.Prev+`>; rel="prev"`)
+	}
+	if links.Next != "" {
+		header = append(header, `<`+links.Next+`>; rel="next"`)
+	}
+	header = append(header, `<`+links.Last+`>; rel="last"`)
+	c.Header("Link", strings.Join(header, ", "))
+
+	return links
+}
+
+// Listing page sizes used when DEFAULT_PAGE_SIZE and MAX_PAGE_SIZE are not set
+const (
+	DefaultPageSize    = 10
+	DefaultMaxPageSize = 100
+)
+
+// Configured listing page sizes: the limit used when none is given and the largest accepted
+var (
+	defaultPageLimit = DefaultPageSize
+	maxPageLimit     = DefaultMaxPageSize
+)
+
+// SetPageSizes configures the default and maximum listing page sizes
+func SetPageSizes(defaultSize, maxSize int) error {
+	if maxSize < 1 {
+		return fmt.Errorf("maximum page size must be a positive integer, got %d", maxSize)
+	}
+	if defaultSize < 1 || defaultSize > maxSize {
+		return fmt.Errorf("default page size must be between 1 and the maximum page size %d, got %d", maxSize, defaultSize)
+	}
+	defaultPageLimit = defaultSize
+	maxPageLimit = maxSize
+	return nil
+}
+
+// parsePagination reads the page and limit query parameters. Out-of-range or
+// non-numeric values fall back to the defaults, or are rejected with a 400 when
+// STRICT_PAGINATION is enabled. The effective limit is echoed in X-Per-Page.
+func (h *BlogHandler) parsePagination(c *gin.Context) (int, int, bool) {
+	page, pageErr := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, limitErr := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultPageLimit)))
+
+	if h.strictPagination {
+		if pageErr != nil || page < 1 {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid page", "page must be a positive integer, got "+strconv.Quote(c.Query("page")))
+			return 0, 0, false
+		}
+		if limitErr != nil || limit < 1 || limit > maxPageLimit {
+			apierror.Respond(c, http.StatusBadRequest, "Invalid limit", "limit must be an integer between 1 and "+strconv.Itoa(maxPageLimit)+", got "+strconv.Quote(c.Query("limit")))
+			return 0, 0, false
+		}
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > maxPageLimit {
+		limit = defaultPageLimit
+	}
+
+	c.Header("X-Per-Page", strconv.Itoa(limit))
+	return page, limit, true
+}
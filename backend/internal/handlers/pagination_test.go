@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestListPaginationModes(t *testing.T) {
+	tests := []struct {
+		query      string
+		strict     bool
+		wantStatus int
+		wantLimit  string
+	}{
+		{"limit=5", false, http.StatusOK, "5"},
+		{"limit=500", false, http.StatusOK, "10"},
+		{"limit=0", false, http.StatusOK, "10"},
+		{"page=-1", false, http.StatusOK, "10"},
+		{"limit=5", true, http.StatusOK, "5"},
+		{"limit=500", true, http.StatusBadRequest, ""},
+		{"limit=0", true, http.StatusBadRequest, ""},
+		{"page=0", true, http.StatusBadRequest, ""},
+		{"page=abc", true, http.StatusBadRequest, ""},
+	}
+	for _, path := range []string{"/blogs", "/blogs/popular"} {
+		for _, strict := range []bool{false, true} {
+			h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.StrictPagination = strict })
+			router := gin.New()
+			router.GET("/blogs", h.GetBlogs)
+			router.GET("/blogs/popular", h.GetPopularBlogs)
+			createTestBlog(t, db, models.Blog{Published: true})
+
+			for _, tt := range tests {
+				if tt.strict != strict {
+					continue
+				}
+				w := serve(router, testRequest{method: http.MethodGet, path: path + "?" + tt.query})
+				if w.Code != tt.wantStatus {
+					t.Errorf("%s?%s strict=%v: status = %d, want %d", path, tt.query, strict, w.Code, tt.wantStatus)
+					continue
+				}
+				if got := w.Header().Get("X-Per-Page"); tt.wantLimit != "" && got != tt.wantLimit {
+					t.Errorf("%s?%s strict=%v: X-Per-Page = %q, want %q", path, tt.query, strict, got, tt.wantLimit)
+				}
+			}
+		}
+	}
+}
+
+func TestGetPopularBlogsPagination(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/popular", h.GetPopularBlogs)
+
+	// Ties on views are broken by the most recent publish time
+	posts := []struct {
+		title string
+		views int
+		day   int
+	}{
+		{"Tied older", 20, 1},
+		{"Most viewed", 90, 2},
+		{"Tied newer", 20, 5},
+		{"Least viewed", 1, 3},
+		{"Middle", 40, 4},
+	}
+	for _, post := range posts {
+		createTestBlog(t, db, models.Blog{Title: post.title, Published: true, ViewCount: post.views, PublishedAt: timeAt(time.June, post.day)})
+	}
+	createTestBlog(t, db, models.Blog{Title: "Popular draft", ViewCount: 1000})
+
+	tests := []struct {
+		query    string
+		titles   []string
+		hasPrev  bool
+		hasNext  bool
+		lastPage int
+	}{
+		{"limit=2", []string{"Most viewed", "Middle"}, false, true, 3},
+		{"limit=2&page=2", []string{"Tied newer", "Tied older"}, true, true, 3},
+		{"limit=2&page=3", []string{"Least viewed"}, true, false, 3},
+		{"limit=2&page=4", []string{}, true, false, 3},
+		{"limit=5", []string{"Most viewed", "Middle", "Tied newer", "Tied older", "Least viewed"}, false, false, 1},
+	}
+	for _, tt := range tests {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/popular?" + tt.query})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogListResponse
+		decode(t, w, &resp)
+		titles := make([]string, len(resp.Blogs))
+		for i, blog := range resp.Blogs {
+			titles[i] = blog.Title
+		}
+		if !reflect.DeepEqual(titles, tt.titles) {
+			t.Errorf("%s: titles = %v, want %v", tt.query, titles, tt.titles)
+		}
+		if resp.Total != 5 || resp.TotalPages != tt.lastPage || resp.HasPrev != tt.hasPrev || resp.HasNext != tt.hasNext {
+			t.Errorf("%s: total %d, pages %d, prev %v, next %v; want 5, %d, %v, %v",
+				tt.query, resp.Total, resp.TotalPages, resp.HasPrev, resp.HasNext, tt.lastPage, tt.hasPrev, tt.hasNext)
+		}
+		if got := w.Header().Get("X-Total-Count"); got != "5" {
+			t.Errorf("%s: X-Total-Count = %q, want 5", tt.query, got)
+		}
+		if got, want := resp.Links.Last, "page="+strconv.Itoa(tt.lastPage); !strings.Contains(got, want) {
+			t.Errorf("%s: last link %q, want %s", tt.query, got, want)
+		}
+	}
+}
+
+func TestGetBlogsPaginationLinks(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	for i := 1; i <= 5; i++ {
+		n := strconv.Itoa(i)
+		createTestBlog(t, db, models.Blog{Title: "Accessible tips part " + n, Content: "<p>Accessible tips, part " + n + " of a long series of posts.</p>",
+			Tags: "a11y", Featured: true, Published: true})
+	}
+	createTestBlog(t, db, models.Blog{Title: "Not featured", Tags: "a11y", Published: true})
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?search=accessible&featured=true&tag=a11y&limit=2&page=2"})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.BlogListResponse
+	decode(t, w, &resp)
+	if resp.Total != 5 || resp.TotalPages != 3 {
+		t.Fatalf("total = %d over %d pages, want 5 over 3", resp.Total, resp.TotalPages)
+	}
+
+	links := map[string]string{"first": resp.Links.First, "prev": resp.Links.Prev, "next": resp.Links.Next, "last": resp.Links.Last}
+	wantPages := map[string]string{"first": "1", "prev": "1", "next": "3", "last": "3"}
+	for rel, link := range links {
+		parsed, err := url.Parse(link)
+		if err != nil || parsed.Scheme != "http" || parsed.Host != "example.com" || parsed.Path != "/blogs" {
+			t.Errorf("%s link %q is not an absolute URL of the listing", rel, link)
+			continue
+		}
+		query := parsed.Query()
+		for key, want := range map[string]string{"search": "accessible", "featured": "true", "tag": "a11y", "limit": "2", "page": wantPages[rel]} {
+			if got := query.Get(key); got != want {
+				t.Errorf("%s link %q: %s = %q, want %q", rel, link, key, got, want)
+			}
+		}
+		if header := w.Header().Get("Link"); !strings.Contains(header, "<"+link+`>; rel="`+rel+`"`) {
+			t.Errorf("Link header %q is missing the %s link", header, rel)
+		}
+	}
+
+	// The first and last pages have no prev and next links
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs?featured=true&limit=5"})
+	resp = models.BlogListResponse{}
+	decode(t, w, &resp)
+	if resp.Links.Prev != "" || resp.Links.Next != "" {
+		t.Errorf("single page links = %+v, want no prev or next", resp.Links)
+	}
+	if header := w.Header().Get("Link"); strings.Contains(header, `rel="prev"`) || strings.Contains(header, `rel="next"`) {
+		t.Errorf("single page Link header = %q, want no prev or next", header)
+	}
+}
+
+func TestGetBlogsSearchPagingWithTies(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+
+	// Posts in each group share a rank, so only the id tiebreaker orders them
+	group := func(title string, blog models.Blog) []string {
+		var titles []string
+		for i := 1; i <= 3; i++ {
+			blog.Title = title + " " + strconv.Itoa(i)
+			blog.Slug = ""
+			blog.Published = true
+			createTestBlog(t, db, blog)
+			titles = append([]string{blog.Title}, titles...)
+		}
+		return titles
+	}
+	content := group("Content match", models.Blog{Content: "<p>Colour contrast matters to readers with low vision and in bright light.</p>"})
+	tagged := group("Tag match", models.Blog{Tags: "contrast"})
+	titled := group("Contrast in title", models.Blog{})
+	createTestBlog(t, db, models.Blog{Title: "No match", Published: true})
+	want := append(append(titled, tagged...), content...)
+
+	var got []string
+	for page := 1; page <= 4; page++ {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?search=contrast&limit=2&page=" + strconv.Itoa(page)})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogListResponse
+		decode(t, w, &resp)
+		if resp.Total != 9 || resp.TotalPages != 5 {
+			t.Errorf("page %d: total = %d over %d pages, want 9 over 5", page, resp.Total, resp.TotalPages)
+		}
+		for _, blog := range resp.Blogs {
+			got = append(got, blog.Title)
+		}
+	}
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs?search=contrast&limit=2&page=5"})
+	var last models.BlogListResponse
+	decode(t, w, &last)
+	if len(last.Blogs) != 1 || last.HasNext {
+		t.Errorf("last page = %d posts, hasNext %v; want 1 post and no next page", len(last.Blogs), last.HasNext)
+	}
+	for _, blog := range last.Blogs {
+		got = append(got, blog.Title)
+	}
+
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("paged results = %v, want %v", got, want)
+	}
+}
+
+func TestSetPageSizes(t *testing.T) {
+	t.Cleanup(func() { SetPageSizes(DefaultPageSize, DefaultMaxPageSize) })
+
+	for _, sizes := range [][2]int{{0, 10}, {-1, 10}, {11, 10}, {5, 0}} {
+		if err := SetPageSizes(sizes[0], sizes[1]); err == nil {
+			t.Errorf("SetPageSizes(%d, %d) succeeded, want an error", sizes[0], sizes[1])
+		}
+	}
+	if defaultPageLimit != DefaultPageSize || maxPageLimit != DefaultMaxPageSize {
+		t.Errorf("rejected sizes changed the limits to %d and %d", defaultPageLimit, maxPageLimit)
+	}
+	if err := SetPageSizes(7, 7); err != nil {
+		t.Errorf("SetPageSizes(7, 7): %v", err)
+	}
+}
+
+func TestConfiguredPageSizes(t *testing.T) {
+	if err := SetPageSizes(3, 5); err != nil {
+		t.Fatalf("SetPageSizes: %v", err)
+	}
+	t.Cleanup(func() { SetPageSizes(DefaultPageSize, DefaultMaxPageSize) })
+
+	for _, strict := range []bool{false, true} {
+		h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.StrictPagination = strict })
+		router := gin.New()
+		router.GET("/blogs", h.GetBlogs)
+		router.GET("/archive/:year/:month", h.GetArchiveMonth)
+		for day := 1; day <= 8; day++ {
+			createTestBlog(t, db, models.Blog{Title: "Post " + strconv.Itoa(day), Published: true, PublishedAt: timeAt(time.May, day)})
+		}
+
+		for _, path := range []string{"/blogs", "/archive/2024/5"} {
+			// Omitted parameters use the configured default
+			w := serve(router, testRequest{method: http.MethodGet, path: path})
+			expectStatus(t, w, http.StatusOK)
+			var resp models.BlogListResponse
+			decode(t, w, &resp)
+			if resp.Limit != 3 || len(resp.Blogs) != 3 || resp.TotalPages != 3 || w.Header().Get("X-Per-Page") != "3" {
+				t.Errorf("%s strict=%v: limit %d, %d posts, %d pages, X-Per-Page %q; want 3, 3, 3, 3",
+					path, strict, resp.Limit, len(resp.Blogs), resp.TotalPages, w.Header().Get("X-Per-Page"))
+			}
+
+			w = serve(router, testRequest{method: http.MethodGet, path: path + "?limit=5"})
+			expectStatus(t, w, http.StatusOK)
+			decode(t, w, &resp)
+			if resp.Limit != 5 || len(resp.Blogs) != 5 {
+				t.Errorf("%s?limit=5 strict=%v: limit %d with %d posts, want 5", path, strict, resp.Limit, len(resp.Blogs))
+			}
+
+			// Limits above the configured maximum fall back to the default, or are rejected in strict mode
+			w = serve(router, testRequest{method: http.MethodGet, path: path + "?limit=6"})
+			if strict {
+				expectStatus(t, w, http.StatusBadRequest)
+				if !strings.Contains(w.Body.String(), "between 1 and 5") {
+					t.Errorf("%s?limit=6: body %s does not name the maximum", path, w.Body.String())
+				}
+				continue
+			}
+			expectStatus(t, w, http.StatusOK)
+			decode(t, w, &resp)
+			if resp.Limit != 3 {
+				t.Errorf("%s?limit=6: limit = %d, want the default 3", path, resp.Limit)
+			}
+		}
+	}
+}
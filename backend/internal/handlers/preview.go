@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// previewTokenParam is the query parameter carrying a draft preview token
+const previewTokenParam = "preview_token"
+
+// CreatePreviewToken handles POST /api/v1/blogs/:id/preview-token
+// @Summary Create a draft preview token
+// @Description Mint a signed, expiring token that lets reviewers read a post before it is published
+// @Tags blogs
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Success 201 {object} gin.H
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/preview-token [post]
+func (h *BlogHandler) CreatePreviewToken(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID", "id must be a positive integer")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id, slug").First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	token, expiresAt := h.previews.Mint(blog.ID, time.Now())
+
+	c.JSON(http.StatusCreated, gin.H{
+		"token":       token,
+		"expires_at":  expiresAt,
+		"preview_url": models.BlogURL(h.siteURL, blog.Slug) + "?" + previewTokenParam + "=" + token,
+	})
+}
+
+// getPreviewBlog loads a post by slug regardless of its published state and
+// checks the preview token against it, writing an error response on failure
+func (h *BlogHandler) getPreviewBlog(c *gin.Context, slug, token string) (models.Blog, bool) {
+	var blog models.Blog
+	if err := requestDB(c, h.db).Where("slug = ?", slug).First(&blog).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			h.respondMissingBlog(c, slug)
+			return blog, false
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return blog, false
+	}
+
+	if err := h.previews.Verify(token, blog.ID, time.Now()); err != nil {
+		apierror.Respond(c, http.StatusForbidden, "Invalid preview token", err.Error())
+		return blog, false
+	}
+
+	// Keep draft previews out of caches and search indexes
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+
+	return blog, true
+}
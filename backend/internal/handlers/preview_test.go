@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestPreviewTokens(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	router.POST("/blogs/:id/preview-token", requireTestAPIKey(), h.CreatePreviewToken)
+
+	draft := createTestBlog(t, db, models.Blog{Title: "Secret draft"})
+	other := createTestBlog(t, db, models.Blog{Title: "Other draft", Content: "<p>Another draft with enough visible text to be a post body.</p>"})
+
+	path := "/blogs/" + itoa(draft.ID) + "/preview-token"
+	expectStatus(t, serve(router, testRequest{method: http.MethodPost, path: path}), http.StatusUnauthorized)
+	w := serve(router, testRequest{method: http.MethodPost, path: path, apiKey: true})
+	expectStatus(t, w, http.StatusCreated)
+	var minted struct {
+		Token      string    `json:"token"`
+		ExpiresAt  time.Time `json:"expires_at"`
+		PreviewURL string    `json:"preview_url"`
+	}
+	decode(t, w, &minted)
+	if !strings.HasSuffix(minted.PreviewURL, "/blog/secret-draft?preview_token="+minted.Token) {
+		t.Errorf("preview_url = %q", minted.PreviewURL)
+	}
+	if !minted.ExpiresAt.After(time.Now()) {
+		t.Errorf("expires_at = %v, want a future time", minted.ExpiresAt)
+	}
+
+	expired, _ := h.previews.Mint(draft.ID, time.Now().Add(-2*h.previews.TTL()))
+	otherToken, _ := h.previews.Mint(other.ID, time.Now())
+
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{
+		{"no token", "", http.StatusNotFound},
+		{"valid token", minted.Token, http.StatusOK},
+		{"expired token", expired, http.StatusForbidden},
+		{"token for another post", otherToken, http.StatusForbidden},
+		{"tampered token", minted.Token + "x", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		target := "/blogs/secret-draft"
+		if tt.token != "" {
+			target += "?preview_token=" + url.QueryEscape(tt.token)
+		}
+		w := serve(router, testRequest{method: http.MethodGet, path: target})
+		if w.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d; body: %s", tt.name, w.Code, tt.want, w.Body.String())
+			continue
+		}
+		if tt.want != http.StatusOK {
+			continue
+		}
+		var resp models.BlogResponse
+		decode(t, w, &resp)
+		if resp.ID != draft.ID || resp.Published {
+			t.Errorf("%s: got post %d published %v, want the draft", tt.name, resp.ID, resp.Published)
+		}
+		if w.Header().Get("Cache-Control") != "no-store" || !strings.Contains(w.Header().Get("X-Robots-Tag"), "noindex") {
+			t.Errorf("%s: preview is cacheable or indexable: %v", tt.name, w.Header())
+		}
+	}
+
+	// Previews do not count as views
+	var stored models.Blog
+	db.First(&stored, draft.ID)
+	if stored.ViewCount != 0 {
+		t.Errorf("view count after previews = %d, want 0", stored.ViewCount)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs/999/preview-token", apiKey: true})
+	expectStatus(t, w, http.StatusNotFound)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"math/rand"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// GetRandomBlog handles GET /api/v1/blogs/random
+// @Summary Get a random blog post
+// @Description Return one random published post without its content, optionally limited to a tag
+// @Tags blogs
+// @Produce json
+// @Param tag query string false "Only pick posts with this tag"
+// @Success 200 {object} models.BlogResponse
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/random [get]
+func (h *BlogHandler) GetRandomBlog(c *gin.Context) {
+	published := func() *gorm.DB {
+		query := requestDB(c, h.db).Model(&models.Blog{}).Where("published = ?", true)
+		if tag := strings.TrimSpace(c.Query("tag")); tag != "" {
+			query = whereHasTag(query, tag)
+		}
+		return query
+	}
+
+	var total int
+	if err := published().Count(&total).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+	if total == 0 {
+		apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+		return
+	}
+
+	// Skip to a random row in a stable order instead of sorting the whole table randomly
+	var blog models.Blog
+	err := published().Order("id").Offset(rand.Intn(total)).Limit(1).First(&blog).Error
+	if gorm.IsRecordNotFoundError(err) {
+		// A post was unpublished between the count and the fetch
+		err = published().Order("id").First(&blog).Error
+	}
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, blog.ToResponse(false))
+}
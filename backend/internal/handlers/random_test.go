@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+// randomTitles requests a random post n times and returns the distinct titles served
+func randomTitles(t *testing.T, router http.Handler, path string, n int) []string {
+	t.Helper()
+	seen := map[string]bool{}
+	for i := 0; i < n; i++ {
+		w := serve(router, testRequest{method: http.MethodGet, path: path})
+		expectStatus(t, w, http.StatusOK)
+		if got := w.Header().Get("Cache-Control"); got != "no-store" {
+			t.Fatalf("Cache-Control = %q, want no-store", got)
+		}
+		var blog models.BlogResponse
+		decode(t, w, &blog)
+		if !blog.Published || blog.Content != "" {
+			t.Fatalf("random post %q: published %v, content %q; want a published post without content", blog.Title, blog.Published, blog.Content)
+		}
+		seen[blog.Title] = true
+	}
+	titles := make([]string, 0, len(seen))
+	for title := range seen {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+	return titles
+}
+
+func TestGetRandomBlog(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/random", h.GetRandomBlog)
+
+	expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: "/blogs/random"}), http.StatusNotFound)
+
+	createTestBlog(t, db, models.Blog{Title: "Alt text", Tags: "a11y, images", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Focus order", Tags: "a11y", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Release notes", Tags: "news", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Draft on captions", Tags: "a11y"})
+	createTestBlog(t, db, models.Blog{Title: "Draft only tag", Tags: "drafts"})
+
+	// Every published post is picked sooner or later, and drafts never are
+	if got, want := randomTitles(t, router, "/blogs/random", 60), []string{"Alt text", "Focus order", "Release notes"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("random posts = %v, want %v", got, want)
+	}
+	if got, want := randomTitles(t, router, "/blogs/random?tag=A11Y", 40), []string{"Alt text", "Focus order"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("random a11y posts = %v, want %v", got, want)
+	}
+	if got, want := randomTitles(t, router, "/blogs/random?tag=news", 5), []string{"Release notes"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("random news posts = %v, want %v", got, want)
+	}
+
+	for _, tag := range []string{"drafts", "missing", "image"} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/random?tag=" + tag})
+		expectStatus(t, w, http.StatusNotFound)
+	}
+}
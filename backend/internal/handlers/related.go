@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// GetRelatedBlogs handles GET /api/v1/blogs/:slug/related
+// @Summary Get posts related to a blog post
+// @Description List published posts sharing the most tags with a post, the posts it links to and the posts linking back to it
+// @Tags blogs
+// @Produce json
+// @Param slug path string true "Blog slug"
+// @Success 200 {object} models.RelatedPostsResponse
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{slug}/related [get]
+func (h *BlogHandler) GetRelatedBlogs(c *gin.Context) {
+	blog, ok := h.findBlogBySlug(c, c.Param("slug"))
+	if !ok {
+		return
+	}
+
+	related, err := h.taggedRelatedPosts(c, blog)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch related posts")
+		return
+	}
+
+	linksTo, err := h.linkedPosts(c, blog)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch related posts")
+		return
+	}
+
+	linkedFrom, err := h.backlinks(c, blog)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch related posts")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.RelatedPostsResponse{
+		Related:    related,
+		LinksTo:    linksTo,
+		LinkedFrom: linkedFrom,
+	})
+}
+
+// taggedRelatedPosts ranks other published posts by the number of tags they
+// share with blog, breaking ties by the newest
+func (h *BlogHandler) taggedRelatedPosts(c *gin.Context, blog models.Blog) ([]models.RelatedPost, error) {
+	related := []models.RelatedPost{}
+	tags := models.ParseTags(blog.Tags)
+	if len(tags) == 0 {
+		return related, nil
+	}
+
+	var candidates []models.Blog
+	query := requestDB(c, h.db).Select("id, slug, title, tags, published_at").
+		Where("published = ? AND id <> ?", true, blog.ID)
+	if err := whereHasTags(query, tags, false).
+		Order("published_at DESC, id DESC").
+		Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		wanted[tag] = true
+	}
+	for _, candidate := range candidates {
+		shared := 0
+		for _, tag := range models.ParseTags(candidate.Tags) {
+			if wanted[tag] {
+				shared++
+			}
+		}
+		if shared > 0 {
+			related = append(related, models.RelatedPost{Slug: candidate.Slug, Title: candidate.Title, SharedTags: shared})
+		}
+	}
+
+	// Candidates arrive newest first, so a stable sort keeps that order within ties
+	sort.SliceStable(related, func(i, j int) bool {
+		return related[i].SharedTags > related[j].SharedTags
+	})
+	if len(related) > models.MaxRelatedPosts {
+		related = related[:models.MaxRelatedPosts]
+	}
+	return related, nil
+}
+
+// linkedPosts returns the published posts blog links to internally, in link order
+func (h *BlogHandler) linkedPosts(c *gin.Context, blog models.Blog) ([]models.AdjacentPost, error) {
+	posts := []models.AdjacentPost{}
+	slugs := models.InternalLinkSlugs(blog.Content, h.siteURL)
+	if len(slugs) == 0 {
+		return posts, nil
+	}
+
+	var targets []models.Blog
+	if err := requestDB(c, h.db).Select("id, slug, title").
+		Where("slug IN (?) AND published = ? AND id <> ?", slugs, true, blog.ID).
+		Find(&targets).Error; err != nil {
+		return nil, err
+	}
+	bySlug := make(map[string]models.Blog, len(targets))
+	for _, target := range targets {
+		bySlug[target.Slug] = target
+	}
+	for _, slug := range slugs {
+		if target, ok := bySlug[slug]; ok {
+			posts = append(posts, models.AdjacentPost{Slug: target.Slug, Title: target.Title})
+		}
+	}
+	return posts, nil
+}
+
+// backlinks returns the published posts whose content links to blog, newest
+// first. Content is prefiltered on the slug, then each link is checked.
+func (h *BlogHandler) backlinks(c *gin.Context, blog models.Blog) ([]models.AdjacentPost, error) {
+	var candidates []models.Blog
+	if err := requestDB(c, h.db).Select("id, slug, title, content").
+		Where("published = ? AND id <> ?", true, blog.ID).
+		Where(`content LIKE ? ESCAPE '\'`, "%"+escapeLike(blog.Slug)+"%").
+		Order("published_at DESC, id DESC").
+		Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	posts := []models.AdjacentPost{}
+	for _, candidate := range candidates {
+		for _, slug := range models.InternalLinkSlugs(candidate.Content, h.siteURL) {
+			if slug == blog.Slug {
+				posts = append(posts, models.AdjacentPost{Slug: candidate.Slug, Title: candidate.Title})
+				break
+			}
+		}
+	}
+	return posts, nil
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+// getRelated requests the related posts of slug
+func getRelated(t *testing.T, router *gin.Engine, slug string) models.RelatedPostsResponse {
+	t.Helper()
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + slug + "/related"})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.RelatedPostsResponse
+	decode(t, w, &resp)
+	return resp
+}
+
+// linksTo builds post content linking to each href
+func linksTo(hrefs ...string) string {
+	content := "<p>" + testContent
+	for _, href := range hrefs {
+		content += ` <a href="` + href + `">link</a>`
+	}
+	return content + "</p>"
+}
+
+func TestGetRelatedBlogsLinkGraph(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.SiteURL = "https://blog.example.com" })
+	router := gin.New()
+	router.GET("/blogs/:slug/related", h.GetRelatedBlogs)
+
+	// hub links to alpha and beta; alpha and beta link back; a draft and an
+	// external site link to hub but do not count
+	createTestBlog(t, db, models.Blog{Title: "Hub", Published: true, PublishedAt: timeAt(1, 1), Content: linksTo(
+		"/blog/beta", "https://blog.example.com/blogs/Alpha/", "/blog/beta#again", "/blog/draft", "/blog/missing", "/blog/hub",
+	)})
+	createTestBlog(t, db, models.Blog{Title: "Alpha", Published: true, PublishedAt: timeAt(2, 1), Content: linksTo("/api/v1/blogs/hub")})
+	createTestBlog(t, db, models.Blog{Title: "Beta", Published: true, PublishedAt: timeAt(3, 1), Content: linksTo("https://blog.example.com/blog/hub")})
+	createTestBlog(t, db, models.Blog{Title: "Draft", Content: linksTo("/blog/hub")})
+	createTestBlog(t, db, models.Blog{Title: "External", Published: true, PublishedAt: timeAt(4, 1), Content: linksTo("https://other.example.com/blog/hub")})
+	// Mentions the slug without linking to it
+	createTestBlog(t, db, models.Blog{Title: "Mention", Published: true, PublishedAt: timeAt(5, 1), Content: "<p>The hub post is great. " + testContent + "</p>"})
+
+	link := func(slug, title string) models.AdjacentPost { return models.AdjacentPost{Slug: slug, Title: title} }
+	tests := []struct {
+		slug       string
+		linksTo    []models.AdjacentPost
+		linkedFrom []models.AdjacentPost
+	}{
+		// Link order, without duplicates, drafts, missing posts or self links
+		{"hub", []models.AdjacentPost{link("beta", "Beta"), link("alpha", "Alpha")}, []models.AdjacentPost{link("beta", "Beta"), link("alpha", "Alpha")}},
+		{"alpha", []models.AdjacentPost{link("hub", "Hub")}, []models.AdjacentPost{link("hub", "Hub")}},
+		{"beta", []models.AdjacentPost{link("hub", "Hub")}, []models.AdjacentPost{link("hub", "Hub")}},
+		{"mention", []models.AdjacentPost{}, []models.AdjacentPost{}},
+	}
+	for _, tt := range tests {
+		resp := getRelated(t, router, tt.slug)
+		if !reflect.DeepEqual(resp.LinksTo, tt.linksTo) {
+			t.Errorf("%s: links_to = %+v, want %+v", tt.slug, resp.LinksTo, tt.linksTo)
+		}
+		if !reflect.DeepEqual(resp.LinkedFrom, tt.linkedFrom) {
+			t.Errorf("%s: linked_from = %+v, want %+v", tt.slug, resp.LinkedFrom, tt.linkedFrom)
+		}
+	}
+}
+
+func TestGetRelatedBlogsSharedTags(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug/related", h.GetRelatedBlogs)
+
+	createTestBlog(t, db, models.Blog{Title: "Source", Tags: "react, css, a11y", Published: true, PublishedAt: timeAt(1, 1)})
+	createTestBlog(t, db, models.Blog{Title: "One shared old", Tags: "css", Published: true, PublishedAt: timeAt(2, 1)})
+	createTestBlog(t, db, models.Blog{Title: "One shared new", Tags: "react, go", Published: true, PublishedAt: timeAt(3, 1)})
+	createTestBlog(t, db, models.Blog{Title: "Two shared", Tags: "a11y, css", Published: true, PublishedAt: timeAt(1, 2)})
+	createTestBlog(t, db, models.Blog{Title: "Draft shared", Tags: "react, css, a11y"})
+	createTestBlog(t, db, models.Blog{Title: "Unrelated", Tags: "go", Published: true})
+
+	resp := getRelated(t, router, "source")
+	want := []models.RelatedPost{
+		{Slug: "two-shared", Title: "Two shared", SharedTags: 2},
+		{Slug: "one-shared-new", Title: "One shared new", SharedTags: 1},
+		{Slug: "one-shared-old", Title: "One shared old", SharedTags: 1},
+	}
+	if !reflect.DeepEqual(resp.Related, want) {
+		t.Errorf("related = %+v, want %+v", resp.Related, want)
+	}
+	if resp.LinksTo == nil || resp.LinkedFrom == nil {
+		t.Error("link arrays are null, want empty")
+	}
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/missing/related"})
+	expectStatus(t, w, http.StatusNotFound)
+}
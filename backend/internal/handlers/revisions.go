@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/webhooks"
+)
+
+// blogIDParam parses the numeric blog ID from the path. GET routes share the
+// :slug wildcard with GetBlogBySlug because gin requires wildcard names to
+// match at the same position, so both names are checked.
+func blogIDParam(c *gin.Context) (uint64, error) {
+	value := c.Param("id")
+	if value == "" {
+		value = c.Param("slug")
+	}
+	return strconv.ParseUint(value, 10, 32)
+}
+
+// saveRevision snapshots the current state of a blog and prunes old revisions
+func (h *BlogHandler) saveRevision(tx *gorm.DB, blog *models.Blog) error {
+	var latest models.BlogRevision
+	version := 1
+	if err := tx.Where("blog_id = ?", blog.ID).Order("version DESC").First(&latest).Error; err == nil {
+		version = latest.Version + 1
+	} else if !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+
+	revision := models.NewBlogRevision(blog, version)
+	if err := tx.Create(&revision).Error; err != nil {
+		return err
+	}
+
+	// Drop revisions beyond the retention limit
+	if h.maxRevisions > 0 {
+		if err := tx.Where("blog_id = ? AND version <= ?", blog.ID, version-h.maxRevisions).
+			Delete(&models.BlogRevision{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRevisions handles GET /api/v1/blogs/:id/revisions
+// @Summary List revisions of a blog post
+// @Description Retrieve the saved revisions of a blog post, newest first
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Success 200 {array} models.BlogRevisionResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/revisions [get]
+func (h *BlogHandler) GetRevisions(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	var revisions []models.BlogRevision
+	if err := requestDB(c, h.db).Where("blog_id = ?", blog.ID).Order("version DESC").Find(&revisions).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch revisions")
+		return
+	}
+
+	revisionResponses := make([]models.BlogRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		revisionResponses[i] = revision.ToResponse(false)
+	}
+
+	c.JSON(http.StatusOK, revisionResponses)
+}
+
+// RestoreRevision handles POST /api/v1/blogs/:id/revisions/:version/restore
+// @Summary Restore a blog post revision
+// @Description Roll a blog post back to a saved revision, snapshotting the current state first
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Param version path int true "Revision version"
+// @Success 200 {object} models.BlogResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/revisions/{version}/restore [post]
+func (h *BlogHandler) RestoreRevision(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid revision version")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	var revision models.BlogRevision
+	if err := requestDB(c, h.db).Where("blog_id = ? AND version = ?", blog.ID, version).First(&revision).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Revision not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch revision")
+		return
+	}
+
+	// Snapshot the current state so the restore itself can be undone
+	tx := requestDB(c, h.db).Begin()
+	if err := h.saveRevision(tx, &blog); err != nil {
+		tx.Rollback()
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to save revision")
+		return
+	}
+
+	if err := tx.Model(&blog).Updates(map[string]interface{}{
+		"title":   revision.Title,
+		"content": revision.Content,
+		"excerpt": revision.Excerpt,
+		"version": gorm.Expr("version + 1"),
+	}).Error; err != nil {
+		tx.Rollback()
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to restore revision")
+		return
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to restore revision")
+		return
+	}
+
+	// Fetch restored blog
+	if err := requestDB(c, h.db).First(&blog, id).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch updated blog post")
+		return
+	}
+
+	h.invalidateListCache()
+	h.webhooks.Dispatch(webhooks.EventBlogUpdated, blog.ToResponse(false))
+
+	response := blog.ToResponse(true)
+	c.Header("ETag", blogETag(blog))
+	c.JSON(http.StatusOK, response)
+}
+
+// GetRevisionDiff handles GET /api/v1/blogs/:id/revisions/diff
+// @Summary Diff two revisions of a blog post
+// @Description Compare the title, excerpt and content of two saved revisions line by line; content lines are block elements such as paragraphs
+// @Tags revisions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Param from query int true "Older revision version"
+// @Param to query int true "Newer revision version"
+// @Success 200 {object} models.RevisionDiffResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/revisions/diff [get]
+func (h *BlogHandler) GetRevisionDiff(c *gin.Context) {
+	id, err := blogIDParam(c)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	from, fromErr := strconv.Atoi(c.Query("from"))
+	to, toErr := strconv.Atoi(c.Query("to"))
+	if fromErr != nil || toErr != nil || from < 1 || to < 1 {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid revision version", "from and to must be revision versions, e.g. from=2&to=5")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id").First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	var revisions []models.BlogRevision
+	if err := requestDB(c, h.db).Where("blog_id = ? AND version IN (?)", blog.ID, []int{from, to}).
+		Find(&revisions).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch revisions")
+		return
+	}
+	byVersion := make(map[int]models.BlogRevision, len(revisions))
+	for _, revision := range revisions {
+		byVersion[revision.Version] = revision
+	}
+
+	for _, version := range []int{from, to} {
+		if _, ok := byVersion[version]; !ok {
+			apierror.Respond(c, http.StatusNotFound, "Revision not found", "No revision "+strconv.Itoa(version)+" is saved for this post")
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, models.DiffRevisions(byVersion[from], byVersion[to]))
+}
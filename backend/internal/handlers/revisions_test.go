@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/models"
+)
+
+// revisionRouter registers the update and revision routes of h
+func revisionRouter(h *BlogHandler) *gin.Engine {
+	router := gin.New()
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	router.GET("/blogs/:slug/revisions", requireTestAPIKey(), h.GetRevisions)
+	router.GET("/blogs/:slug/revisions/diff", requireTestAPIKey(), h.GetRevisionDiff)
+	router.POST("/blogs/:id/revisions/:version/restore", requireTestAPIKey(), h.RestoreRevision)
+	return router
+}
+
+// editBlog updates fields of a post, skipping the version check
+func editBlog(t *testing.T, router *gin.Engine, id uint, fields gin.H) {
+	t.Helper()
+	w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(id), body: fields,
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+}
+
+func TestRevisionsRecordEditsAndRestore(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := revisionRouter(h)
+	blog := createTestBlog(t, db, models.Blog{Title: "First title"})
+
+	editBlog(t, router, blog.ID, gin.H{"title": "Second title"})
+	editBlog(t, router, blog.ID, gin.H{"title": "Third title"})
+
+	path := "/blogs/" + itoa(blog.ID) + "/revisions"
+	expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: path}), http.StatusUnauthorized)
+	w := serve(router, testRequest{method: http.MethodGet, path: path, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var revisions []models.BlogRevisionResponse
+	decode(t, w, &revisions)
+	if len(revisions) != 2 {
+		t.Fatalf("got %d revisions, want 2: %+v", len(revisions), revisions)
+	}
+	if revisions[0].Version != 2 || revisions[0].Title != "Second title" ||
+		revisions[1].Version != 1 || revisions[1].Title != "First title" {
+		t.Errorf("revisions = %+v, want version 2 Second title then version 1 First title", revisions)
+	}
+
+	expectStatus(t, serve(router, testRequest{method: http.MethodPost, path: path + "/1/restore"}), http.StatusUnauthorized)
+	w = serve(router, testRequest{method: http.MethodPost, path: path + "/1/restore", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var restored models.BlogResponse
+	decode(t, w, &restored)
+	if restored.Title != "First title" {
+		t.Errorf("restored title = %q, want %q", restored.Title, "First title")
+	}
+
+	// The restore snapshots the state it replaced
+	var latest models.BlogRevision
+	db.Where("blog_id = ?", blog.ID).Order("version DESC").First(&latest)
+	if latest.Version != 3 || latest.Title != "Third title" {
+		t.Errorf("latest revision = version %d %q, want version 3 %q", latest.Version, latest.Title, "Third title")
+	}
+
+	w = serve(router, testRequest{method: http.MethodPost, path: path + "/9/restore", apiKey: true})
+	expectStatus(t, w, http.StatusNotFound)
+}
+
+func TestRevisionsPruneBeyondMaxRevisions(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.MaxRevisions = 2 })
+	router := revisionRouter(h)
+	blog := createTestBlog(t, db, models.Blog{Title: "Version 1"})
+
+	for _, title := range []string{"Version 2", "Version 3", "Version 4"} {
+		editBlog(t, router, blog.ID, gin.H{"title": title})
+	}
+
+	var revisions []models.BlogRevision
+	db.Where("blog_id = ?", blog.ID).Order("version").Find(&revisions)
+	if len(revisions) != 2 || revisions[0].Version != 2 || revisions[1].Version != 3 {
+		t.Errorf("kept revisions = %+v, want versions 2 and 3", revisions)
+	}
+}
+
+func TestGetRevisionDiff(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := revisionRouter(h)
+	added := "<p>Captions help readers in noisy places as well as deaf readers.</p>"
+	blog := createTestBlog(t, db, models.Blog{Title: "Captions"})
+
+	// Each edit snapshots the state it replaces: version 1 is the original post
+	editBlog(t, router, blog.ID, gin.H{"content": testContent + added})
+	editBlog(t, router, blog.ID, gin.H{"title": "Captions again"})
+
+	path := "/blogs/" + itoa(blog.ID) + "/revisions/diff"
+	expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: path + "?from=1&to=2"}), http.StatusUnauthorized)
+	w := serve(router, testRequest{method: http.MethodGet, path: path + "?from=1&to=2", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var diff models.RevisionDiffResponse
+	decode(t, w, &diff)
+	if diff.From != 1 || diff.To != 2 || diff.Title.Changed || diff.Excerpt.Changed || !diff.Content.Changed {
+		t.Errorf("diff = %+v, want only the content of versions 1 and 2 changed", diff)
+	}
+	want := []models.DiffLine{{Op: models.DiffEqual, Text: testContent}, {Op: models.DiffInsert, Text: added}}
+	if !reflect.DeepEqual(diff.Content.Lines, want) {
+		t.Errorf("content lines = %+v, want %+v", diff.Content.Lines, want)
+	}
+
+	for query, status := range map[string]int{
+		"?from=1&to=9": http.StatusNotFound,
+		"?from=1":      http.StatusBadRequest,
+		"?from=0&to=2": http.StatusBadRequest,
+		"?from=a&to=2": http.StatusBadRequest,
+	} {
+		w := serve(router, testRequest{method: http.MethodGet, path: path + query, apiKey: true})
+		if w.Code != status {
+			t.Errorf("%s: status = %d, want %d", query, w.Code, status)
+		}
+	}
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/999/revisions/diff?from=1&to=2", apiKey: true})
+	expectStatus(t, w, http.StatusNotFound)
+}
+
+func TestRestoreRevisionRecomputesReadingTime(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := revisionRouter(h)
+	long := "<p>" + strings.Repeat("accessible ", 900) + "</p>"
+	blog := createTestBlog(t, db, models.Blog{Title: "Long read", Content: long})
+	editBlog(t, router, blog.ID, gin.H{"content": testContent})
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/" + itoa(blog.ID) + "/revisions/1/restore", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var restored models.BlogResponse
+	decode(t, w, &restored)
+
+	// The update hook derives reading time from the restored content
+	want := models.CalculateReadingTime(long)
+	var stored models.Blog
+	db.First(&stored, blog.ID)
+	if want <= models.CalculateReadingTime(testContent) || restored.ReadingTime != want || stored.ReadingTime != want {
+		t.Errorf("reading time = %d in the response, %d stored; want %d", restored.ReadingTime, stored.ReadingTime, want)
+	}
+}
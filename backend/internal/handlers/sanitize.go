@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// PreviewSanitizedContent handles POST /api/v1/blogs/sanitize-preview
+// @Summary Preview sanitized content
+// @Description Run raw content through the HTML sanitizer without saving it, returning the HTML that would be stored and the elements and attributes removed
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param content body models.SanitizePreviewRequest true "Raw content"
+// @Success 200 {object} models.SanitizePreviewResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Router /blogs/sanitize-preview [post]
+func (h *BlogHandler) PreviewSanitizedContent(c *gin.Context) {
+	var req models.SanitizePreviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	content, removed := models.SanitizeHTMLReport(req.Content)
+	c.JSON(http.StatusOK, models.SanitizePreviewResponse{HTML: content, Removed: removed})
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestPreviewSanitizedContent(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs/sanitize-preview", requireTestAPIKey(), h.PreviewSanitizedContent)
+	content := `<h2>Heading</h2><p>Kept <a href="https://example.com/">link</a></p><script>alert(1)</script>`
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/sanitize-preview", body: map[string]string{"content": content}})
+	expectStatus(t, w, http.StatusUnauthorized)
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs/sanitize-preview", body: map[string]string{}, apiKey: true})
+	expectStatus(t, w, http.StatusBadRequest)
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs/sanitize-preview", body: map[string]string{"content": content}, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.SanitizePreviewResponse
+	decode(t, w, &resp)
+	if want := `<h2>Heading</h2><p>Kept <a href="https://example.com/">link</a></p>`; resp.HTML != want {
+		t.Errorf("html = %q, want %q", resp.HTML, want)
+	}
+	if strings.Contains(resp.HTML, "alert") {
+		t.Errorf("script content survived: %q", resp.HTML)
+	}
+	want := models.SanitizerRemoval{Element: "script", Reason: models.RemovedWithContent, Count: 1}
+	if len(resp.Removed) != 1 || resp.Removed[0] != want {
+		t.Errorf("removed = %+v, want only %+v", resp.Removed, want)
+	}
+
+	// Previewing saves nothing
+	var count int
+	db.Model(&models.Blog{}).Count(&count)
+	if count != 0 {
+		t.Errorf("blogs after preview = %d, want 0", count)
+	}
+}
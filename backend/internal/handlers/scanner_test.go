@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+	"technoprise-blog-backend/internal/scanner"
+)
+
+// flaggedContent holds an email address and a card-like number, the first
+// with an HTML entity the scanner must see through
+const flaggedContent = "<p>Reach me at jane&#64;example.com or pay with 4111 1111 1111 1111. " + testContent + "</p>"
+
+// newScanningHandler returns a test handler running the default content scanner
+func newScanningHandler(t *testing.T) (*BlogHandler, *gin.Engine) {
+	t.Helper()
+	h, _ := newTestHandler(t, nil)
+	contentScanner, err := scanner.New(scanner.DefaultConfig())
+	if err != nil {
+		t.Fatalf("scanner.New: %v", err)
+	}
+	h.scanner = contentScanner
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	return h, router
+}
+
+func TestCreateBlogContentScan(t *testing.T) {
+	_, router := newScanningHandler(t)
+	want := []scanner.Finding{
+		{Kind: scanner.KindEmail, Match: "jane@example.com"},
+		{Kind: scanner.KindCreditCard, Match: "4111 1111 1111 1111"},
+	}
+	post := func(query, title string) *httptest.ResponseRecorder {
+		return serve(router, testRequest{method: http.MethodPost, path: "/blogs" + query, body: gin.H{
+			"title": title, "content": flaggedContent, "author": "Test Author",
+		}})
+	}
+
+	// Strict mode rejects the post and lists the findings
+	w := post("?strict=true", "Flagged strictly")
+	expectStatus(t, w, http.StatusUnprocessableEntity)
+	var rejected struct {
+		ContentWarnings []scanner.Finding `json:"content_warnings"`
+	}
+	decode(t, w, &rejected)
+	if !reflect.DeepEqual(rejected.ContentWarnings, want) {
+		t.Errorf("strict content_warnings = %+v, want %+v", rejected.ContentWarnings, want)
+	}
+
+	// Otherwise the post is created with warnings
+	w = post("", "Flagged post")
+	expectStatus(t, w, http.StatusCreated)
+	var created models.CreateBlogResponse
+	decode(t, w, &created)
+	if !reflect.DeepEqual(created.ContentWarnings, want) {
+		t.Errorf("content_warnings = %+v, want %+v", created.ContentWarnings, want)
+	}
+
+	// Clean content carries no warnings
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs?strict=true", body: gin.H{
+		"title": "Clean post", "content": testContent, "author": "Test Author",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var clean map[string]interface{}
+	decode(t, w, &clean)
+	if _, ok := clean["content_warnings"]; ok {
+		t.Errorf("clean post has content_warnings: %v", clean["content_warnings"])
+	}
+}
+
+func TestUpdateBlogContentScan(t *testing.T) {
+	h, router := newScanningHandler(t)
+	blog := createTestBlog(t, h.db, models.Blog{Title: "Clean post"})
+	path := "/blogs/" + itoa(blog.ID)
+	ifMatch := map[string]string{"If-Match": "*"}
+
+	// Only the fields sent are scanned: the title carries the email here
+	w := serve(router, testRequest{method: http.MethodPut, path: path + "?strict=true", body: gin.H{"title": "Mail a@example.com"}, headers: ifMatch})
+	expectStatus(t, w, http.StatusUnprocessableEntity)
+	var stored models.Blog
+	h.db.First(&stored, blog.ID)
+	if stored.Title != "Clean post" {
+		t.Errorf("strict rejection still saved the title %q", stored.Title)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPut, path: path, body: gin.H{"content": flaggedContent}, headers: ifMatch})
+	expectStatus(t, w, http.StatusOK)
+	var updated models.UpdateBlogResponse
+	decode(t, w, &updated)
+	if len(updated.ContentWarnings) != 2 {
+		t.Errorf("content_warnings = %+v, want the email and card", updated.ContentWarnings)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPut, path: path + "?strict=true", body: gin.H{"excerpt": "A new excerpt"}, headers: ifMatch})
+	expectStatus(t, w, http.StatusOK)
+}
+
+func TestContentScanDisabled(t *testing.T) {
+	h, _ := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs?strict=true", body: gin.H{
+		"title": "Unscanned post", "content": flaggedContent, "author": "Test Author",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+}
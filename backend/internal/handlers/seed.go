@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/database"
+	"technoprise-blog-backend/internal/models"
+)
+
+// GetSeedStatus handles GET /api/v1/admin/seed-status
+// @Summary Get the sample data status
+// @Description Report whether the sample blog posts were seeded, how many of them remain and whether SEED_DATABASE enables seeding at startup
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} models.SeedStatus
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/seed-status [get]
+func (h *BlogHandler) GetSeedStatus(c *gin.Context) {
+	status, err := database.GetSeedStatus(requestDB(c, h.db))
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch seed status")
+		return
+	}
+	status.SeedingEnabled = h.seedEnabled
+
+	c.JSON(http.StatusOK, status)
+}
+
+// SeedDatabase handles POST /api/v1/admin/seed
+// @Summary Seed the sample blog posts
+// @Description Create the sample blog posts in an empty database. With force=true posts may exist, and only the sample posts whose slugs are free are created.
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param force query bool false "Seed even though posts exist"
+// @Success 200 {object} models.SeedResponse
+// @Failure 401 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/seed [post]
+func (h *BlogHandler) SeedDatabase(c *gin.Context) {
+	force, _ := strconv.ParseBool(c.Query("force"))
+
+	created, skipped, err := database.Seed(requestDB(c, h.db), force)
+	if err == database.ErrSeedNotEmpty {
+		apierror.Respond(c, http.StatusConflict, "Database already contains blog posts", "pass force=true to add the missing sample posts")
+		return
+	}
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to seed database")
+		return
+	}
+
+	if created > 0 {
+		h.invalidateListCache()
+	}
+	c.JSON(http.StatusOK, models.SeedResponse{Created: created, Skipped: skipped})
+}
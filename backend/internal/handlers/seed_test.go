@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/database"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestSeedStatusAndExplicitSeed(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) {
+		cfg.SeedDatabase = true
+		cfg.ListCacheTTL = time.Minute
+	})
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	router.GET("/admin/seed-status", requireTestAPIKey(), h.GetSeedStatus)
+	router.POST("/admin/seed", requireTestAPIKey(), h.SeedDatabase)
+	samples := len(database.SeedSlugs())
+
+	status := func() models.SeedStatus {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/admin/seed-status", apiKey: true})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.SeedStatus
+		decode(t, w, &resp)
+		return resp
+	}
+	seed := func(query string, want int) models.SeedResponse {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodPost, path: "/admin/seed" + query, apiKey: true})
+		expectStatus(t, w, want)
+		var resp models.SeedResponse
+		if want == http.StatusOK {
+			decode(t, w, &resp)
+		}
+		return resp
+	}
+
+	expectStatus(t, serve(router, testRequest{method: http.MethodGet, path: "/admin/seed-status"}), http.StatusUnauthorized)
+	expectStatus(t, serve(router, testRequest{method: http.MethodPost, path: "/admin/seed"}), http.StatusUnauthorized)
+
+	if got := status(); got.Seeded || !got.SeedingEnabled || got.SeedPosts != samples || got.TotalPosts != 0 {
+		t.Errorf("initial status = %+v, want unseeded with seeding enabled", got)
+	}
+
+	// Fill the list cache so seeding has to invalidate it
+	listTitles(t, router, "/blogs")
+	if resp := seed("", http.StatusOK); resp.Created != samples || resp.Skipped != 0 {
+		t.Errorf("seed = %+v, want %d created", resp, samples)
+	}
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs"})
+	if cache := w.Header().Get("X-Cache"); cache != "MISS" {
+		t.Errorf("X-Cache after seeding = %q, want MISS", cache)
+	}
+	if got := status(); !got.Seeded || got.SeededAt == nil || got.RemainingSeedPosts != samples || got.TotalPosts != samples {
+		t.Errorf("seeded status = %+v, want all %d sample posts", got, samples)
+	}
+
+	// Posts exist, so seeding needs force, which skips every slug in use
+	seed("", http.StatusConflict)
+	if resp := seed("?force=true", http.StatusOK); resp.Created != 0 || resp.Skipped != samples {
+		t.Errorf("forced seed = %+v, want all %d skipped", resp, samples)
+	}
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs"})
+	if cache := w.Header().Get("X-Cache"); cache != "HIT" {
+		t.Errorf("X-Cache after a seed creating nothing = %q, want HIT", cache)
+	}
+
+	var count int
+	db.Model(&models.Blog{}).Count(&count)
+	if count != samples {
+		t.Errorf("posts = %d, want %d", count, samples)
+	}
+}
+
+func TestSeedStatusSeedingDisabled(t *testing.T) {
+	h, _ := newTestHandler(t, func(cfg *config.Blog) { cfg.SeedDatabase = false })
+	router := gin.New()
+	router.GET("/admin/seed-status", h.GetSeedStatus)
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/admin/seed-status"})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.SeedStatus
+	decode(t, w, &resp)
+	if resp.SeedingEnabled {
+		t.Error("seeding_enabled = true, want false")
+	}
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// GetSeries handles GET /api/v1/series/:slug
+// @Summary Get a post series
+// @Description Retrieve a series with its published posts in reading order
+// @Tags series
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Success 200 {object} models.SeriesResponse
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /series/{slug} [get]
+func (h *BlogHandler) GetSeries(c *gin.Context) {
+	var series models.Series
+	if err := requestDB(c, h.db).Where("slug = ?", models.CanonicalSlug(c.Param("slug"))).First(&series).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Series not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch series")
+		return
+	}
+
+	posts, err := h.seriesPosts(c, series.ID)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch series")
+		return
+	}
+
+	response := models.SeriesResponse{
+		ID:          series.ID,
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
+		Posts:       make([]models.SeriesPost, len(posts)),
+	}
+	for i, post := range posts {
+		response.Posts[i] = models.SeriesPost{
+			Slug:        post.Slug,
+			Title:       post.Title,
+			Order:       i + 1,
+			PublishedAt: post.PublishedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateSeries handles POST /api/v1/series
+// @Summary Create a post series
+// @Description Create an empty series; posts join it through series_id on create or update
+// @Tags series
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param series body models.CreateSeriesRequest true "Series data"
+// @Success 201 {object} models.Series
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /series [post]
+func (h *BlogHandler) CreateSeries(c *gin.Context) {
+	var req models.CreateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	title := models.SanitizeString(req.Title)
+	slug := models.GenerateSlug(title)
+	if slug == "" {
+		apierror.Respond(c, http.StatusBadRequest, "Title does not produce a valid slug")
+		return
+	}
+
+	var count int
+	if err := requestDB(c, h.db).Model(&models.Series{}).Where("slug = ?", slug).Count(&count).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to create series")
+		return
+	}
+	if count > 0 {
+		apierror.RespondWith(c, http.StatusConflict, "A series with this slug already exists", gin.H{"conflicting_slug": slug})
+		return
+	}
+
+	series := models.Series{
+		Title:       title,
+		Slug:        slug,
+		Description: models.SanitizeString(req.Description),
+	}
+	if err := requestDB(c, h.db).Create(&series).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to create series")
+		return
+	}
+
+	c.JSON(http.StatusCreated, series)
+}
+
+// seriesPosts loads the published posts of a series in reading order
+func (h *BlogHandler) seriesPosts(c *gin.Context, seriesID uint) ([]models.Blog, error) {
+	var posts []models.Blog
+	err := requestDB(c, h.db).Select("id, slug, title, published_at").
+		Where("series_id = ? AND published = ?", seriesID, true).
+		Order(models.SeriesOrdering).
+		Find(&posts).Error
+	return posts, err
+}
+
+// seriesContext places a published post within its series, or returns nil
+// when it has none. Lookup failures are recorded but never fail the request.
+func (h *BlogHandler) seriesContext(c *gin.Context, blog models.Blog) *models.SeriesContext {
+	if blog.SeriesID == nil || !blog.Published {
+		return nil
+	}
+
+	var series models.Series
+	if err := requestDB(c, h.db).Select("id, slug, title").First(&series, *blog.SeriesID).Error; err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			c.Error(err)
+		}
+		return nil
+	}
+
+	posts, err := h.seriesPosts(c, series.ID)
+	if err != nil {
+		c.Error(err)
+		return nil
+	}
+
+	for i, post := range posts {
+		if post.ID != blog.ID {
+			continue
+		}
+		context := &models.SeriesContext{
+			Slug:     series.Slug,
+			Title:    series.Title,
+			Position: i + 1,
+			Total:    len(posts),
+		}
+		if i > 0 {
+			context.Prev = &models.AdjacentPost{Slug: posts[i-1].Slug, Title: posts[i-1].Title}
+		}
+		if i+1 < len(posts) {
+			context.Next = &models.AdjacentPost{Slug: posts[i+1].Slug, Title: posts[i+1].Title}
+		}
+		return context
+	}
+	return nil
+}
+
+// resolveSeriesOrder checks that a series exists and returns the position a
+// post takes in it: order when given, otherwise after the series' last post.
+// An error response is written when the series does not exist.
+func (h *BlogHandler) resolveSeriesOrder(c *gin.Context, seriesID uint, order int) (int, bool) {
+	var count int
+	if err := requestDB(c, h.db).Model(&models.Series{}).Where("id = ?", seriesID).Count(&count).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch series")
+		return 0, false
+	}
+	if count == 0 {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid series", "series_id must be the ID of an existing series")
+		return 0, false
+	}
+	if order > 0 {
+		return order, true
+	}
+
+	var last struct{ Max int }
+	if err := requestDB(c, h.db).Model(&models.Blog{}).Select("COALESCE(MAX(series_order), 0) AS max").
+		Where("series_id = ?", seriesID).
+		Scan(&last).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch series")
+		return 0, false
+	}
+	return last.Max + 1, true
+}
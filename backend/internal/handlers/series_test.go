@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+// seriesRouter serves the series routes and the blog routes that place posts in a series
+func seriesRouter(h *BlogHandler) *gin.Engine {
+	router := gin.New()
+	router.POST("/series", requireTestAPIKey(), h.CreateSeries)
+	router.GET("/series/:slug", h.GetSeries)
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	return router
+}
+
+// seriesSlugs returns the slugs of a series' posts in the order the API lists them
+func seriesSlugs(t *testing.T, router *gin.Engine, slug string) []string {
+	t.Helper()
+	w := serve(router, testRequest{method: http.MethodGet, path: "/series/" + slug})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.SeriesResponse
+	decode(t, w, &resp)
+	slugs := make([]string, len(resp.Posts))
+	for i, post := range resp.Posts {
+		if post.Order != i+1 {
+			t.Errorf("post %s has order %d, want %d", post.Slug, post.Order, i+1)
+		}
+		slugs[i] = post.Slug
+	}
+	return slugs
+}
+
+func TestCreateSeries(t *testing.T) {
+	h, _ := newTestHandler(t, nil)
+	router := seriesRouter(h)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/series", body: gin.H{"title": "Accessibility Guide"}})
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/series", body: gin.H{
+		"title": "Accessibility Guide", "description": "A three-part guide",
+	}, apiKey: true})
+	expectStatus(t, w, http.StatusCreated)
+	var series models.Series
+	decode(t, w, &series)
+	if series.ID == 0 || series.Slug != "accessibility-guide" || series.Description != "A three-part guide" {
+		t.Errorf("series = %+v", series)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/series", body: gin.H{"title": "accessibility guide!"}, apiKey: true})
+	expectStatus(t, w, http.StatusConflict)
+	w = serve(router, testRequest{method: http.MethodPost, path: "/series", body: gin.H{"title": ""}, apiKey: true})
+	expectStatus(t, w, http.StatusBadRequest)
+
+	// An empty series lists no posts
+	if slugs := seriesSlugs(t, router, "Accessibility-Guide"); len(slugs) != 0 {
+		t.Errorf("new series posts = %q, want none", slugs)
+	}
+	w = serve(router, testRequest{method: http.MethodGet, path: "/series/missing"})
+	expectStatus(t, w, http.StatusNotFound)
+}
+
+func TestSeriesOrdering(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := seriesRouter(h)
+	guide := models.Series{Title: "Guide", Slug: "guide"}
+	db.Create(&guide)
+
+	create := func(title string, extra gin.H) uint {
+		t.Helper()
+		body := gin.H{"title": title, "content": "<p>" + title + ": " + testContent + "</p>", "author": "Test Author", "published": true, "series_id": guide.ID}
+		for k, v := range extra {
+			body[k] = v
+		}
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: body})
+		expectStatus(t, w, http.StatusCreated)
+		var created models.CreateBlogResponse
+		decode(t, w, &created)
+		return created.ID
+	}
+
+	// Without an order each post is appended after the last part
+	create("Part one", nil)
+	partTwo := create("Part two", nil)
+	create("Part three", nil)
+	// Drafts keep their place but are not listed
+	create("Part four draft", gin.H{"published": false})
+	if want := []string{"part-one", "part-two", "part-three"}; !reflect.DeepEqual(seriesSlugs(t, router, "guide"), want) {
+		t.Errorf("appended order = %q, want %q", seriesSlugs(t, router, "guide"), want)
+	}
+	var draft models.Blog
+	db.Where("slug = ?", "part-four-draft").First(&draft)
+	if draft.SeriesOrder != 4 {
+		t.Errorf("draft series_order = %d, want 4", draft.SeriesOrder)
+	}
+
+	// An explicit order slots a post in; ties go to the earlier publish date
+	create("Introduction", gin.H{"series_order": 1})
+	want := []string{"part-one", "introduction", "part-two", "part-three"}
+	if got := seriesSlugs(t, router, "guide"); !reflect.DeepEqual(got, want) {
+		t.Errorf("order with a tie = %q, want %q", got, want)
+	}
+
+	// Moving a post to the end, then out of the series
+	w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(partTwo), body: gin.H{"series_order": 10},
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	want = []string{"part-one", "introduction", "part-three", "part-two"}
+	if got := seriesSlugs(t, router, "guide"); !reflect.DeepEqual(got, want) {
+		t.Errorf("order after moving part two = %q, want %q", got, want)
+	}
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(partTwo), body: gin.H{"series_id": 0},
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	want = []string{"part-one", "introduction", "part-three"}
+	if got := seriesSlugs(t, router, "guide"); !reflect.DeepEqual(got, want) {
+		t.Errorf("order after removing part two = %q, want %q", got, want)
+	}
+
+	// Unknown series and orders without a series are rejected
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Orphan", "content": "<p>Orphan: " + testContent + "</p>", "author": "Test Author", "series_id": 999,
+	}})
+	expectStatus(t, w, http.StatusBadRequest)
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(partTwo), body: gin.H{"series_order": 2},
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusBadRequest)
+}
+
+func TestGetBlogBySlugSeriesNavigation(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := seriesRouter(h)
+	guide := models.Series{Title: "Guide", Slug: "guide"}
+	db.Create(&guide)
+	inSeries := func(title string, order int, published bool) {
+		createTestBlog(t, db, models.Blog{Title: title, SeriesID: &guide.ID, SeriesOrder: order, Published: published, PublishedAt: timeAt(1, order)})
+	}
+	inSeries("Part three", 3, true)
+	inSeries("Part one", 1, true)
+	inSeries("Part two draft", 2, false)
+	inSeries("Part two", 2, true)
+	createTestBlog(t, db, models.Blog{Title: "Standalone", Published: true})
+
+	seriesOf := func(slug string) *models.SeriesContext {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + slug})
+		expectStatus(t, w, http.StatusOK)
+		var resp models.BlogDetailResponse
+		decode(t, w, &resp)
+		return resp.Series
+	}
+	link := func(slug, title string) *models.AdjacentPost { return &models.AdjacentPost{Slug: slug, Title: title} }
+
+	tests := []struct {
+		slug string
+		want models.SeriesContext
+	}{
+		{"part-one", models.SeriesContext{Slug: "guide", Title: "Guide", Position: 1, Total: 3, Next: link("part-two", "Part two")}},
+		{"part-two", models.SeriesContext{Slug: "guide", Title: "Guide", Position: 2, Total: 3, Prev: link("part-one", "Part one"), Next: link("part-three", "Part three")}},
+		{"part-three", models.SeriesContext{Slug: "guide", Title: "Guide", Position: 3, Total: 3, Prev: link("part-two", "Part two")}},
+	}
+	for _, tt := range tests {
+		got := seriesOf(tt.slug)
+		if got == nil || !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("%s: series = %+v, want %+v", tt.slug, got, tt.want)
+		}
+	}
+	if got := seriesOf("standalone"); got != nil {
+		t.Errorf("standalone post series = %+v, want none", got)
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// errSlugExhausted is returned when no numbered slug variant is free
+var errSlugExhausted = errors.New("no available slug variant")
+
+// maxSlugSuffix bounds the numbered variants tried before giving up on a slug
+const maxSlugSuffix = 1000
+
+// slugTaken reports whether any post other than excludeID, including
+// soft-deleted ones which keep their slug, already uses slug. An excludeID of 0
+// checks every post.
+func (h *BlogHandler) slugTaken(c *gin.Context, slug string, excludeID uint) (bool, error) {
+	var count int64
+	if err := requestDB(c, h.db).Unscoped().Model(&models.Blog{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// availableSlug returns base if it is free, otherwise the first free numbered
+// variant such as "base-2". Slugs used by excludeID count as free, so a post
+// keeps its own slug.
+func (h *BlogHandler) availableSlug(c *gin.Context, base string, excludeID uint) (string, error) {
+	taken, err := h.slugTaken(c, base, excludeID)
+	if err != nil || !taken {
+		return base, err
+	}
+
+	for suffix := 2; suffix <= maxSlugSuffix; suffix++ {
+		// Shorten the base so the suffixed slug stays within the length cap
+		ending := "-" + strconv.Itoa(suffix)
+		candidate := models.TruncateSlug(base, models.SlugMaxLength()-len(ending)) + ending
+		taken, err := h.slugTaken(c, candidate, excludeID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+
+	return "", errSlugExhausted
+}
+
+// PreviewSlug handles GET /api/v1/blogs/slug-preview
+// @Summary Preview the slug for a title
+// @Description Return the slug a title would produce, whether it is taken, and the next available variant without creating anything
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param title query string true "Post title"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/slug-preview [get]
+func (h *BlogHandler) PreviewSlug(c *gin.Context) {
+	title := strings.TrimSpace(c.Query("title"))
+	if title == "" {
+		apierror.Respond(c, http.StatusBadRequest, "Title is required")
+		return
+	}
+
+	slug := models.GenerateSlug(models.SanitizeString(title))
+	if slug == "" {
+		apierror.Respond(c, http.StatusBadRequest, "Title does not produce a valid slug")
+		return
+	}
+
+	taken, err := h.slugTaken(c, slug, 0)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to check slug availability")
+		return
+	}
+
+	available, err := h.availableSlug(c, slug, 0)
+	if err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to find an available slug")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"slug":      slug,
+		"taken":     taken,
+		"available": available,
+	})
+}
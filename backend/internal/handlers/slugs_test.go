@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestPreviewSlug(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/slug-preview", h.PreviewSlug)
+
+	createTestBlog(t, db, models.Blog{Title: "Taken title"})
+	deleted := createTestBlog(t, db, models.Blog{Title: "Deleted title"})
+	if err := db.Delete(&deleted).Error; err != nil {
+		t.Fatalf("delete blog: %v", err)
+	}
+
+	tests := []struct {
+		title     string
+		slug      string
+		taken     bool
+		available string
+	}{
+		{"Fresh title", "fresh-title", false, "fresh-title"},
+		{"Taken title", "taken-title", true, "taken-title-2"},
+		{"Deleted title", "deleted-title", true, "deleted-title-2"},
+		{"Café Crème über alles", "cafe-creme-uber-alles", false, "cafe-creme-uber-alles"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/slug-preview?title=" + url.QueryEscape(tt.title)})
+			expectStatus(t, w, http.StatusOK)
+			var resp struct {
+				Slug      string `json:"slug"`
+				Taken     bool   `json:"taken"`
+				Available string `json:"available"`
+			}
+			decode(t, w, &resp)
+			if resp.Slug != tt.slug || resp.Taken != tt.taken || resp.Available != tt.available {
+				t.Errorf("got %+v, want slug %q taken %v available %q", resp, tt.slug, tt.taken, tt.available)
+			}
+		})
+	}
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/slug-preview?title=%20%20"})
+	expectStatus(t, w, http.StatusBadRequest)
+}
+
+func TestUpdateBlogRetitleAvoidsSlugCollisions(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.PUT("/blogs/:id", h.UpdateBlog)
+
+	createTestBlog(t, db, models.Blog{Title: "Existing title"})
+	deleted := createTestBlog(t, db, models.Blog{Title: "Removed title", Content: "<p>Removed post content that is long enough to be a valid post body.</p>"})
+	if err := db.Delete(&deleted).Error; err != nil {
+		t.Fatalf("delete blog: %v", err)
+	}
+	blog := createTestBlog(t, db, models.Blog{Title: "Original title", Content: "<p>Original post content that is long enough to be a valid post body.</p>"})
+
+	tests := []struct {
+		title string
+		slug  string
+	}{
+		{"Existing title", "existing-title-2"},
+		{"Removed title", "removed-title-2"},
+		{"Original title", "original-title"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			var current models.Blog
+			db.First(&current, blog.ID)
+			w := serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(blog.ID), body: gin.H{
+				"title":   tt.title,
+				"version": current.Version,
+			}})
+			expectStatus(t, w, http.StatusOK)
+			var updated models.BlogResponse
+			decode(t, w, &updated)
+			if updated.Slug != tt.slug {
+				t.Errorf("slug = %q, want %q", updated.Slug, tt.slug)
+			}
+		})
+	}
+}
+
+func TestGetBlogBySlugCanonicalRedirects(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	createTestBlog(t, db, models.Blog{Title: "My Slug", Published: true})
+	createTestBlog(t, db, models.Blog{Title: "Hidden Draft"})
+
+	// follow requests path and follows redirects, returning the final response and the hops taken
+	follow := func(path string) (*httptest.ResponseRecorder, []string) {
+		hops := []string{}
+		for i := 0; i < 3; i++ {
+			w := serve(router, testRequest{method: http.MethodGet, path: path})
+			if w.Code != http.StatusMovedPermanently {
+				return w, hops
+			}
+			path = w.Header().Get("Location")
+			hops = append(hops, path)
+		}
+		t.Fatalf("redirect loop: %v", hops)
+		return nil, nil
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/blogs/MY-SLUG", "/blogs/my-slug"},
+		{"/blogs/My-Slug", "/blogs/my-slug"},
+		{"/blogs/my-slug/", "/blogs/my-slug"},
+		{"/blogs/My-Slug/", "/blogs/my-slug"},
+		{"/blogs/MY-SLUG?fields=title", "/blogs/my-slug?fields=title"},
+	}
+	for _, tt := range tests {
+		w, hops := follow(tt.path)
+		expectStatus(t, w, http.StatusOK)
+		if len(hops) == 0 || hops[len(hops)-1] != tt.want {
+			t.Errorf("%s: redirected via %v, want to end at %s", tt.path, hops, tt.want)
+		}
+	}
+
+	if w, hops := follow("/blogs/my-slug"); w.Code != http.StatusOK || len(hops) != 0 {
+		t.Errorf("canonical slug: status %d via %v, want 200 without redirects", w.Code, hops)
+	}
+	for _, path := range []string{"/blogs/NO-SUCH-POST", "/blogs/HIDDEN-DRAFT"} {
+		if w := serve(router, testRequest{method: http.MethodGet, path: path}); w.Code != http.StatusNotFound {
+			t.Errorf("%s: status = %d, want 404 without a redirect", path, w.Code)
+		}
+	}
+}
+
+func TestSlugMaxLengthWithSuffix(t *testing.T) {
+	if err := models.SetSlugMaxLength(models.MinSlugMaxLength); err != nil {
+		t.Fatalf("SetSlugMaxLength: %v", err)
+	}
+	t.Cleanup(func() { models.SetSlugMaxLength(models.DefaultSlugMaxLength) })
+
+	h, _ := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/slug-preview", h.PreviewSlug)
+	router.POST("/blogs", h.CreateBlog)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Accessibility rocks today", "content": testContent, "author": "Test Author",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var created models.BlogResponse
+	decode(t, w, &created)
+	if created.Slug != "accessibility-rocks" {
+		t.Errorf("slug = %q, want accessibility-rocks", created.Slug)
+	}
+
+	// The base is shortened so the suffixed slug stays within the cap
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/slug-preview?title=" + url.QueryEscape("Accessibility rocks tomorrow")})
+	expectStatus(t, w, http.StatusOK)
+	var resp struct {
+		Slug      string `json:"slug"`
+		Available string `json:"available"`
+	}
+	decode(t, w, &resp)
+	if resp.Slug != "accessibility-rocks" || resp.Available != "accessibility-rock-2" {
+		t.Errorf("preview = %+v, want slug accessibility-rocks available accessibility-rock-2", resp)
+	}
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/mailer"
+	"technoprise-blog-backend/internal/models"
+)
+
+const (
+	// subscribersPath is the API path under SITE_URL the confirm and unsubscribe links point at
+	subscribersPath = "/api/v1/subscribers"
+
+	// notifyBatchSize is the number of subscribers loaded at a time when announcing a post
+	notifyBatchSize = 500
+)
+
+// Subscribe handles POST /api/v1/subscribers
+// @Summary Subscribe to new posts
+// @Description Request new-post emails; a confirmation link is emailed and nothing else is sent until it is opened
+// @Tags subscribers
+// @Accept json
+// @Produce json
+// @Param subscriber body models.SubscribeRequest true "Email address"
+// @Success 202 {object} models.SubscribeResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 429 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /subscribers [post]
+func (h *BlogHandler) Subscribe(c *gin.Context) {
+	var req models.SubscribeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+
+	email, ok := models.NormalizeEmail(req.Email)
+	if !ok {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid email address", "email must be a single address such as reader@example.com")
+		return
+	}
+
+	var subscriber models.Subscriber
+	err := requestDB(c, h.db).Where("email = ?", email).First(&subscriber).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to create subscription")
+		return
+	}
+
+	if gorm.IsRecordNotFoundError(err) {
+		token, err := models.NewSubscriberToken()
+		if err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to create subscription")
+			return
+		}
+		subscriber = models.Subscriber{Email: email, Token: token}
+		if err := requestDB(c, h.db).Create(&subscriber).Error; err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to create subscription")
+			return
+		}
+	}
+
+	// Repeat requests resend the confirmation; confirmed addresses get nothing
+	if !subscriber.Confirmed {
+		h.mailer.Send(confirmationEmail(subscriber, h.subscribersURL()))
+	}
+
+	c.JSON(http.StatusAccepted, models.SubscribeResponse{Email: email})
+}
+
+// ConfirmSubscription handles GET /api/v1/subscribers/confirm
+// @Summary Confirm a subscription
+// @Description Confirm an email address using the token from the confirmation email
+// @Tags subscribers
+// @Produce json
+// @Param token query string true "Subscription token"
+// @Success 200 {object} models.SubscriberResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /subscribers/confirm [get]
+func (h *BlogHandler) ConfirmSubscription(c *gin.Context) {
+	subscriber, ok := h.findSubscriberByToken(c)
+	if !ok {
+		return
+	}
+
+	if !subscriber.Confirmed {
+		if err := requestDB(c, h.db).Model(&subscriber).UpdateColumn("confirmed", true).Error; err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to confirm subscription")
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, subscriber.ToResponse())
+}
+
+// Unsubscribe handles GET and POST /api/v1/subscribers/unsubscribe
+// @Summary Unsubscribe from new posts
+// @Description Remove a subscriber using the token from any email; POST supports one-click List-Unsubscribe
+// @Tags subscribers
+// @Produce json
+// @Param token query string true "Subscription token"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /subscribers/unsubscribe [get]
+// @Router /subscribers/unsubscribe [post]
+func (h *BlogHandler) Unsubscribe(c *gin.Context) {
+	subscriber, ok := h.findSubscriberByToken(c)
+	if !ok {
+		return
+	}
+
+	if err := requestDB(c, h.db).Delete(&subscriber).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to unsubscribe")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// findSubscriberByToken loads the subscriber named by the token query parameter,
+// writing an error response when it is missing or unknown
+func (h *BlogHandler) findSubscriberByToken(c *gin.Context) (models.Subscriber, bool) {
+	var subscriber models.Subscriber
+	token := strings.TrimSpace(c.Query("token"))
+	if token == "" {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid subscription token")
+		return subscriber, false
+	}
+
+	if err := requestDB(c, h.db).Where("token = ?", token).First(&subscriber).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Subscription not found")
+			return subscriber, false
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch subscription")
+		return subscriber, false
+	}
+	return subscriber, true
+}
+
+// notifySubscribers emails confirmed subscribers about a newly published post.
+// Subscribers are loaded and emails queued in the background so publishing is
+// never held up.
+func (h *BlogHandler) notifySubscribers(blog models.Blog) {
+	if !blog.Published {
+		return
+	}
+	go h.sendNewPostEmails(blog)
+}
+
+// subscribersURL is the absolute base of the confirm and unsubscribe links.
+// Emailed links are built from SITE_URL, never from the request's Host, so a
+// forged Host cannot send a reader's token to another site.
+func (h *BlogHandler) subscribersURL() string {
+	return strings.TrimRight(h.siteURL, "/") + subscribersPath
+}
+
+// sendNewPostEmails queues a new-post email for every confirmed subscriber
+func (h *BlogHandler) sendNewPostEmails(blog models.Blog) {
+	base := h.subscribersURL()
+	var lastID uint
+	for {
+		var subscribers []models.Subscriber
+		if err := h.db.Where("confirmed = ? AND id > ?", true, lastID).
+			Order("id").
+			Limit(notifyBatchSize).
+			Find(&subscribers).Error; err != nil {
+			log.Printf("Failed to load subscribers for post %d: %v", blog.ID, err)
+			return
+		}
+		for _, subscriber := range subscribers {
+			h.mailer.Send(newPostEmail(subscriber, blog, h.siteURL, base))
+		}
+		if len(subscribers) < notifyBatchSize {
+			return
+		}
+		lastID = subscribers[len(subscribers)-1].ID
+	}
+}
+
+// subscriberLink builds a confirm or unsubscribe link carrying the subscriber's token
+func subscriberLink(base, action string, subscriber models.Subscriber) string {
+	return base + "/" + action + "?token=" + url.QueryEscape(subscriber.Token)
+}
+
+// confirmationEmail builds the double opt-in email sent after subscribing
+func confirmationEmail(subscriber models.Subscriber, base string) mailer.Message {
+	return mailer.Message{
+		To:      subscriber.Email,
+		Subject: "Confirm your subscription",
+		Body: "Please confirm that you want to receive an email when a new post is published:\n\n" +
+			subscriberLink(base, "confirm", subscriber) + "\n\n" +
+			"If you did not subscribe, you can ignore this email.\n",
+	}
+}
+
+// newPostEmail builds the announcement sent to a subscriber when a post is published
+func newPostEmail(subscriber models.Subscriber, blog models.Blog, siteURL, base string) mailer.Message {
+	unsubscribeURL := subscriberLink(base, "unsubscribe", subscriber)
+	return mailer.Message{
+		To:             subscriber.Email,
+		Subject:        "New post: " + blog.Title,
+		UnsubscribeURL: unsubscribeURL,
+		Body: blog.Title + "\nby " + blog.Author + "\n\n" +
+			blog.Excerpt + "\n\n" +
+			"Read it at " + models.BlogURL(siteURL, blog.Slug) + "\n\n" +
+			"To stop receiving these emails, unsubscribe at " + unsubscribeURL + "\n",
+	}
+}
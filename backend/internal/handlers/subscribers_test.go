@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/config"
+	"technoprise-blog-backend/internal/mailer"
+	"technoprise-blog-backend/internal/models"
+)
+
+// sentEmail is an email received by the test SMTP server
+type sentEmail struct {
+	To              string
+	Subject         string
+	ListUnsubscribe string
+	Body            string
+}
+
+// startTestSMTP serves just enough SMTP for net/smtp.SendMail and returns a
+// mailer delivering to it, with a channel of the emails it receives
+func startTestSMTP(t *testing.T) (*mailer.Mailer, <-chan sentEmail) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	emails := make(chan sentEmail, 16)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSMTP(t, conn, emails)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return mailer.New(mailer.Config{Host: host, Port: port, From: "blog@example.com"}), emails
+}
+
+// serveTestSMTP handles one SMTP session, decoding each message it is sent
+func serveTestSMTP(t *testing.T, conn net.Conn, emails chan<- sentEmail) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "DATA"):
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			msg, err := mail.ReadMessage(strings.NewReader(data.String()))
+			if err != nil {
+				t.Errorf("parse email: %v", err)
+				return
+			}
+			body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+			if err != nil {
+				t.Errorf("decode email body: %v", err)
+				return
+			}
+			subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+			emails <- sentEmail{
+				To:              msg.Header.Get("To"),
+				Subject:         subject,
+				ListUnsubscribe: msg.Header.Get("List-Unsubscribe"),
+				Body:            string(body),
+			}
+			reply("250 queued")
+		case strings.HasPrefix(command, "QUIT"):
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+// receiveEmail waits for the next email sent to the test SMTP server
+func receiveEmail(t *testing.T, emails <-chan sentEmail) sentEmail {
+	t.Helper()
+	select {
+	case email := <-emails:
+		return email
+	case <-time.After(5 * time.Second):
+		t.Fatal("no email sent")
+		return sentEmail{}
+	}
+}
+
+// expectNoEmail fails the test when an email arrives within a short wait
+func expectNoEmail(t *testing.T, emails <-chan sentEmail) {
+	t.Helper()
+	select {
+	case email := <-emails:
+		t.Fatalf("unexpected email to %s: %s", email.To, email.Subject)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+// spoofedOrigin is a forged Host and scheme emailed links must never use
+var spoofedOrigin = testRequest{host: "attacker.example", headers: map[string]string{"X-Forwarded-Proto": "https"}}
+
+func TestSubscriptionOptIn(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.SiteURL = "https://blog.example.com" })
+	var emails <-chan sentEmail
+	h.mailer, emails = startTestSMTP(t)
+	router := gin.New()
+	router.POST("/api/v1/subscribers", h.Subscribe)
+	router.GET("/api/v1/subscribers/confirm", h.ConfirmSubscription)
+	router.GET("/api/v1/subscribers/unsubscribe", h.Unsubscribe)
+
+	for _, email := range []string{"", "not-an-email", "a@example.com, b@example.com"} {
+		w := serve(router, testRequest{method: http.MethodPost, path: "/api/v1/subscribers", body: map[string]string{"email": email}})
+		expectStatus(t, w, http.StatusBadRequest)
+	}
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/api/v1/subscribers", body: map[string]string{"email": " Reader@Example.com "},
+		host: spoofedOrigin.host, headers: spoofedOrigin.headers})
+	expectStatus(t, w, http.StatusAccepted)
+	var resp models.SubscribeResponse
+	decode(t, w, &resp)
+	if resp.Email != "reader@example.com" {
+		t.Errorf("email = %q, want reader@example.com", resp.Email)
+	}
+
+	var subscriber models.Subscriber
+	if err := db.Where("email = ?", "reader@example.com").First(&subscriber).Error; err != nil {
+		t.Fatalf("load subscriber: %v", err)
+	}
+	if subscriber.Confirmed || subscriber.Token == "" {
+		t.Fatalf("subscriber = %+v, want unconfirmed with a token", subscriber)
+	}
+
+	// The link comes from SITE_URL, whatever Host the request claimed
+	confirmLink := "https://blog.example.com/api/v1/subscribers/confirm?token=" + subscriber.Token
+	confirmation := receiveEmail(t, emails)
+	if confirmation.To != "reader@example.com" || confirmation.Subject != "Confirm your subscription" {
+		t.Errorf("confirmation email = %s: %s", confirmation.To, confirmation.Subject)
+	}
+	if !strings.Contains(confirmation.Body, confirmLink) || strings.Contains(confirmation.Body, spoofedOrigin.host) {
+		t.Errorf("confirmation body %q lacks link %s or uses the request Host", confirmation.Body, confirmLink)
+	}
+
+	// Subscribing again resends the confirmation without a second subscriber
+	w = serve(router, testRequest{method: http.MethodPost, path: "/api/v1/subscribers", body: map[string]string{"email": "reader@example.com"}})
+	expectStatus(t, w, http.StatusAccepted)
+	if resent := receiveEmail(t, emails); !strings.Contains(resent.Body, confirmLink) {
+		t.Errorf("resent body %q lacks link %s", resent.Body, confirmLink)
+	}
+	var count int
+	db.Model(&models.Subscriber{}).Count(&count)
+	if count != 1 {
+		t.Errorf("subscribers = %d, want 1", count)
+	}
+
+	w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/subscribers/confirm"})
+	expectStatus(t, w, http.StatusBadRequest)
+	w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/subscribers/confirm?token=unknown"})
+	expectStatus(t, w, http.StatusNotFound)
+
+	for i := 0; i < 2; i++ {
+		w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/subscribers/confirm?token=" + subscriber.Token})
+		expectStatus(t, w, http.StatusOK)
+	}
+	var confirmed models.Subscriber
+	db.First(&confirmed, subscriber.ID)
+	if !confirmed.Confirmed {
+		t.Error("subscriber not confirmed")
+	}
+
+	// Confirmed subscribers are not sent another confirmation
+	w = serve(router, testRequest{method: http.MethodPost, path: "/api/v1/subscribers", body: map[string]string{"email": "reader@example.com"}})
+	expectStatus(t, w, http.StatusAccepted)
+	expectNoEmail(t, emails)
+
+	w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/subscribers/unsubscribe?token=" + subscriber.Token})
+	expectStatus(t, w, http.StatusNoContent)
+	db.Model(&models.Subscriber{}).Count(&count)
+	if count != 0 {
+		t.Errorf("subscribers after unsubscribe = %d, want 0", count)
+	}
+	w = serve(router, testRequest{method: http.MethodGet, path: "/api/v1/subscribers/unsubscribe?token=" + subscriber.Token})
+	expectStatus(t, w, http.StatusNotFound)
+}
+
+func TestPublishNotifiesConfirmedSubscribers(t *testing.T) {
+	h, db := newTestHandler(t, func(cfg *config.Blog) { cfg.SiteURL = "https://blog.example.com" })
+	var emails <-chan sentEmail
+	h.mailer, emails = startTestSMTP(t)
+	router := gin.New()
+	router.POST("/blogs", requireTestAPIKey(), h.CreateBlog)
+	router.PUT("/blogs/:id", requireTestAPIKey(), h.UpdateBlog)
+
+	for _, subscriber := range []models.Subscriber{
+		{Email: "confirmed@example.com", Token: "confirmed-token", Confirmed: true},
+		{Email: "pending@example.com", Token: "pending-token"},
+	} {
+		if err := db.Create(&subscriber).Error; err != nil {
+			t.Fatalf("create subscriber: %v", err)
+		}
+	}
+
+	draft := map[string]interface{}{
+		"title":     "Drafted announcement",
+		"content":   testContent,
+		"excerpt":   "A draft excerpt",
+		"author":    "Test Author",
+		"published": false,
+	}
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: draft, apiKey: true})
+	expectStatus(t, w, http.StatusCreated)
+	var created models.CreateBlogResponse
+	decode(t, w, &created)
+	expectNoEmail(t, emails)
+
+	// Updating a draft that stays a draft sends nothing either
+	draft["excerpt"] = "An edited draft excerpt"
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(created.ID), body: draft, headers: map[string]string{"If-Match": "*"}, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	expectNoEmail(t, emails)
+
+	draft["published"] = true
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(created.ID), body: draft, headers: map[string]string{"If-Match": "*"}, apiKey: true,
+		host: spoofedOrigin.host})
+	expectStatus(t, w, http.StatusOK)
+	announcement := receiveEmail(t, emails)
+	if announcement.To != "confirmed@example.com" || announcement.Subject != "New post: Drafted announcement" {
+		t.Errorf("announcement = %s: %s", announcement.To, announcement.Subject)
+	}
+	unsubscribeLink := "https://blog.example.com/api/v1/subscribers/unsubscribe?token=confirmed-token"
+	if announcement.ListUnsubscribe != "<"+unsubscribeLink+">" {
+		t.Errorf("List-Unsubscribe = %q, want <%s>", announcement.ListUnsubscribe, unsubscribeLink)
+	}
+	if !strings.Contains(announcement.Body, "https://blog.example.com/blog/drafted-announcement") || strings.Contains(announcement.Body, spoofedOrigin.host) {
+		t.Errorf("announcement body %q lacks the post link or uses the request Host", announcement.Body)
+	}
+
+	// Pending subscribers get nothing, and saving a published post again does not re-announce it
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(created.ID), body: draft, headers: map[string]string{"If-Match": "*"}, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	expectNoEmail(t, emails)
+
+	published := map[string]interface{}{
+		"title":     "Published straight away",
+		"content":   "<p>This post goes out to subscribers as soon as it is created and saved.</p>",
+		"excerpt":   "A published excerpt",
+		"author":    "Test Author",
+		"published": true,
+	}
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: published, apiKey: true})
+	expectStatus(t, w, http.StatusCreated)
+	if announcement := receiveEmail(t, emails); announcement.Subject != "New post: Published straight away" {
+		t.Errorf("subject = %q, want New post: Published straight away", announcement.Subject)
+	}
+	expectNoEmail(t, emails)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// minSuggestQueryLength is the shortest query that produces suggestions
+const minSuggestQueryLength = 2
+
+// SuggestBlogs handles GET /api/v1/blogs/suggest
+// @Summary Get search suggestions
+// @Description Suggest published posts whose title or tags start with the query, most viewed first
+// @Tags blogs
+// @Produce json
+// @Param q query string true "Search prefix (at least 2 characters)"
+// @Param limit query int false "Number of suggestions" default(5)
+// @Success 200 {array} models.SuggestionResponse
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/suggest [get]
+func (h *BlogHandler) SuggestBlogs(c *gin.Context) {
+	q := strings.TrimSpace(c.Query("q"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "5"))
+
+	// Validate parameters
+	if limit < 1 || limit > 20 {
+		limit = 5
+	}
+
+	suggestions := []models.SuggestionResponse{}
+	if utf8.RuneCountInString(q) < minSuggestQueryLength {
+		c.JSON(http.StatusOK, suggestions)
+		return
+	}
+
+	var blogs []models.Blog
+	if err := requestDB(c, h.db).Select("title, slug, view_count").
+		Where("published = ?", true).
+		Where(`LOWER(title) LIKE ? ESCAPE '\' OR `+normalizedTagsColumn+` LIKE ? ESCAPE '\'`, prefixPattern(q), tagPrefixPattern(q)).
+		Order("view_count DESC, title ASC").
+		Limit(limit).
+		Find(&blogs).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch suggestions")
+		return
+	}
+
+	for _, blog := range blogs {
+		suggestions = append(suggestions, models.SuggestionResponse{Title: blog.Title, Slug: blog.Slug})
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestSuggestBlogs(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/suggest", h.SuggestBlogs)
+
+	createTestBlog(t, db, models.Blog{Title: "Accessible forms", Tags: "forms", Published: true, ViewCount: 10})
+	createTestBlog(t, db, models.Blog{Title: "Accent colours", Tags: "design", Published: true, ViewCount: 50})
+	createTestBlog(t, db, models.Blog{Title: "Colour contrast", Tags: "design, accessibility", Published: true, ViewCount: 30})
+	createTestBlog(t, db, models.Blog{Title: "Inaccurate claims", Tags: "opinion", Published: true, ViewCount: 99})
+	createTestBlog(t, db, models.Blog{Title: "Access draft", Tags: "forms", ViewCount: 500})
+	createTestBlog(t, db, models.Blog{Title: "100% accessible_ish", Tags: "fun", Published: true})
+
+	suggest := func(query string) []string {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/suggest?" + query})
+		expectStatus(t, w, http.StatusOK)
+		var suggestions []models.SuggestionResponse
+		decode(t, w, &suggestions)
+		slugs := make([]string, len(suggestions))
+		for i, suggestion := range suggestions {
+			slugs[i] = suggestion.Slug
+		}
+		return slugs
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		// Title and tag prefixes match, most viewed first; mid-word matches and drafts do not
+		{"q=acc", []string{"accent-colours", "colour-contrast", "accessible-forms"}},
+		{"q=ACCESS", []string{"colour-contrast", "accessible-forms"}},
+		{"q=acc&limit=1", []string{"accent-colours"}},
+		{"q=des", []string{"accent-colours", "colour-contrast"}},
+		{"q=" + url.QueryEscape("100%"), []string{"100-accessible-ish"}},
+		{"q=" + url.QueryEscape("1_0"), []string{}},
+		{"q=zzz", []string{}},
+		// Queries shorter than two characters suggest nothing
+		{"q=a", []string{}},
+		{"q=" + url.QueryEscape(" a "), []string{}},
+		{"q=", []string{}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		if got := suggest(tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: suggestions = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
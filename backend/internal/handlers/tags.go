@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// GetTagCloud handles GET /api/v1/tags/cloud
+// @Summary Get the tag cloud
+// @Description Count tags across published posts and assign each a weight from 1 to 5 based on frequency
+// @Tags tags
+// @Produce json
+// @Success 200 {array} models.TagCloudEntry
+// @Failure 500 {object} apierror.Response
+// @Router /tags/cloud [get]
+func (h *BlogHandler) GetTagCloud(c *gin.Context) {
+	var tagLists []string
+	if err := requestDB(c, h.db).Model(&models.Blog{}).
+		Where("published = ? AND tags <> ''", true).
+		Pluck("tags", &tagLists).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch tags")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.BuildTagCloud(tagLists))
+}
+
+// GetTagSynonyms handles GET /api/v1/tags/synonyms
+// @Summary Get tag synonyms
+// @Description Map each tag synonym to the canonical tag it is stored as
+// @Tags tags
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /tags/synonyms [get]
+func (h *BlogHandler) GetTagSynonyms(c *gin.Context) {
+	c.JSON(http.StatusOK, models.TagSynonyms())
+}
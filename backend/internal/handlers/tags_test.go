@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestBlogTagValidation(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+
+	longTag := strings.Repeat("x", models.MaxTagLength+1)
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Tagged post", "content": testContent, "author": "Test Author", "tags": "react, " + longTag + ", a",
+	}})
+	expectStatus(t, w, http.StatusBadRequest)
+	var resp struct {
+		InvalidTags []string `json:"invalid_tags"`
+	}
+	decode(t, w, &resp)
+	if want := []string{longTag, "a"}; !reflect.DeepEqual(resp.InvalidTags, want) {
+		t.Errorf("invalid_tags = %q, want %q", resp.InvalidTags, want)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Tagged post", "content": testContent, "author": "Test Author",
+		"tags": "one, two, three, four, five, six, seven, eight, nine, ten, eleven",
+	}})
+	expectStatus(t, w, http.StatusBadRequest)
+
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Tagged post", "content": testContent, "author": "Test Author", "tags": "React, CSS, react , css",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var created models.BlogResponse
+	decode(t, w, &created)
+	if want := []string{"react", "css"}; !reflect.DeepEqual(created.Tags, want) {
+		t.Errorf("created tags = %q, want %q", created.Tags, want)
+	}
+
+	update := func(tags string) int {
+		return serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(created.ID), body: gin.H{"tags": tags},
+			headers: map[string]string{"If-Match": "*"}}).Code
+	}
+	if status := update("react, " + longTag); status != http.StatusBadRequest {
+		t.Errorf("update with a long tag: status = %d, want 400", status)
+	}
+	if status := update("HTML, html, Html"); status != http.StatusOK {
+		t.Errorf("update with duplicate tags: status = %d, want 200", status)
+	}
+	var stored models.Blog
+	db.First(&stored, created.ID)
+	if stored.Tags != "html" {
+		t.Errorf("stored tags = %q, want %q", stored.Tags, "html")
+	}
+}
+
+func TestGetTagCloudWeights(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/tags/cloud", h.GetTagCloud)
+
+	// react on 9 posts, css on 5, html on 3 and go on 1
+	for i := 0; i < 9; i++ {
+		tags := "react"
+		if i < 5 {
+			tags += ", css"
+		}
+		if i < 3 {
+			tags += ", HTML"
+		}
+		if i == 0 {
+			tags += ", go"
+		}
+		createTestBlog(t, db, models.Blog{Title: "Post " + itoa(uint(i)), Tags: tags, Published: true})
+	}
+	// Drafts are not counted
+	createTestBlog(t, db, models.Blog{Title: "Draft", Tags: "go, html, draft-only"})
+
+	w := serve(router, testRequest{method: http.MethodGet, path: "/tags/cloud"})
+	expectStatus(t, w, http.StatusOK)
+	var cloud []models.TagCloudEntry
+	decode(t, w, &cloud)
+	// Weights scale linearly from 1 for the rarest tag to 5 for the most used
+	want := []models.TagCloudEntry{
+		{Tag: "css", Count: 5, Weight: 3},
+		{Tag: "go", Count: 1, Weight: 1},
+		{Tag: "html", Count: 3, Weight: 2},
+		{Tag: "react", Count: 9, Weight: 5},
+	}
+	if !reflect.DeepEqual(cloud, want) {
+		t.Errorf("cloud = %+v, want %+v", cloud, want)
+	}
+}
+
+func TestBlogTagSynonyms(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs", h.CreateBlog)
+	router.PUT("/blogs/:id", h.UpdateBlog)
+	router.GET("/tags/synonyms", h.GetTagSynonyms)
+
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Synonym post", "content": testContent, "author": "Test Author", "tags": "A11y, css, accessibility",
+	}})
+	expectStatus(t, w, http.StatusCreated)
+	var created models.BlogResponse
+	decode(t, w, &created)
+	if want := []string{"accessibility", "css"}; !reflect.DeepEqual(created.Tags, want) {
+		t.Errorf("created tags = %q, want %q", created.Tags, want)
+	}
+
+	w = serve(router, testRequest{method: http.MethodPut, path: "/blogs/" + itoa(created.ID), body: gin.H{"tags": "UX, user experience"},
+		headers: map[string]string{"If-Match": "*"}})
+	expectStatus(t, w, http.StatusOK)
+	var stored models.Blog
+	db.First(&stored, created.ID)
+	if stored.Tags != "user experience" {
+		t.Errorf("stored tags = %q, want %q", stored.Tags, "user experience")
+	}
+
+	w = serve(router, testRequest{method: http.MethodGet, path: "/tags/synonyms"})
+	expectStatus(t, w, http.StatusOK)
+	var synonyms map[string]string
+	decode(t, w, &synonyms)
+	if want := map[string]string{"a11y": "accessibility", "ux": "user experience"}; !reflect.DeepEqual(synonyms, want) {
+		t.Errorf("synonyms = %v, want %v", synonyms, want)
+	}
+}
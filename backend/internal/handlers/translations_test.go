@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestTranslationsGroupingAndFiltering(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs", h.GetBlogs)
+	router.GET("/blogs/:slug", h.GetBlogBySlug)
+	router.POST("/blogs", h.CreateBlog)
+
+	create := func(title, language, group string) *models.BlogResponse {
+		t.Helper()
+		w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+			"title": title, "content": "<p>" + title + ": " + testContent + "</p>", "author": "Test Author",
+			"language": language, "translation_group": group, "published": true,
+		}})
+		if w.Code != http.StatusCreated {
+			t.Fatalf("create %q: status = %d; body: %s", title, w.Code, w.Body.String())
+		}
+		var created models.BlogResponse
+		decode(t, w, &created)
+		return &created
+	}
+
+	english := create("Accessible forms", "", "")
+	if english.Language != models.DefaultLanguage || !models.IsValidTranslationGroup(english.TranslationGroup) {
+		t.Fatalf("default post: language %q, group %q", english.Language, english.TranslationGroup)
+	}
+	spanish := create("Formularios accesibles", "es", english.TranslationGroup)
+	french := create("Formulaires accessibles", "FR", english.TranslationGroup)
+	other := create("Colour contrast", "en", "")
+	if spanish.TranslationGroup != english.TranslationGroup || french.Language != "fr" {
+		t.Errorf("variants: spanish group %q, french language %q", spanish.TranslationGroup, french.Language)
+	}
+	if other.TranslationGroup == english.TranslationGroup {
+		t.Error("unrelated post joined the translation group")
+	}
+
+	// A second variant in the same language, or an unknown group, is rejected
+	w := serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Otro formulario", "content": "<p>Otra versión: " + testContent + "</p>", "author": "Test Author",
+		"language": "es", "translation_group": english.TranslationGroup,
+	}})
+	expectStatus(t, w, http.StatusConflict)
+	w = serve(router, testRequest{method: http.MethodPost, path: "/blogs", body: gin.H{
+		"title": "Orphan translation", "content": "<p>Orphan: " + testContent + "</p>", "author": "Test Author",
+		"language": "de", "translation_group": models.NewTranslationGroup(),
+	}})
+	expectStatus(t, w, http.StatusBadRequest)
+
+	// Each variant lists the others, but not drafts or itself
+	createTestBlog(t, db, models.Blog{Title: "Barrierefreie Formulare", Language: "de", TranslationGroup: english.TranslationGroup})
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + spanish.Slug})
+	expectStatus(t, w, http.StatusOK)
+	var detail models.BlogDetailResponse
+	decode(t, w, &detail)
+	want := []models.Translation{{Language: "en", Slug: english.Slug}, {Language: "fr", Slug: french.Slug}}
+	if !reflect.DeepEqual(detail.Translations, want) {
+		t.Errorf("translations = %+v, want %+v", detail.Translations, want)
+	}
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs/" + other.Slug})
+	detail = models.BlogDetailResponse{}
+	decode(t, w, &detail)
+	if len(detail.Translations) != 0 {
+		t.Errorf("untranslated post translations = %+v, want none", detail.Translations)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"lang=es", []string{"Formularios accesibles"}},
+		{"lang=EN", []string{"Colour contrast", "Accessible forms"}},
+		{"lang=ja", []string{}},
+		{"", []string{"Colour contrast", "Formulaires accessibles", "Formularios accesibles", "Accessible forms"}},
+	}
+	for _, tt := range tests {
+		if got := listTitles(t, router, "/blogs?"+tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: titles = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+	w = serve(router, testRequest{method: http.MethodGet, path: "/blogs?lang=spanish"})
+	expectStatus(t, w, http.StatusBadRequest)
+}
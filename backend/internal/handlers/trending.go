@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/models"
+)
+
+// Trending window and size bounds
+const (
+	defaultTrendingDays  = 7
+	maxTrendingDays      = 365
+	defaultTrendingLimit = 5
+	maxTrendingLimit     = 50
+)
+
+// GetTrendingBlogs handles GET /api/v1/blogs/trending
+// @Summary Get trending blog posts
+// @Description Rank published posts by views recorded within the last N days, falling back to lifetime view counts
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param days query int false "Window in days, 1 to 365" default(7)
+// @Param limit query int false "Number of posts, 1 to 50" default(5)
+// @Success 200 {array} models.BlogResponse
+// @Header 200 {string} X-Trending-Source "recent when ranked by views in the window, lifetime when it had none"
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/trending [get]
+func (h *BlogHandler) GetTrendingBlogs(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", strconv.Itoa(defaultTrendingDays)))
+	if err != nil || days < 1 || days > maxTrendingDays {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid days", "days must be an integer between 1 and "+strconv.Itoa(maxTrendingDays)+", got "+strconv.Quote(c.Query("days")))
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultTrendingLimit)))
+	if err != nil || limit < 1 || limit > maxTrendingLimit {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid limit", "limit must be an integer between 1 and "+strconv.Itoa(maxTrendingLimit)+", got "+strconv.Quote(c.Query("limit")))
+		return
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	// Rank by views recorded within the window
+	var blogs []models.Blog
+	if err := requestDB(c, h.db).Table("blogs").
+		Select("blogs.*").
+		Joins("JOIN post_views ON post_views.blog_id = blogs.id").
+		Where("blogs.published = ? AND post_views.viewed_at >= ?", true, since).
+		Group("blogs.id").
+		Order("COUNT(post_views.id) DESC, blogs.view_count DESC").
+		Limit(limit).
+		Find(&blogs).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch trending blogs")
+		return
+	}
+
+	// Fall back to lifetime counts when there is no time-series data for the window
+	source := "recent"
+	if len(blogs) == 0 {
+		source = "lifetime"
+		if err := requestDB(c, h.db).Where("published = ?", true).
+			Order("view_count DESC, published_at DESC").
+			Limit(limit).
+			Find(&blogs).Error; err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch trending blogs")
+			return
+		}
+	}
+
+	blogResponses := make([]models.BlogResponse, len(blogs))
+	for i, blog := range blogs {
+		blogResponses[i] = blog.ToResponse(false)
+	}
+
+	c.Header("X-Trending-Source", source)
+	c.JSON(http.StatusOK, blogResponses)
+}
+
+// GetPopularBlogs handles GET /api/v1/blogs/popular
+// @Summary Get the most viewed blog posts
+// @Description Paginated leaderboard of published posts by lifetime view count, ties broken by recency
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param fields query string false "Comma-separated response fields to include, e.g. title,slug,view_count"
+// @Success 200 {object} models.BlogListResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/popular [get]
+func (h *BlogHandler) GetPopularBlogs(c *gin.Context) {
+	page, limit, ok := h.parsePagination(c)
+	if !ok {
+		return
+	}
+
+	fields, ok := h.parseFields(c)
+	if !ok {
+		return
+	}
+
+	query := requestDB(c, h.db).Model(&models.Blog{}).Where("published = ?", true)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to count blogs")
+		return
+	}
+
+	offset := (page - 1) * limit
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+
+	var blogs []models.Blog
+	if err := query.Order("view_count DESC, published_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&blogs).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch popular blogs")
+		return
+	}
+
+	blogResponses := make([]models.BlogResponse, len(blogs))
+	for i, blog := range blogs {
+		blogResponses[i] = blog.ToResponse(false)
+	}
+
+	response := models.BlogListResponse{
+		Blogs:      blogResponses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+		Links:      paginationLinks(c, page, totalPages),
+	}
+
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.Header("X-Page", strconv.Itoa(page))
+	c.Header("X-Per-Page", strconv.Itoa(limit))
+
+	if fields != nil {
+		trimmed, err := trimBlogList(response, fields)
+		if err != nil {
+			c.Error(err)
+			apierror.Respond(c, http.StatusInternalServerError, "Failed to encode blogs")
+			return
+		}
+		c.JSON(http.StatusOK, trimmed)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
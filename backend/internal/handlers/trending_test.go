@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/models"
+)
+
+// recordViews stores count timestamped views of a post, daysAgo days in the past
+func recordViews(t *testing.T, db *gorm.DB, blog models.Blog, daysAgo, count int) {
+	t.Helper()
+	viewedAt := time.Now().UTC().AddDate(0, 0, -daysAgo)
+	for i := 0; i < count; i++ {
+		if err := db.Create(&models.PostView{BlogID: blog.ID, ViewedAt: viewedAt}).Error; err != nil {
+			t.Fatalf("record view: %v", err)
+		}
+	}
+}
+
+// trendingTitles requests the trending posts and returns their titles and source
+func trendingTitles(t *testing.T, router http.Handler, query string) ([]string, string) {
+	t.Helper()
+	w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/trending" + query})
+	expectStatus(t, w, http.StatusOK)
+	var blogs []models.BlogResponse
+	decode(t, w, &blogs)
+	titles := make([]string, len(blogs))
+	for i, blog := range blogs {
+		titles[i] = blog.Title
+	}
+	return titles, w.Header().Get("X-Trending-Source")
+}
+
+func TestGetTrendingBlogsRanksViewsInWindow(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/trending", h.GetTrendingBlogs)
+
+	// Lifetime counts only break ties between posts viewed equally often in the window
+	lifetime := createTestBlog(t, db, models.Blog{Title: "Lifetime favourite", Published: true, ViewCount: 1000})
+	recent := createTestBlog(t, db, models.Blog{Title: "Recent hit", Published: true, ViewCount: 5})
+	steady := createTestBlog(t, db, models.Blog{Title: "Steady", Published: true, ViewCount: 50})
+	tied := createTestBlog(t, db, models.Blog{Title: "Tied with more lifetime views", Published: true, ViewCount: 60})
+	draft := createTestBlog(t, db, models.Blog{Title: "Viewed draft", ViewCount: 5})
+	recordViews(t, db, lifetime, 10, 4)
+	recordViews(t, db, recent, 1, 3)
+	recordViews(t, db, steady, 2, 1)
+	recordViews(t, db, steady, 20, 1)
+	recordViews(t, db, tied, 3, 1)
+	recordViews(t, db, draft, 1, 5)
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"Recent hit", "Tied with more lifetime views", "Steady"}},
+		{"?days=7", []string{"Recent hit", "Tied with more lifetime views", "Steady"}},
+		{"?days=30", []string{"Lifetime favourite", "Recent hit", "Steady", "Tied with more lifetime views"}},
+		{"?days=30&limit=2", []string{"Lifetime favourite", "Recent hit"}},
+	}
+	for _, tt := range tests {
+		titles, source := trendingTitles(t, router, tt.query)
+		if !reflect.DeepEqual(titles, tt.want) {
+			t.Errorf("%q: trending = %v, want %v", tt.query, titles, tt.want)
+		}
+		if source != "recent" {
+			t.Errorf("%q: X-Trending-Source = %q, want recent", tt.query, source)
+		}
+	}
+}
+
+func TestGetTrendingBlogsFallsBackToLifetimeViews(t *testing.T) {
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/trending", h.GetTrendingBlogs)
+	createTestBlog(t, db, models.Blog{Title: "Few views", Published: true, ViewCount: 3})
+	old := createTestBlog(t, db, models.Blog{Title: "Many views", Published: true, ViewCount: 30})
+	createTestBlog(t, db, models.Blog{Title: "Draft", ViewCount: 300})
+
+	// Views recorded before the window do not count as recent
+	recordViews(t, db, old, 30, 2)
+	titles, source := trendingTitles(t, router, "?days=7")
+	if want := []string{"Many views", "Few views"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("trending = %v, want %v", titles, want)
+	}
+	if source != "lifetime" {
+		t.Errorf("X-Trending-Source = %q, want lifetime", source)
+	}
+}
+
+func TestGetTrendingBlogsRejectsInvalidBounds(t *testing.T) {
+	h, _ := newTestHandler(t, nil)
+	router := gin.New()
+	router.GET("/blogs/trending", h.GetTrendingBlogs)
+
+	for _, query := range []string{"days=0", "days=366", "days=week", "limit=0", "limit=51", "limit=few"} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/trending?" + query})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", query, w.Code)
+		}
+	}
+	for _, query := range []string{"days=1", "days=365", "limit=1", "limit=50"} {
+		w := serve(router, testRequest{method: http.MethodGet, path: "/blogs/trending?" + query})
+		expectStatus(t, w, http.StatusOK)
+	}
+}
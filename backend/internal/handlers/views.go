@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"technoprise-blog-backend/internal/apierror"
+	"technoprise-blog-backend/internal/middleware"
+	"technoprise-blog-backend/internal/models"
+)
+
+// viewQueueSize is the number of pending views buffered before writes fall back to synchronous
+const viewQueueSize = 1024
+
+// viewWriter records blog views off the request path
+type viewWriter struct {
+	db    *gorm.DB
+	queue chan uint
+}
+
+// newViewWriter creates a view writer and starts its background worker
+func newViewWriter(db *gorm.DB) *viewWriter {
+	w := &viewWriter{
+		db:    db,
+		queue: make(chan uint, viewQueueSize),
+	}
+	go w.run()
+	return w
+}
+
+// Record queues a view for the given blog, writing inline if the queue is full
+func (w *viewWriter) Record(blogID uint) {
+	select {
+	case w.queue <- blogID:
+	default:
+		w.write(blogID)
+	}
+}
+
+// run drains the queue until it is closed
+func (w *viewWriter) run() {
+	for blogID := range w.queue {
+		w.write(blogID)
+	}
+}
+
+// write increments the lifetime view count and records a timestamped view
+func (w *viewWriter) write(blogID uint) {
+	if err := w.db.Model(&models.Blog{}).Where("id = ?", blogID).
+		UpdateColumn("view_count", gorm.Expr("view_count + ?", 1)).Error; err != nil {
+		log.Printf("Failed to increment view count for blog %d: %v", blogID, err)
+	}
+
+	view := models.PostView{BlogID: blogID, ViewedAt: time.Now().UTC()}
+	if err := w.db.Create(&view).Error; err != nil {
+		log.Printf("Failed to record view for blog %d: %v", blogID, err)
+	}
+}
+
+// viewAuditLog writes manual view count changes as JSON lines alongside the access log
+var viewAuditLog = log.New(os.Stdout, "", 0)
+
+// viewAuditEntry is a single structured view count audit log line
+type viewAuditEntry struct {
+	Time          string `json:"time"`
+	Level         string `json:"level"`
+	Type          string `json:"type"`
+	RequestID     string `json:"request_id"`
+	ClientIP      string `json:"client_ip"`
+	Action        string `json:"action"`
+	BlogID        uint   `json:"blog_id"`
+	PreviousCount int    `json:"previous_count"`
+	ViewCount     int    `json:"view_count"`
+}
+
+// ResetViewCount handles POST /api/v1/blogs/:id/views/reset
+// @Summary Reset a post's view count
+// @Description Set the lifetime view count of a blog post to zero; the change is written to the audit log
+// @Tags views
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Success 200 {object} models.ViewCountResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/views/reset [post]
+func (h *BlogHandler) ResetViewCount(c *gin.Context) {
+	h.setViewCount(c, "reset", 0)
+}
+
+// SetViewCount handles POST /api/v1/blogs/:id/views/set
+// @Summary Set a post's view count
+// @Description Set the lifetime view count of a blog post to a non-negative baseline; the change is written to the audit log
+// @Tags views
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Blog ID"
+// @Param views body models.SetViewCountRequest true "New view count"
+// @Success 200 {object} models.ViewCountResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /blogs/{id}/views/set [post]
+func (h *BlogHandler) SetViewCount(c *gin.Context) {
+	var req models.SetViewCountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BindFailed(c, err)
+		return
+	}
+	if req.ViewCount == nil || *req.ViewCount < 0 {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid request data", "view_count must be a non-negative integer")
+		return
+	}
+
+	h.setViewCount(c, "set", *req.ViewCount)
+}
+
+// setViewCount overwrites the view count of the post in the path and audits the change
+func (h *BlogHandler) setViewCount(c *gin.Context, action string, count int) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apierror.Respond(c, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+
+	var blog models.Blog
+	if err := requestDB(c, h.db).Select("id, view_count").First(&blog, id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+			return
+		}
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to fetch blog post")
+		return
+	}
+
+	// UpdateColumn skips hooks and leaves updated_at and version alone
+	previous := blog.ViewCount
+	if err := requestDB(c, h.db).Model(&blog).UpdateColumn("view_count", count).Error; err != nil {
+		c.Error(err)
+		apierror.Respond(c, http.StatusInternalServerError, "Failed to update blog post")
+		return
+	}
+
+	line, err := json.Marshal(viewAuditEntry{
+		Time:          time.Now().UTC().Format(time.RFC3339Nano),
+		Level:         "info",
+		Type:          "view_count_change",
+		RequestID:     middleware.GetRequestID(c),
+		ClientIP:      c.ClientIP(),
+		Action:        action,
+		BlogID:        uint(id),
+		PreviousCount: previous,
+		ViewCount:     count,
+	})
+	if err == nil {
+		viewAuditLog.Println(string(line))
+	}
+
+	h.invalidateListCache()
+
+	c.JSON(http.StatusOK, models.ViewCountResponse{
+		ID:                uint(id),
+		PreviousViewCount: previous,
+		ViewCount:         count,
+	})
+}
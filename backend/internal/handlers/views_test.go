@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/models"
+)
+
+func TestViewCountResetAndSet(t *testing.T) {
+	var logged bytes.Buffer
+	viewAuditLog.SetOutput(&logged)
+	defer viewAuditLog.SetOutput(os.Stdout)
+
+	h, db := newTestHandler(t, nil)
+	router := gin.New()
+	router.POST("/blogs/:id/views/reset", requireTestAPIKey(), h.ResetViewCount)
+	router.POST("/blogs/:id/views/set", requireTestAPIKey(), h.SetViewCount)
+	blog := createTestBlog(t, db, models.Blog{Title: "Bot magnet", Published: true, ViewCount: 90210})
+	base := "/blogs/" + itoa(blog.ID) + "/views/"
+
+	viewCount := func() int {
+		var stored models.Blog
+		db.First(&stored, blog.ID)
+		return stored.ViewCount
+	}
+
+	w := serve(router, testRequest{method: http.MethodPost, path: base + "reset"})
+	expectStatus(t, w, http.StatusUnauthorized)
+	w = serve(router, testRequest{method: http.MethodPost, path: base + "set", body: gin.H{"view_count": 5}})
+	expectStatus(t, w, http.StatusUnauthorized)
+	if viewCount() != 90210 {
+		t.Fatalf("unauthenticated requests changed the view count to %d", viewCount())
+	}
+
+	w = serve(router, testRequest{method: http.MethodPost, path: base + "reset", apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	var resp models.ViewCountResponse
+	decode(t, w, &resp)
+	if want := (models.ViewCountResponse{ID: blog.ID, PreviousViewCount: 90210, ViewCount: 0}); resp != want {
+		t.Errorf("reset response = %+v, want %+v", resp, want)
+	}
+	if viewCount() != 0 {
+		t.Errorf("view count after reset = %d, want 0", viewCount())
+	}
+
+	w = serve(router, testRequest{method: http.MethodPost, path: base + "set", body: gin.H{"view_count": 1500}, apiKey: true})
+	expectStatus(t, w, http.StatusOK)
+	decode(t, w, &resp)
+	if want := (models.ViewCountResponse{ID: blog.ID, PreviousViewCount: 0, ViewCount: 1500}); resp != want {
+		t.Errorf("set response = %+v, want %+v", resp, want)
+	}
+	if viewCount() != 1500 {
+		t.Errorf("view count after set = %d, want 1500", viewCount())
+	}
+
+	for name, body := range map[string]interface{}{
+		"negative": gin.H{"view_count": -1},
+		"missing":  gin.H{},
+		"string":   gin.H{"view_count": "many"},
+	} {
+		w := serve(router, testRequest{method: http.MethodPost, path: base + "set", body: body, apiKey: true})
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s value: status = %d, want 400", name, w.Code)
+		}
+	}
+	if w := serve(router, testRequest{method: http.MethodPost, path: "/blogs/9999/views/reset", apiKey: true}); w.Code != http.StatusNotFound {
+		t.Errorf("unknown post: status = %d, want 404", w.Code)
+	}
+	if viewCount() != 1500 {
+		t.Errorf("rejected requests changed the view count to %d", viewCount())
+	}
+
+	// Each change is audited with the counts before and after
+	lines := strings.Split(strings.TrimSpace(logged.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("%d audit lines, want 2: %q", len(lines), logged.String())
+	}
+	var entries [2]viewAuditEntry
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &entries[i]); err != nil {
+			t.Fatalf("audit line %q: %v", line, err)
+		}
+	}
+	if e := entries[0]; e.Action != "reset" || e.BlogID != blog.ID || e.PreviousCount != 90210 || e.ViewCount != 0 || e.Type != "view_count_change" {
+		t.Errorf("reset audit entry = %+v", e)
+	}
+	if e := entries[1]; e.Action != "set" || e.PreviousCount != 0 || e.ViewCount != 1500 {
+		t.Errorf("set audit entry = %+v", e)
+	}
+}
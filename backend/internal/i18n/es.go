@@ -0,0 +1,114 @@
+package i18n
+
+// spanish is the Spanish message catalog
+var spanish = map[string]string{
+	"A blog post with identical content already exists": "Ya existe una entrada con contenido idéntico",
+	"A series with this slug already exists":            "Ya existe una serie con este slug",
+	"A translation in this language already exists":     "Ya existe una traducción en este idioma",
+	"Admin API disabled":                                "API de administración deshabilitada",
+	"Author not found":                                  "Autor no encontrado",
+	"Batch too large":                                   "Lote demasiado grande",
+	"Blog post no longer available":                     "La entrada ya no está disponible",
+	"Blog post not found":                               "Entrada no encontrada",
+	"Blog post was modified by someone else":            "Otra persona ha modificado la entrada",
+	"Comment not found":                                 "Comentario no encontrado",
+	"Comments are disabled for this post":               "Los comentarios están desactivados para esta publicación",
+	"Content contains personal data or profanity":       "El contenido incluye datos personales o lenguaje obsceno",
+	"Content is too short":                              "El contenido es demasiado corto",
+	"Database already contains blog posts":              "La base de datos ya contiene entradas del blog",
+	"Draft not found":                                   "Borrador no encontrado",
+	"Excerpt is too long":                               "El extracto es demasiado largo",
+	"Failed to check for duplicate content":             "No se pudo comprobar si el contenido está duplicado",
+	"Failed to check slug availability":                 "No se pudo comprobar la disponibilidad del slug",
+	"Failed to compute post totals":                     "No se pudieron calcular los totales de entradas",
+	"Failed to compute publishing history":              "No se pudo calcular el historial de publicación",
+	"Failed to confirm subscription":                    "No se pudo confirmar la suscripción",
+	"Failed to count blogs":                             "No se pudieron contar las entradas",
+	"Failed to count comments":                          "No se pudieron contar los comentarios",
+	"Failed to count featured blogs":                    "No se pudieron contar las entradas destacadas",
+	"Failed to create blog post":                        "No se pudo crear la entrada",
+	"Failed to create comment":                          "No se pudo crear el comentario",
+	"Failed to create series":                           "Error al crear la serie",
+	"Failed to create subscription":                     "No se pudo crear la suscripción",
+	"Failed to delete blog post":                        "No se pudo eliminar la entrada",
+	"Failed to delete blog posts":                       "No se pudieron eliminar las entradas",
+	"Failed to encode blog post":                        "No se pudo codificar la entrada",
+	"Failed to encode blogs":                            "No se pudieron codificar las entradas",
+	"Failed to encode feed":                             "No se pudo generar el feed",
+	"Failed to fetch accessibility statement":           "Error al obtener la declaración de accesibilidad",
+	"Failed to fetch archive":                           "Error al obtener el archivo",
+	"Failed to fetch authors":                           "No se pudieron obtener los autores",
+	"Failed to fetch blog post":                         "No se pudo obtener la entrada",
+	"Failed to fetch blog posts":                        "No se pudieron obtener las entradas",
+	"Failed to fetch blogs":                             "No se pudieron obtener las entradas",
+	"Failed to fetch comments":                          "No se pudieron obtener los comentarios",
+	"Failed to fetch draft":                             "Error al obtener el borrador",
+	"Failed to fetch popular blogs":                     "No se pudieron obtener las entradas populares",
+	"Failed to fetch related posts":                     "Error al obtener las publicaciones relacionadas",
+	"Failed to fetch revision":                          "No se pudo obtener la revisión",
+	"Failed to fetch revisions":                         "No se pudieron obtener las revisiones",
+	"Failed to fetch seed status":                       "No se pudo obtener el estado de los datos de ejemplo",
+	"Failed to fetch series":                            "Error al obtener la serie",
+	"Failed to fetch subscription":                      "No se pudo obtener la suscripción",
+	"Failed to fetch suggestions":                       "No se pudieron obtener las sugerencias",
+	"Failed to fetch tags":                              "No se pudieron obtener las etiquetas",
+	"Failed to fetch translations":                      "No se pudieron obtener las traducciones",
+	"Failed to fetch trending blogs":                    "No se pudieron obtener las entradas en tendencia",
+	"Failed to fetch updated blog post":                 "No se pudo obtener la entrada actualizada",
+	"Failed to find an available slug":                  "No se encontró un slug disponible",
+	"Failed to generate a unique slug":                  "No se pudo generar un slug único",
+	"Failed to read request body":                       "No se pudo leer el cuerpo de la solicitud",
+	"Failed to restore revision":                        "No se pudo restaurar la revisión",
+	"Failed to save draft":                              "Error al guardar el borrador",
+	"Failed to save revision":                           "No se pudo guardar la revisión",
+	"Failed to seed database":                           "No se pudieron cargar los datos de ejemplo",
+	"Failed to unsubscribe":                             "No se pudo cancelar la suscripción",
+	"Failed to update accessibility statement":          "Error al actualizar la declaración de accesibilidad",
+	"Failed to update blog post":                        "No se pudo actualizar la entrada",
+	"Failed to update blog posts":                       "No se pudieron actualizar las entradas",
+	"Failed to update comment":                          "No se pudo actualizar el comentario",
+	"Featured image alt text required":                  "Se requiere texto alternativo para la imagen destacada",
+	"Featured image alt text too long":                  "El texto alternativo de la imagen destacada es demasiado largo",
+	"Invalid CSP report":                                "Informe CSP no válido",
+	"Invalid Idempotency-Key":                           "Idempotency-Key no válida",
+	"Invalid If-Match header":                           "Cabecera If-Match no válida",
+	"Invalid blog ID":                                   "ID de entrada no válido",
+	"Invalid comment ID":                                "ID de comentario no válido",
+	"Invalid days":                                      "Días no válidos",
+	"Invalid email address":                             "Dirección de correo electrónico no válida",
+	"Invalid featured image":                            "Imagen destacada no válida",
+	"Invalid fields":                                    "Campos no válidos",
+	"Invalid from date":                                 "Fecha inicial no válida",
+	"Invalid language":                                  "Idioma no válido",
+	"Invalid limit":                                     "Límite no válido",
+	"Invalid max_tags":                                  "max_tags no válido",
+	"Invalid month":                                     "Mes no válido",
+	"Invalid page":                                      "Página no válida",
+	"Invalid parent comment":                            "Comentario padre no válido",
+	"Invalid preview token":                             "Token de vista previa no válido",
+	"Invalid published filter":                          "Filtro de publicación no válido",
+	"Invalid request data":                              "Datos de solicitud no válidos",
+	"Invalid revision version":                          "Versión de revisión no válida",
+	"Invalid series":                                    "Serie no válida",
+	"Invalid since date":                                "Fecha de última visita no válida",
+	"Invalid sort":                                      "Orden no válido",
+	"Invalid status":                                    "Estado no válido",
+	"Invalid subscription token":                        "Token de suscripción no válido",
+	"Invalid tag mode":                                  "Modo de etiquetas no válido",
+	"Invalid tags":                                      "Etiquetas no válidas",
+	"Invalid to date":                                   "Fecha final no válida",
+	"Invalid translation group":                         "Grupo de traducción no válido",
+	"Invalid year":                                      "Año no válido",
+	"Maximum number of featured posts reached":          "Se alcanzó el número máximo de entradas destacadas",
+	"Request body too large":                            "El cuerpo de la solicitud es demasiado grande",
+	"Request timed out":                                 "La solicitud ha excedido el tiempo de espera",
+	"Revision not found":                                "Revisión no encontrada",
+	"Series not found":                                  "Serie no encontrada",
+	"Subscription not found":                            "Suscripción no encontrada",
+	"Tag not found":                                     "Etiqueta no encontrada",
+	"Title does not produce a valid slug":               "El título no genera un slug válido",
+	"Title is required":                                 "El título es obligatorio",
+	"Too many requests":                                 "Demasiadas solicitudes",
+	"Unauthorized":                                      "No autorizado",
+	"Version required":                                  "Se requiere la versión",
+}
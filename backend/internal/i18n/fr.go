@@ -0,0 +1,114 @@
+package i18n
+
+// french is the French message catalog
+var french = map[string]string{
+	"A blog post with identical content already exists": "Un article au contenu identique existe déjà",
+	"A series with this slug already exists":            "Une série avec ce slug existe déjà",
+	"A translation in this language already exists":     "Une traduction dans cette langue existe déjà",
+	"Admin API disabled":                                "API d'administration désactivée",
+	"Author not found":                                  "Auteur introuvable",
+	"Batch too large":                                   "Lot trop volumineux",
+	"Blog post no longer available":                     "L'article n'est plus disponible",
+	"Blog post not found":                               "Article introuvable",
+	"Blog post was modified by someone else":            "L'article a été modifié par quelqu'un d'autre",
+	"Comment not found":                                 "Commentaire introuvable",
+	"Comments are disabled for this post":               "Les commentaires sont désactivés pour cet article",
+	"Content contains personal data or profanity":       "Le contenu comporte des données personnelles ou des grossièretés",
+	"Content is too short":                              "Le contenu est trop court",
+	"Database already contains blog posts":              "La base de données contient déjà des articles",
+	"Draft not found":                                   "Brouillon introuvable",
+	"Excerpt is too long":                               "L'extrait est trop long",
+	"Failed to check for duplicate content":             "Impossible de vérifier les contenus en double",
+	"Failed to check slug availability":                 "Impossible de vérifier la disponibilité du slug",
+	"Failed to compute post totals":                     "Impossible de calculer les totaux des articles",
+	"Failed to compute publishing history":              "Impossible de calculer l'historique de publication",
+	"Failed to confirm subscription":                    "Impossible de confirmer l'abonnement",
+	"Failed to count blogs":                             "Impossible de compter les articles",
+	"Failed to count comments":                          "Impossible de compter les commentaires",
+	"Failed to count featured blogs":                    "Impossible de compter les articles à la une",
+	"Failed to create blog post":                        "Impossible de créer l'article",
+	"Failed to create comment":                          "Impossible de créer le commentaire",
+	"Failed to create series":                           "Échec de la création de la série",
+	"Failed to create subscription":                     "Impossible de créer l'abonnement",
+	"Failed to delete blog post":                        "Impossible de supprimer l'article",
+	"Failed to delete blog posts":                       "Impossible de supprimer les articles",
+	"Failed to encode blog post":                        "Impossible d'encoder l'article",
+	"Failed to encode blogs":                            "Impossible d'encoder les articles",
+	"Failed to encode feed":                             "Impossible de générer le flux",
+	"Failed to fetch accessibility statement":           "Échec de la récupération de la déclaration d’accessibilité",
+	"Failed to fetch archive":                           "Échec de la récupération des archives",
+	"Failed to fetch authors":                           "Impossible de récupérer les auteurs",
+	"Failed to fetch blog post":                         "Impossible de récupérer l'article",
+	"Failed to fetch blog posts":                        "Impossible de récupérer les articles",
+	"Failed to fetch blogs":                             "Impossible de récupérer les articles",
+	"Failed to fetch comments":                          "Impossible de récupérer les commentaires",
+	"Failed to fetch draft":                             "Échec de la récupération du brouillon",
+	"Failed to fetch popular blogs":                     "Impossible de récupérer les articles populaires",
+	"Failed to fetch related posts":                     "Échec de la récupération des articles associés",
+	"Failed to fetch revision":                          "Impossible de récupérer la révision",
+	"Failed to fetch revisions":                         "Impossible de récupérer les révisions",
+	"Failed to fetch seed status":                       "Impossible de récupérer l'état des données d'exemple",
+	"Failed to fetch series":                            "Échec de la récupération de la série",
+	"Failed to fetch subscription":                      "Impossible de récupérer l'abonnement",
+	"Failed to fetch suggestions":                       "Impossible de récupérer les suggestions",
+	"Failed to fetch tags":                              "Impossible de récupérer les étiquettes",
+	"Failed to fetch translations":                      "Impossible de récupérer les traductions",
+	"Failed to fetch trending blogs":                    "Impossible de récupérer les articles tendance",
+	"Failed to fetch updated blog post":                 "Impossible de récupérer l'article mis à jour",
+	"Failed to find an available slug":                  "Aucun slug disponible trouvé",
+	"Failed to generate a unique slug":                  "Impossible de générer un slug unique",
+	"Failed to read request body":                       "Impossible de lire le corps de la requête",
+	"Failed to restore revision":                        "Impossible de restaurer la révision",
+	"Failed to save draft":                              "Échec de l’enregistrement du brouillon",
+	"Failed to save revision":                           "Impossible d'enregistrer la révision",
+	"Failed to seed database":                           "Impossible de charger les données d'exemple",
+	"Failed to unsubscribe":                             "Impossible de se désabonner",
+	"Failed to update accessibility statement":          "Échec de la mise à jour de la déclaration d’accessibilité",
+	"Failed to update blog post":                        "Impossible de mettre à jour l'article",
+	"Failed to update blog posts":                       "Impossible de mettre à jour les articles",
+	"Failed to update comment":                          "Impossible de mettre à jour le commentaire",
+	"Featured image alt text required":                  "Texte alternatif de l'image à la une requis",
+	"Featured image alt text too long":                  "Texte alternatif de l'image à la une trop long",
+	"Invalid CSP report":                                "Rapport CSP invalide",
+	"Invalid Idempotency-Key":                           "Idempotency-Key invalide",
+	"Invalid If-Match header":                           "En-tête If-Match invalide",
+	"Invalid blog ID":                                   "ID d'article invalide",
+	"Invalid comment ID":                                "ID de commentaire invalide",
+	"Invalid days":                                      "Nombre de jours invalide",
+	"Invalid email address":                             "Adresse e-mail invalide",
+	"Invalid featured image":                            "Image à la une invalide",
+	"Invalid fields":                                    "Champs invalides",
+	"Invalid from date":                                 "Date de début invalide",
+	"Invalid language":                                  "Langue invalide",
+	"Invalid limit":                                     "Limite invalide",
+	"Invalid max_tags":                                  "max_tags non valide",
+	"Invalid month":                                     "Mois invalide",
+	"Invalid page":                                      "Page invalide",
+	"Invalid parent comment":                            "Commentaire parent invalide",
+	"Invalid preview token":                             "Jeton d'aperçu invalide",
+	"Invalid published filter":                          "Filtre de publication non valide",
+	"Invalid request data":                              "Données de requête invalides",
+	"Invalid revision version":                          "Version de révision invalide",
+	"Invalid series":                                    "Série invalide",
+	"Invalid since date":                                "Date de dernière visite invalide",
+	"Invalid sort":                                      "Tri invalide",
+	"Invalid status":                                    "Statut invalide",
+	"Invalid subscription token":                        "Jeton d'abonnement invalide",
+	"Invalid tag mode":                                  "Mode d’étiquettes invalide",
+	"Invalid tags":                                      "Étiquettes invalides",
+	"Invalid to date":                                   "Date de fin invalide",
+	"Invalid translation group":                         "Groupe de traduction invalide",
+	"Invalid year":                                      "Année invalide",
+	"Maximum number of featured posts reached":          "Nombre maximal d'articles à la une atteint",
+	"Request body too large":                            "Corps de la requête trop volumineux",
+	"Request timed out":                                 "Délai de la requête dépassé",
+	"Revision not found":                                "Révision introuvable",
+	"Series not found":                                  "Série introuvable",
+	"Subscription not found":                            "Abonnement introuvable",
+	"Tag not found":                                     "Étiquette introuvable",
+	"Title does not produce a valid slug":               "Le titre ne produit pas de slug valide",
+	"Title is required":                                 "Le titre est obligatoire",
+	"Too many requests":                                 "Trop de requêtes",
+	"Unauthorized":                                      "Non autorisé",
+	"Version required":                                  "Version requise",
+}
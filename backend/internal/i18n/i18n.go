@@ -0,0 +1,116 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultLocale is used when a client accepts none of the supported locales
+const DefaultLocale = "en"
+
+// localeKey is the gin context key holding the negotiated locale
+const localeKey = "locale"
+
+// catalogs maps a locale to its translations, keyed by the English message.
+// English needs no catalog because messages are written in English.
+var catalogs = map[string]map[string]string{
+	"es": spanish,
+	"fr": french,
+}
+
+// Supported returns the locales messages can be rendered in, default first
+func Supported() []string {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return append([]string{DefaultLocale}, locales...)
+}
+
+// IsSupported reports whether messages can be rendered in locale
+func IsSupported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok || locale == DefaultLocale
+}
+
+// languageRange is one weighted entry of an Accept-Language header
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// Match picks the best supported locale for an Accept-Language header such as
+// "es-MX,es;q=0.9,en;q=0.8". Region subtags match their base language, ranges
+// with q=0 are excluded, and DefaultLocale is returned when nothing matches.
+func Match(acceptLanguage string) string {
+	var ranges []languageRange
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+				quality = q
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		ranges = append(ranges, languageRange{tag: tag, quality: quality})
+	}
+
+	// Earlier ranges win ties, as listed by the client
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, r := range ranges {
+		if r.tag == "*" {
+			return DefaultLocale
+		}
+		base := strings.FieldsFunc(r.tag, func(c rune) bool { return c == '-' || c == '_' })
+		if len(base) > 0 && IsSupported(base[0]) {
+			return base[0]
+		}
+	}
+	return DefaultLocale
+}
+
+// Translate renders an English message in locale, falling back to the English
+// text when the locale or message has no translation
+func Translate(locale, message string) string {
+	if translated, ok := catalogs[locale][message]; ok {
+		return translated
+	}
+	return message
+}
+
+// SetLocale stores the negotiated locale on the request context
+func SetLocale(c *gin.Context, locale string) {
+	c.Set(localeKey, locale)
+}
+
+// Locale returns the negotiated locale of the request, or DefaultLocale
+func Locale(c *gin.Context) string {
+	if locale := c.GetString(localeKey); locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// T translates an English message into the locale negotiated for the request
+func T(c *gin.Context, message string) string {
+	return Translate(Locale(c), message)
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", DefaultLocale},
+		{"es", "es"},
+		{"es-MX", "es"},
+		{"FR-ca", "fr"},
+		{"fr_FR", "fr"},
+		{"de-DE,es;q=0.5", "es"},
+		{"es;q=0.8,fr;q=0.9", "fr"},
+		{"fr,es", "fr"},                  // Equal weights keep the client's order
+		{"es;q=0,fr;q=0.1", "fr"},        // q=0 excludes a language
+		{"en-GB,es;q=0.9", "en"},         // English needs no catalog
+		{"de,ja;q=0.5", DefaultLocale},   // Nothing supported
+		{"*", DefaultLocale},             // Any language
+		{"es;q=abc", "es"},               // Malformed weights count as 1
+		{" , ;q=0.5, es ", "es"},         // Empty ranges skipped
+		{"es;q=0", DefaultLocale},        // Only an excluded language
+		{"pt-BR,*;q=0.5", DefaultLocale}, // Wildcard before any supported language
+	}
+	for _, tt := range tests {
+		if got := Match(tt.header); got != tt.want {
+			t.Errorf("Match(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	if got := Translate("es", "Blog post not found"); got != "Entrada no encontrada" {
+		t.Errorf("Spanish = %q", got)
+	}
+	if got := Translate("fr", "Blog post not found"); got != "Article introuvable" {
+		t.Errorf("French = %q", got)
+	}
+	for _, locale := range []string{"en", "de", ""} {
+		if got := Translate(locale, "Blog post not found"); got != "Blog post not found" {
+			t.Errorf("locale %q = %q, want the English message", locale, got)
+		}
+	}
+	if got := Translate("es", "A message nobody translated"); got != "A message nobody translated" {
+		t.Errorf("untranslated message = %q, want it unchanged", got)
+	}
+}
+
+func TestCatalogsCoverTheSameMessages(t *testing.T) {
+	if want := []string{"en", "es", "fr"}; !reflect.DeepEqual(Supported(), want) {
+		t.Errorf("Supported() = %v, want %v", Supported(), want)
+	}
+	for locale, catalog := range catalogs {
+		for message := range spanish {
+			if catalog[message] == "" {
+				t.Errorf("%s catalog has no translation for %q", locale, message)
+			}
+		}
+		if len(catalog) != len(spanish) {
+			t.Errorf("%s catalog has %d messages, Spanish has %d", locale, len(catalog), len(spanish))
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPort is the SMTP submission port used when SMTP_PORT is unset
+	DefaultPort = "587"
+
+	// queueSize is the number of emails buffered before new emails are dropped
+	queueSize = 1024
+
+	// maxAttempts is the number of send attempts per email
+	maxAttempts = 3
+
+	// initialBackoff is the delay before the first retry, doubled on each attempt
+	initialBackoff = 2 * time.Second
+)
+
+// Config holds the SMTP server settings
+type Config struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// Message is a plain-text email to a single recipient
+type Message struct {
+	To             string
+	Subject        string
+	Body           string
+	UnsubscribeURL string // Sent as List-Unsubscribe when set
+}
+
+// Mailer sends queued emails over SMTP in the background
+type Mailer struct {
+	config  Config
+	queue   chan Message
+	backoff time.Duration
+}
+
+// New creates a mailer and starts its background worker. Without an SMTP host
+// emails are logged instead of sent.
+func New(config Config) *Mailer {
+	if config.Port == "" {
+		config.Port = DefaultPort
+	}
+	if config.From == "" {
+		config.From = config.Username
+	}
+	m := &Mailer{
+		config:  config,
+		queue:   make(chan Message, queueSize),
+		backoff: initialBackoff,
+	}
+	if m.Enabled() {
+		go m.run()
+	} else {
+		log.Println("SMTP_HOST not set; emails will be logged instead of sent")
+	}
+	return m
+}
+
+// Enabled reports whether an SMTP server is configured
+func (m *Mailer) Enabled() bool {
+	return m != nil && m.config.Host != ""
+}
+
+// Send queues an email without blocking the caller
+func (m *Mailer) Send(msg Message) {
+	if m == nil {
+		return
+	}
+	if !m.Enabled() {
+		log.Printf("Email to %s not sent (SMTP not configured): %s", msg.To, msg.Subject)
+		return
+	}
+
+	select {
+	case m.queue <- msg:
+	default:
+		log.Printf("Email queue full, dropping email to %s: %s", msg.To, msg.Subject)
+	}
+}
+
+// run sends queued emails one at a time until the queue is closed
+func (m *Mailer) run() {
+	for msg := range m.queue {
+		m.deliver(msg)
+	}
+}
+
+// deliver sends a single email, retrying with exponential backoff
+func (m *Mailer) deliver(msg Message) {
+	data, err := m.encode(msg)
+	if err != nil {
+		log.Printf("Failed to encode email to %s: %v", msg.To, err)
+		return
+	}
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+	addr := net.JoinHostPort(m.config.Host, m.config.Port)
+
+	backoff := m.backoff
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err := smtp.SendMail(addr, auth, m.config.From, []string{msg.To}, data)
+		if err == nil {
+			return
+		}
+		if attempt == maxAttempts {
+			log.Printf("Email to %s failed after %d attempts: %v", msg.To, attempt, err)
+			return
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// encode renders msg as an RFC 5322 message with a quoted-printable UTF-8 body
+func (m *Mailer) encode(msg Message) ([]byte, error) {
+	for _, value := range []string{msg.To, msg.Subject, msg.UnsubscribeURL} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, errors.New("header value contains a line break")
+		}
+	}
+
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		buf.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", m.config.From)
+	header("To", msg.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().UTC().Format(time.RFC1123Z))
+	header("Message-ID", "<"+uuid.New().String()+"@"+m.config.Host+">")
+	if msg.UnsubscribeURL != "" {
+		header("List-Unsubscribe", "<"+msg.UnsubscribeURL+">")
+		header("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+	if _, err := body.Write([]byte(msg.Body)); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
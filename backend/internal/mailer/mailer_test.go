@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	m := &Mailer{config: Config{Host: "smtp.example.com", From: "blog@example.com"}}
+	data, err := m.encode(Message{
+		To:             "reader@example.com",
+		Subject:        "New post: Café accessibility",
+		Body:           "Read it at https://example.com/blog/cafe?ref=email\n",
+		UnsubscribeURL: "https://example.com/api/v1/subscribers/unsubscribe?token=abc",
+	})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	headers := map[string]string{
+		"From":                  "blog@example.com",
+		"To":                    "reader@example.com",
+		"List-Unsubscribe":      "<https://example.com/api/v1/subscribers/unsubscribe?token=abc>",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		"Content-Type":          "text/plain; charset=UTF-8",
+	}
+	for name, want := range headers {
+		if got := msg.Header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject")); subject != "New post: Café accessibility" {
+		t.Errorf("Subject = %q", subject)
+	}
+	body, _ := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	if string(body) != "Read it at https://example.com/blog/cafe?ref=email\r\n" {
+		t.Errorf("body = %q", body)
+	}
+
+	for _, bad := range []Message{
+		{To: "reader@example.com\r\nBcc: other@example.com", Subject: "Hi"},
+		{To: "reader@example.com", Subject: "Hi\nBcc: other@example.com"},
+	} {
+		if _, err := m.encode(bad); err == nil {
+			t.Errorf("encode(%q, %q) succeeded, want a header injection error", bad.To, bad.Subject)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+)
+
+// APIKeyHeader is the header carrying the admin API key
+const APIKeyHeader = "X-API-Key"
+
+// RequireAPIKey rejects requests that don't present apiKey in the X-API-Key
+// header or as an "Authorization: Bearer" token. With no key configured the
+// protected routes are disabled entirely.
+func RequireAPIKey(apiKey string) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if apiKey == "" {
+			apierror.Abort(c, http.StatusServiceUnavailable, "Admin API disabled", "Set API_KEY to enable authenticated endpoints")
+			return
+		}
+
+		if !HasAPIKey(c, apiKey) {
+			c.Header("WWW-Authenticate", `Bearer realm="api"`)
+			apierror.Abort(c, http.StatusUnauthorized, "Unauthorized", "A valid API key is required")
+			return
+		}
+
+		c.Next()
+	})
+}
+
+// HasAPIKey reports whether the request presents apiKey, for handlers that
+// show more to authenticated callers instead of rejecting anonymous ones
+func HasAPIKey(c *gin.Context, apiKey string) bool {
+	return apiKey != "" && subtle.ConstantTimeCompare([]byte(presentedAPIKey(c)), []byte(apiKey)) == 1
+}
+
+// presentedAPIKey returns the key sent with the request, if any
+func presentedAPIKey(c *gin.Context) string {
+	if key := c.GetHeader(APIKeyHeader); key != "" {
+		return key
+	}
+	if auth := c.GetHeader("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	return ""
+}
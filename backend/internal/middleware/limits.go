@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+)
+
+// DefaultMaxBodyBytes is the request body limit used when none is configured
+const DefaultMaxBodyBytes int64 = 1 << 20 // 1MB
+
+// MaxBodySize rejects write requests whose body exceeds limit bytes with 413.
+// Read-only methods pass through untouched.
+func MaxBodySize(limit int64) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			c.Next()
+			return
+		}
+
+		// Reject early when the declared length is already too large
+		if c.Request.ContentLength > limit {
+			abortTooLarge(c, limit)
+			return
+		}
+
+		// Buffer the body through a capped reader to catch chunked or misdeclared uploads
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, limit))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				abortTooLarge(c, limit)
+				return
+			}
+			apierror.Abort(c, http.StatusBadRequest, "Failed to read request body")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		c.Next()
+	})
+}
+
+// abortTooLarge writes a 413 response describing the body limit
+func abortTooLarge(c *gin.Context, limit int64) {
+	apierror.AbortWith(c, http.StatusRequestEntityTooLarge, "Request body too large",
+		gin.H{"max_bytes": limit},
+		"Request bodies must not exceed "+strconv.FormatInt(limit, 10)+" bytes")
+}
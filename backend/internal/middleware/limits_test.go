@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMaxBodySize(t *testing.T) {
+	const limit = 64
+	router := gin.New()
+	router.Use(MaxBodySize(limit))
+	echo := func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, "%d", len(body))
+	}
+	router.POST("/blogs", echo)
+	router.PUT("/blogs/1", echo)
+	router.GET("/blogs", echo)
+
+	tests := []struct {
+		name    string
+		method  string
+		size    int
+		chunked bool
+		want    int
+	}{
+		{"at the limit", http.MethodPost, limit, false, http.StatusOK},
+		{"declared oversized", http.MethodPost, limit + 1, false, http.StatusRequestEntityTooLarge},
+		{"chunked oversized", http.MethodPut, 10 * limit, true, http.StatusRequestEntityTooLarge},
+		{"chunked within the limit", http.MethodPut, limit / 2, true, http.StatusOK},
+		{"read route", http.MethodGet, 10 * limit, false, http.StatusOK},
+	}
+	for _, tt := range tests {
+		path := "/blogs"
+		if tt.method == http.MethodPut {
+			path = "/blogs/1"
+		}
+		var body io.Reader = strings.NewReader(strings.Repeat("x", tt.size))
+		if tt.chunked {
+			// Hide the length so the body is only caught while read
+			body = io.MultiReader(body)
+		}
+		req := httptest.NewRequest(tt.method, path, body)
+		if tt.chunked {
+			req.ContentLength = -1
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, w.Code, tt.want)
+			continue
+		}
+		if tt.want == http.StatusOK {
+			if got := w.Body.String(); got != strconv.Itoa(tt.size) {
+				t.Errorf("%s: handler read %s bytes, want %d", tt.name, got, tt.size)
+			}
+			continue
+		}
+		var resp struct {
+			Code     string `json:"code"`
+			MaxBytes int64  `json:"max_bytes"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s: decode %q: %v", tt.name, w.Body.String(), err)
+		}
+		if resp.Code != "payload_too_large" || resp.MaxBytes != limit {
+			t.Errorf("%s: body = %+v, want payload_too_large with max_bytes %d", tt.name, resp, limit)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/i18n"
+)
+
+// Locale negotiates the response language from Accept-Language, making it
+// available to handlers through i18n.T and advertising it in Content-Language
+func Locale() gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		locale := i18n.Match(c.GetHeader("Accept-Language"))
+		i18n.SetLocale(c, locale)
+		c.Header("Content-Language", locale)
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		c.Next()
+	})
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+)
+
+func TestLocaleTranslatesErrors(t *testing.T) {
+	router := gin.New()
+	router.Use(Locale())
+	router.GET("/blogs/:slug", func(c *gin.Context) {
+		apierror.Respond(c, http.StatusNotFound, "Blog post not found")
+	})
+
+	tests := []struct {
+		acceptLanguage string
+		locale         string
+		message        string
+	}{
+		{"es-ES,es;q=0.9", "es", "Entrada no encontrada"},
+		{"fr-CA", "fr", "Article introuvable"},
+		{"de-DE,fr;q=0.5", "fr", "Article introuvable"},
+		{"ja", "en", "Blog post not found"},
+		{"", "en", "Blog post not found"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/blogs/missing", nil)
+		if tt.acceptLanguage != "" {
+			r.Header.Set("Accept-Language", tt.acceptLanguage)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("%q: status = %d, want 404", tt.acceptLanguage, w.Code)
+		}
+		if got := w.Header().Get("Content-Language"); got != tt.locale {
+			t.Errorf("%q: Content-Language = %q, want %q", tt.acceptLanguage, got, tt.locale)
+		}
+		if got := w.Header().Get("Vary"); got != "Accept-Language" {
+			t.Errorf("%q: Vary = %q, want Accept-Language", tt.acceptLanguage, got)
+		}
+
+		var body map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("%q: decode %q: %v", tt.acceptLanguage, w.Body.String(), err)
+		}
+		if body["error"] != tt.message {
+			t.Errorf("%q: error = %v, want %q", tt.acceptLanguage, body["error"], tt.message)
+		}
+		// Only the message is translated; keys and codes stay stable
+		keys := make([]string, 0, len(body))
+		for key := range body {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		if want := []string{"code", "details", "error"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("%q: keys = %v, want %v", tt.acceptLanguage, keys, want)
+		}
+		if body["code"] != apierror.CodeFor(http.StatusNotFound) {
+			t.Errorf("%q: code = %v, want %q", tt.acceptLanguage, body["code"], apierror.CodeFor(http.StatusNotFound))
+		}
+	}
+}
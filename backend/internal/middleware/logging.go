@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader is the header used to propagate request IDs
+	RequestIDHeader = "X-Request-ID"
+
+	// requestIDKey is the gin context key holding the request ID
+	requestIDKey = "request_id"
+
+	// maxRequestIDLength caps inbound request IDs to keep log lines bounded
+	maxRequestIDLength = 128
+)
+
+// DefaultLogSkipPaths are the frequently polled probe paths left out of the
+// access log when LOG_SKIP_PATHS is not set
+var DefaultLogSkipPaths = []string{"/api/v1/health", "/api/v1/ready"}
+
+// accessLogEntry is a single structured access log line
+type accessLogEntry struct {
+	Time      string  `json:"time"`
+	Level     string  `json:"level"`
+	RequestID string  `json:"request_id"`
+	Method    string  `json:"method"`
+	Path      string  `json:"path"`
+	Query     string  `json:"query,omitempty"`
+	Status    int     `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+	ClientIP  string  `json:"client_ip"`
+	UserAgent string  `json:"user_agent,omitempty"`
+	Errors    string  `json:"errors,omitempty"`
+}
+
+// RequestID assigns each request an ID, reusing an inbound X-Request-ID when present
+func RequestID() gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.New().String()
+		}
+
+		c.Set(requestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+
+		c.Next()
+	})
+}
+
+// GetRequestID returns the request ID assigned by the RequestID middleware
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+// JSONLogger logs each request as a single JSON line to stdout, except
+// requests to skipPaths
+func JSONLogger(skipPaths ...string) gin.HandlerFunc {
+	return JSONLoggerWithWriter(os.Stdout, skipPaths...)
+}
+
+// JSONLoggerWithWriter logs each request as a single JSON line to the given
+// writer. Requests to skipPaths, matched exactly, are only logged when they
+// fail with a server error, so a failing probe still shows up.
+func JSONLoggerWithWriter(out io.Writer, skipPaths ...string) gin.HandlerFunc {
+	var mu sync.Mutex
+	skip := make(map[string]bool, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = true
+	}
+
+	return gin.HandlerFunc(func(c *gin.Context) {
+		start := time.Now()
+		path := c.Request.URL.Path
+		query := c.Request.URL.RawQuery
+
+		c.Next()
+
+		status := c.Writer.Status()
+		if skip[path] && status < http.StatusInternalServerError {
+			return
+		}
+
+		level := "info"
+		if status >= http.StatusInternalServerError {
+			level = "error"
+		} else if status >= http.StatusBadRequest {
+			level = "warn"
+		}
+
+		entry := accessLogEntry{
+			Time:      start.UTC().Format(time.RFC3339Nano),
+			Level:     level,
+			RequestID: GetRequestID(c),
+			Method:    c.Request.Method,
+			Path:      path,
+			Query:     query,
+			Status:    status,
+			LatencyMS: float64(time.Since(start).Microseconds()) / 1000.0,
+			ClientIP:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			Errors:    c.Errors.ByType(gin.ErrorTypePrivate).String(),
+		}
+
+		line, err := json.Marshal(entry)
+		if err != nil {
+			log.Printf("Failed to encode access log: %v", err)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if _, err := out.Write(append(line, '\n')); err != nil {
+			log.Printf("Failed to write access log: %v", err)
+		}
+	})
+}
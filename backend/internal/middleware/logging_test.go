@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// loggedPaths returns the path of every access log line in out
+func loggedPaths(t *testing.T, out *bytes.Buffer) []string {
+	t.Helper()
+	paths := []string{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry accessLogEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("decode log line %q: %v", line, err)
+		}
+		paths = append(paths, entry.Path)
+	}
+	return paths
+}
+
+func TestJSONLoggerSkipPaths(t *testing.T) {
+	var out bytes.Buffer
+	router := gin.New()
+	router.Use(RequestID(), JSONLoggerWithWriter(&out, DefaultLogSkipPaths...))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v1/health", ok)
+	router.GET("/api/v1/health/details", ok)
+	router.GET("/api/v1/ready", func(c *gin.Context) { c.Status(http.StatusServiceUnavailable) })
+	router.GET("/api/v1/blogs", ok)
+
+	for _, path := range []string{"/api/v1/health", "/api/v1/health?verbose=1", "/api/v1/health/details", "/api/v1/blogs", "/api/v1/missing"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	want := []string{"/api/v1/health/details", "/api/v1/blogs", "/api/v1/missing"}
+	if got := loggedPaths(t, &out); strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("logged %q, want %q", got, want)
+	}
+
+	// A skipped probe that fails with a server error is still logged
+	out.Reset()
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/ready", nil))
+	if got := loggedPaths(t, &out); len(got) != 1 || got[0] != "/api/v1/ready" {
+		t.Errorf("failing probe logged %q, want /api/v1/ready", got)
+	}
+}
+
+func TestJSONLoggerEntry(t *testing.T) {
+	var out bytes.Buffer
+	router := gin.New()
+	router.Use(RequestID(), JSONLoggerWithWriter(&out))
+	router.GET("/api/v1/health", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/health?verbose=1", nil)
+	r.Header.Set(RequestIDHeader, "probe-1")
+	r.Header.Set("User-Agent", "kube-probe/1.29")
+	router.ServeHTTP(httptest.NewRecorder(), r)
+
+	var entry accessLogEntry
+	if err := json.Unmarshal(out.Bytes(), &entry); err != nil {
+		t.Fatalf("decode log line %q: %v", out.String(), err)
+	}
+	if entry.Level != "info" || entry.RequestID != "probe-1" || entry.Method != http.MethodGet || entry.Path != "/api/v1/health" ||
+		entry.Query != "verbose=1" || entry.Status != http.StatusOK || entry.UserAgent != "kube-probe/1.29" {
+		t.Errorf("entry = %+v", entry)
+	}
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+)
+
+// RateLimiter counts requests per key in fixed time windows
+type RateLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	window  time.Duration
+	windows map[string]*rateWindow
+	swept   time.Time
+}
+
+// rateWindow tracks one key's requests in the current window
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// NewRateLimiter allows up to limit requests per key in each window. A limit
+// of zero or less disables limiting.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]*rateWindow),
+		swept:   time.Now(),
+	}
+}
+
+// Allow records a request for key, returning false and the time until the
+// window resets once the limit has been reached
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	if l == nil || l.limit <= 0 {
+		return true, 0
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Drop expired windows now and then so idle clients don't accumulate
+	if now.Sub(l.swept) > l.window {
+		for k, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, k)
+			}
+		}
+		l.swept = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &rateWindow{start: now}
+		l.windows[key] = w
+	}
+
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
+
+// RateLimit rejects requests beyond the limiter's per-IP limit with 429
+func RateLimit(limiter *RateLimiter) gin.HandlerFunc {
+	return RateLimitIf(limiter, nil)
+}
+
+// RateLimitIf applies the limiter's per-IP limit only to requests matching
+// the predicate; a nil predicate matches every request
+func RateLimitIf(limiter *RateLimiter, match func(*gin.Context) bool) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if match != nil && !match(c) {
+			c.Next()
+			return
+		}
+
+		if ok, retryAfter := limiter.Allow(c.ClientIP()); !ok {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			if seconds < 1 {
+				seconds = 1
+			}
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			apierror.AbortWith(c, http.StatusTooManyRequests, "Too many requests",
+				gin.H{"retry_after": seconds},
+				"Rate limit exceeded, retry after "+strconv.Itoa(seconds)+" seconds")
+			return
+		}
+
+		c.Next()
+	})
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRateLimitIfSearch(t *testing.T) {
+	const limit = 3
+	router := gin.New()
+	router.GET("/blogs", RateLimitIf(NewRateLimiter(limit, time.Minute), func(c *gin.Context) bool {
+		return strings.TrimSpace(c.Query("search")) != ""
+	}), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	get := func(path, ip string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.RemoteAddr = ip + ":1234"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	for i := 0; i < limit; i++ {
+		if w := get("/blogs?search=term"+strconv.Itoa(i), "192.0.2.1"); w.Code != http.StatusOK {
+			t.Fatalf("search %d: status = %d, want %d", i+1, w.Code, http.StatusOK)
+		}
+	}
+
+	w := get("/blogs?search=another", "192.0.2.1")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("search over the limit: status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	if seconds, err := strconv.Atoi(w.Header().Get("Retry-After")); err != nil || seconds < 1 || seconds > 60 {
+		t.Errorf("Retry-After = %q, want 1-60 seconds", w.Header().Get("Retry-After"))
+	}
+
+	// Plain listings, blank searches and other clients are unaffected
+	for _, path := range []string{"/blogs", "/blogs?page=2", "/blogs?search=%20"} {
+		if w := get(path, "192.0.2.1"); w.Code != http.StatusOK {
+			t.Errorf("%s: status = %d, want %d", path, w.Code, http.StatusOK)
+		}
+	}
+	if w := get("/blogs?search=term", "192.0.2.2"); w.Code != http.StatusOK {
+		t.Errorf("search from another IP: status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	limiter := NewRateLimiter(0, time.Minute)
+	for i := 0; i < 100; i++ {
+		if ok, _ := limiter.Allow("192.0.2.1"); !ok {
+			t.Fatalf("request %d rejected by a disabled limiter", i+1)
+		}
+	}
+}
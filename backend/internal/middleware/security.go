@@ -1,24 +1,108 @@
 package middleware
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 )
 
-// SecurityHeaders adds security headers to all responses
+// DefaultContentSecurityPolicy is the CSP sent when none is configured
+const DefaultContentSecurityPolicy = "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline' fonts.googleapis.com; font-src 'self' fonts.gstatic.com; img-src 'self' data: https:; connect-src 'self'"
+
+// HeaderDisabled is the configuration value that turns a security header off
+const HeaderDisabled = "off"
+
+// HSTSDisabled is the HSTSMaxAge that turns Strict-Transport-Security off
+const HSTSDisabled = -1
+
+// SecurityHeaderConfig holds the configurable security header values. A
+// header whose value is HeaderDisabled is not sent.
+type SecurityHeaderConfig struct {
+	FrameOptions   string // X-Frame-Options: DENY or SAMEORIGIN
+	ReferrerPolicy string // Referrer-Policy, e.g. strict-origin-when-cross-origin
+	HSTSMaxAge     int    // Strict-Transport-Security max-age in seconds, or HSTSDisabled
+	CSP            string // Content-Security-Policy
+	CSPReportOnly  bool   // Send the CSP as Content-Security-Policy-Report-Only
+}
+
+// DefaultSecurityHeaderConfig returns the headers sent when none are configured
+func DefaultSecurityHeaderConfig() SecurityHeaderConfig {
+	return SecurityHeaderConfig{
+		FrameOptions:   "DENY",
+		ReferrerPolicy: "strict-origin-when-cross-origin",
+		HSTSMaxAge:     31536000,
+		CSP:            DefaultContentSecurityPolicy,
+	}
+}
+
+// frameOptions are the X-Frame-Options values browsers support
+var frameOptions = map[string]bool{"DENY": true, "SAMEORIGIN": true}
+
+// referrerPolicies are the values defined by the Referrer Policy specification
+var referrerPolicies = map[string]bool{
+	"no-referrer": true, "no-referrer-when-downgrade": true, "origin": true, "origin-when-cross-origin": true,
+	"same-origin": true, "strict-origin": true, "strict-origin-when-cross-origin": true, "unsafe-url": true,
+}
+
+// Validate reports a header value outside the allowed set
+func (c SecurityHeaderConfig) Validate() error {
+	if c.FrameOptions != HeaderDisabled && !frameOptions[strings.ToUpper(c.FrameOptions)] {
+		return fmt.Errorf("X-Frame-Options must be DENY, SAMEORIGIN or %s, got %q", HeaderDisabled, c.FrameOptions)
+	}
+	if c.ReferrerPolicy != HeaderDisabled && !referrerPolicies[strings.ToLower(c.ReferrerPolicy)] {
+		return fmt.Errorf("Referrer-Policy %q is not a valid policy", c.ReferrerPolicy)
+	}
+	if c.HSTSMaxAge < 0 && c.HSTSMaxAge != HSTSDisabled {
+		return fmt.Errorf("HSTS max-age must be a non-negative number of seconds, got %d", c.HSTSMaxAge)
+	}
+	return nil
+}
+
+// SecurityHeaders adds security headers to all responses using the default CSP
 func SecurityHeaders() gin.HandlerFunc {
+	return SecurityHeadersWithCSP(DefaultContentSecurityPolicy, false)
+}
+
+// SecurityHeadersWithCSP adds security headers to all responses with the given
+// CSP. In report-only mode the policy is sent as
+// Content-Security-Policy-Report-Only so violations are reported but not blocked.
+func SecurityHeadersWithCSP(policy string, reportOnly bool) gin.HandlerFunc {
+	config := DefaultSecurityHeaderConfig()
+	config.CSP = policy
+	config.CSPReportOnly = reportOnly
+	return SecurityHeadersWithConfig(config)
+}
+
+// SecurityHeadersWithConfig adds the configured security headers to all
+// responses. The configuration must already be valid.
+func SecurityHeadersWithConfig(config SecurityHeaderConfig) gin.HandlerFunc {
+	cspHeader := "Content-Security-Policy"
+	if config.CSPReportOnly {
+		cspHeader = "Content-Security-Policy-Report-Only"
+	}
+	frame := strings.ToUpper(config.FrameOptions)
+	referrer := strings.ToLower(config.ReferrerPolicy)
+	hsts := "max-age=" + strconv.Itoa(config.HSTSMaxAge) + "; includeSubDomains"
+
 	return gin.HandlerFunc(func(c *gin.Context) {
 		// Security headers
 		c.Header("X-Content-Type-Options", "nosniff")
-		c.Header("X-Frame-Options", "DENY")
+		if config.FrameOptions != HeaderDisabled {
+			c.Header("X-Frame-Options", frame)
+		}
 		c.Header("X-XSS-Protection", "1; mode=block")
-		c.Header("Referrer-Policy", "strict-origin-when-cross-origin")
-		c.Header("Content-Security-Policy", "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline' fonts.googleapis.com; font-src 'self' fonts.gstatic.com; img-src 'self' data: https:; connect-src 'self'")
-		
+		if config.ReferrerPolicy != HeaderDisabled {
+			c.Header("Referrer-Policy", referrer)
+		}
+		c.Header(cspHeader, config.CSP)
+
 		// HSTS header for HTTPS
-		if c.Request.TLS != nil {
-			c.Header("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		if c.Request.TLS != nil && config.HSTSMaxAge != HSTSDisabled {
+			c.Header("Strict-Transport-Security", hsts)
 		}
-		
+
 		c.Next()
 	})
 }
@@ -33,12 +117,12 @@ func AccessibilityHeaders() gin.HandlerFunc {
 		c.Header("X-Keyboard-Navigation", "enabled")
 		c.Header("X-High-Contrast-Support", "available")
 		c.Header("X-Reduced-Motion-Support", "available")
-		
+
 		// Cache control for accessibility resources
 		if c.Request.URL.Path == "/api/v1/accessibility" {
 			c.Header("Cache-Control", "public, max-age=3600")
 		}
-		
+
 		c.Next()
 	})
 }
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// securityResponse serves a request through the given security headers middleware
+func securityResponse(handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.Use(handler)
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	return w
+}
+
+func TestSecurityHeadersCSPMode(t *testing.T) {
+	const policy = "default-src 'self'; font-src 'self'"
+	tests := []struct {
+		reportOnly bool
+		sent       string
+		absent     string
+	}{
+		{false, "Content-Security-Policy", "Content-Security-Policy-Report-Only"},
+		{true, "Content-Security-Policy-Report-Only", "Content-Security-Policy"},
+	}
+	for _, tt := range tests {
+		w := securityResponse(SecurityHeadersWithCSP(policy, tt.reportOnly))
+		if got := w.Header().Get(tt.sent); got != policy {
+			t.Errorf("report-only %v: %s = %q, want %q", tt.reportOnly, tt.sent, got, policy)
+		}
+		if got := w.Header().Get(tt.absent); got != "" {
+			t.Errorf("report-only %v: %s = %q, want it unset", tt.reportOnly, tt.absent, got)
+		}
+	}
+
+	w := securityResponse(SecurityHeaders())
+	if got := w.Header().Get("Content-Security-Policy"); got != DefaultContentSecurityPolicy {
+		t.Errorf("default CSP = %q, want %q", got, DefaultContentSecurityPolicy)
+	}
+}
+
+// securityHeadersOverTLS serves an HTTPS request through the given security headers middleware
+func securityHeadersOverTLS(handler gin.HandlerFunc) http.Header {
+	router := gin.New()
+	router.Use(handler)
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.TLS = &tls.ConnectionState{}
+	router.ServeHTTP(w, r)
+	return w.Header()
+}
+
+func TestSecurityHeadersConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func(*SecurityHeaderConfig)
+		want   map[string]string // An empty value means the header is not sent
+	}{
+		{"defaults", nil, map[string]string{
+			"X-Frame-Options":           "DENY",
+			"Referrer-Policy":           "strict-origin-when-cross-origin",
+			"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
+			"X-Content-Type-Options":    "nosniff",
+		}},
+		{"overridden", func(c *SecurityHeaderConfig) {
+			c.FrameOptions = "sameorigin"
+			c.ReferrerPolicy = "No-Referrer"
+			c.HSTSMaxAge = 600
+		}, map[string]string{
+			"X-Frame-Options":           "SAMEORIGIN",
+			"Referrer-Policy":           "no-referrer",
+			"Strict-Transport-Security": "max-age=600; includeSubDomains",
+		}},
+		{"zero max-age", func(c *SecurityHeaderConfig) { c.HSTSMaxAge = 0 }, map[string]string{
+			"Strict-Transport-Security": "max-age=0; includeSubDomains",
+		}},
+		{"disabled", func(c *SecurityHeaderConfig) {
+			c.FrameOptions = HeaderDisabled
+			c.ReferrerPolicy = HeaderDisabled
+			c.HSTSMaxAge = HSTSDisabled
+		}, map[string]string{
+			"X-Frame-Options":           "",
+			"Referrer-Policy":           "",
+			"Strict-Transport-Security": "",
+			"X-Content-Type-Options":    "nosniff",
+			"Content-Security-Policy":   DefaultContentSecurityPolicy,
+		}},
+	}
+	for _, tt := range tests {
+		config := DefaultSecurityHeaderConfig()
+		if tt.config != nil {
+			tt.config(&config)
+		}
+		if err := config.Validate(); err != nil {
+			t.Fatalf("%s: Validate: %v", tt.name, err)
+		}
+		header := securityHeadersOverTLS(SecurityHeadersWithConfig(config))
+		for name, want := range tt.want {
+			if got := header.Get(name); got != want {
+				t.Errorf("%s: %s = %q, want %q", tt.name, name, got, want)
+			}
+		}
+	}
+
+	// HSTS is only sent over HTTPS
+	if got := securityResponse(SecurityHeaders()).Header().Get("Strict-Transport-Security"); got != "" {
+		t.Errorf("plain HTTP Strict-Transport-Security = %q, want it unset", got)
+	}
+}
+
+func TestSecurityHeaderConfigValidate(t *testing.T) {
+	tests := []struct {
+		config func(*SecurityHeaderConfig)
+		valid  bool
+	}{
+		{func(c *SecurityHeaderConfig) { c.FrameOptions = "SameOrigin" }, true},
+		{func(c *SecurityHeaderConfig) { c.FrameOptions = "ALLOW-FROM https://example.com" }, false},
+		{func(c *SecurityHeaderConfig) { c.FrameOptions = "" }, false},
+		{func(c *SecurityHeaderConfig) { c.ReferrerPolicy = "unsafe-url" }, true},
+		{func(c *SecurityHeaderConfig) { c.ReferrerPolicy = "everywhere" }, false},
+		{func(c *SecurityHeaderConfig) { c.HSTSMaxAge = 0 }, true},
+		{func(c *SecurityHeaderConfig) { c.HSTSMaxAge = -2 }, false},
+	}
+	for i, tt := range tests {
+		config := DefaultSecurityHeaderConfig()
+		tt.config(&config)
+		if err := config.Validate(); (err == nil) != tt.valid {
+			t.Errorf("case %d (%+v): Validate() = %v, want valid %v", i, config, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"technoprise-blog-backend/internal/apierror"
+)
+
+// DefaultRequestTimeout bounds request handling when REQUEST_TIMEOUT is unset
+const DefaultRequestTimeout = 15 * time.Second
+
+// timeoutWriter replaces any response started after the request deadline with
+// a 503, so a handler whose queries were cancelled never reports a 500
+type timeoutWriter struct {
+	gin.ResponseWriter
+	ctx     context.Context
+	body    []byte
+	expired bool
+}
+
+// timedOut reports whether the deadline has passed, writing the 503 the first
+// time it is noticed before anything else was sent
+func (w *timeoutWriter) timedOut() bool {
+	if w.expired {
+		return true
+	}
+	if w.ctx.Err() != context.DeadlineExceeded || w.ResponseWriter.Written() {
+		return false
+	}
+
+	w.expired = true
+	header := w.ResponseWriter.Header()
+	for _, name := range []string{"Content-Length", "ETag", "Last-Modified", "Cache-Control"} {
+		header.Del(name)
+	}
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	w.ResponseWriter.WriteHeader(http.StatusServiceUnavailable)
+	w.ResponseWriter.Write(w.body)
+	return true
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	if w.timedOut() {
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *timeoutWriter) WriteHeaderNow() {
+	if w.timedOut() {
+		return
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *timeoutWriter) Write(data []byte) (int, error) {
+	if w.timedOut() {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	if w.timedOut() {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Timeout bounds each request with a context deadline. Handlers pass the
+// request context to their queries so slow ones are cancelled, and a response
+// written after the deadline is replaced with a 503. A zero timeout disables it.
+func Timeout(timeout time.Duration) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		body, _ := json.Marshal(apierror.Body(c, http.StatusServiceUnavailable, "Request timed out",
+			"The request took longer than "+timeout.String()+" to process"))
+		c.Writer = &timeoutWriter{ResponseWriter: c.Writer, ctx: ctx, body: body}
+
+		c.Next()
+	})
+}
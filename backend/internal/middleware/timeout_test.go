@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestTimeout(t *testing.T) {
+	// slow waits for the request deadline, then responds as if nothing happened
+	slow := func(c *gin.Context) {
+		select {
+		case <-c.Request.Context().Done():
+		case <-time.After(time.Second):
+		}
+		c.Header("ETag", `"v1"`)
+		c.JSON(http.StatusOK, gin.H{"status": "late"})
+	}
+	fast := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		handler gin.HandlerFunc
+		want    int
+	}{
+		{"past the deadline", 20 * time.Millisecond, slow, http.StatusServiceUnavailable},
+		{"within the deadline", time.Second, fast, http.StatusOK},
+		{"disabled", 0, fast, http.StatusOK},
+	}
+	for _, tt := range tests {
+		router := gin.New()
+		router.Use(Timeout(tt.timeout))
+		router.GET("/blogs", tt.handler)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/blogs", nil))
+		if w.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, w.Code, tt.want)
+			continue
+		}
+		if tt.want != http.StatusServiceUnavailable {
+			continue
+		}
+		if !strings.Contains(w.Body.String(), "Request timed out") || strings.Contains(w.Body.String(), "late") {
+			t.Errorf("%s: body = %s, want only the timeout error", tt.name, w.Body.String())
+		}
+		if w.Header().Get("ETag") != "" {
+			t.Errorf("%s: timeout response kept the handler's ETag", tt.name)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// AccessibilityStatementID is the primary key of the single accessibility statement row
+const AccessibilityStatementID = 1
+
+// AccessibilityStatement is the site's editable accessibility statement. There
+// is exactly one row.
+type AccessibilityStatement struct {
+	ID        uint      `gorm:"primary_key"`
+	WCAGLevel string    `gorm:"size:3;not null"` // Conformance level: A, AA or AAA
+	Features  string    `gorm:"type:text"`       // Newline-separated accessibility features
+	LastAudit time.Time `gorm:"not null"`
+	Contact   string    `gorm:"size:255"` // Where to report accessibility problems
+	UpdatedAt time.Time
+}
+
+// DefaultAccessibilityStatement returns the statement seeded into a new database
+func DefaultAccessibilityStatement() AccessibilityStatement {
+	return AccessibilityStatement{
+		ID:        AccessibilityStatementID,
+		WCAGLevel: "AA",
+		Features: strings.Join([]string{
+			"Screen reader optimization",
+			"Keyboard navigation",
+			"High contrast support",
+			"Reduced motion support",
+			"Focus management",
+			"Semantic HTML",
+			"ARIA labels",
+		}, "\n"),
+		LastAudit: time.Now().UTC(),
+	}
+}
+
+// AccessibilityStatementResponse is the public accessibility statement
+type AccessibilityStatementResponse struct {
+	WCAGCompliance string    `json:"wcag_compliance"`
+	Features       []string  `json:"features"`
+	LastAudit      time.Time `json:"last_audit"`
+	Contact        string    `json:"contact"`
+}
+
+// UpdateAccessibilityStatementRequest replaces the accessibility statement
+type UpdateAccessibilityStatementRequest struct {
+	WCAGCompliance string     `json:"wcag_compliance" validate:"required,oneof=A AA AAA"`
+	Features       []string   `json:"features" validate:"required,min=1,max=50"`
+	LastAudit      *time.Time `json:"last_audit" validate:"required"`
+	Contact        string     `json:"contact" validate:"max=255"`
+}
+
+// ToResponse converts the statement to its API form
+func (s *AccessibilityStatement) ToResponse() AccessibilityStatementResponse {
+	features := []string{}
+	for _, feature := range strings.Split(s.Features, "\n") {
+		if feature = strings.TrimSpace(feature); feature != "" {
+			features = append(features, feature)
+		}
+	}
+	return AccessibilityStatementResponse{
+		WCAGCompliance: s.WCAGLevel,
+		Features:       features,
+		LastAudit:      s.LastAudit.UTC(),
+		Contact:        s.Contact,
+	}
+}
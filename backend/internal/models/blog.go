@@ -3,84 +3,239 @@ package models
 import (
 	"strings"
 	"time"
+
 	"github.com/jinzhu/gorm"
 )
 
 // Blog represents a blog post with accessibility features
 type Blog struct {
-	ID          uint      `json:"id" gorm:"primary_key"`
-	Title       string    `json:"title" gorm:"not null;size:255" validate:"required,min=1,max=255"`
-	Slug        string    `json:"slug" gorm:"unique;not null;size:255" validate:"required,min=1,max=255"`
-	Content     string    `json:"content" gorm:"type:text" validate:"required,min=10"`
-	Excerpt     string    `json:"excerpt" gorm:"size:500" validate:"max=500"`
-	Author      string    `json:"author" gorm:"not null;size:100" validate:"required,min=1,max=100"`
-	Published   bool      `json:"published" gorm:"default:false"`
-	Featured    bool      `json:"featured" gorm:"default:false"`
-	Tags        string    `json:"tags" gorm:"size:500"` // Comma-separated tags
-	MetaTitle   string    `json:"meta_title" gorm:"size:60"` // SEO meta title
-	MetaDesc    string    `json:"meta_description" gorm:"size:160"` // SEO meta description
-	ReadingTime int       `json:"reading_time" gorm:"default:0"` // Estimated reading time in minutes
-	ViewCount   int       `json:"view_count" gorm:"default:0"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	PublishedAt *time.Time `json:"published_at"`
+	ID               uint       `json:"id" gorm:"primary_key"`
+	Title            string     `json:"title" gorm:"not null;size:255" validate:"required,min=1,max=255"`
+	Slug             string     `json:"slug" gorm:"unique;not null;size:255" validate:"required,min=1,max=255"`
+	Content          string     `json:"content" gorm:"type:text" validate:"required,min=10"`
+	Excerpt          string     `json:"excerpt" gorm:"size:500" validate:"max=500"`
+	RichExcerpt      string     `json:"rich_excerpt" gorm:"type:text"` // First paragraph with inline formatting and links kept, cached from content
+	Author           string     `json:"author" gorm:"not null;size:100" validate:"required,min=1,max=100"`
+	Published        bool       `json:"published" gorm:"default:false"`
+	Featured         bool       `json:"featured" gorm:"default:false"`
+	AllowComments    bool       `json:"allow_comments" gorm:"not null;default:true"`
+	Tags             string     `json:"tags" gorm:"size:500"`             // Comma-separated tags
+	MetaTitle        string     `json:"meta_title" gorm:"size:60"`        // SEO meta title
+	MetaDesc         string     `json:"meta_description" gorm:"size:160"` // SEO meta description
+	ReadingTime      int        `json:"reading_time" gorm:"default:0"`    // Estimated reading time in minutes
+	HeadingCount     int        `json:"heading_count" gorm:"default:0"`   // Number of h1-h6 sections, cached from content
+	ViewCount        int        `json:"view_count" gorm:"default:0;index"`
+	Version          int        `json:"version" gorm:"not null;default:1"`                  // Incremented on every edit for optimistic concurrency
+	FeaturedImage    string     `json:"featured_image" gorm:"size:2048"`                    // Cover image URL
+	FeaturedImageAlt string     `json:"featured_image_alt" gorm:"size:125"`                 // Required alt text for the cover image
+	ContentHash      string     `json:"-" gorm:"size:64;index"`                             // Normalized hash of visible content for duplicate detection
+	Language         string     `json:"language" gorm:"size:2;not null;default:'en';index"` // ISO 639-1 language code
+	TranslationGroup string     `json:"translation_group" gorm:"size:36;index"`             // Shared by all language variants of a post
+	SeriesID         *uint      `json:"series_id" gorm:"index"`                             // Series the post belongs to, if any
+	SeriesOrder      int        `json:"series_order" gorm:"not null;default:0"`             // Position within the series
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+	PublishedAt      *time.Time `json:"published_at"`
+	DeletedAt        *time.Time `json:"-" sql:"index"` // Soft delete timestamp
 }
 
 // BlogResponse represents the API response structure
 type BlogResponse struct {
-	ID          uint       `json:"id"`
-	Title       string     `json:"title"`
-	Slug        string     `json:"slug"`
-	Content     string     `json:"content,omitempty"` // Only included in single blog requests
-	Excerpt     string     `json:"excerpt"`
-	Author      string     `json:"author"`
-	Published   bool       `json:"published"`
-	Featured    bool       `json:"featured"`
-	Tags        []string   `json:"tags"`
-	MetaTitle   string     `json:"meta_title,omitempty"`
-	MetaDesc    string     `json:"meta_description,omitempty"`
-	ReadingTime int        `json:"reading_time"`
-	ViewCount   int        `json:"view_count"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	PublishedAt *time.Time `json:"published_at"`
+	ID               uint           `json:"id"`
+	Title            string         `json:"title"`
+	Slug             string         `json:"slug"`
+	Content          string         `json:"content,omitempty"` // Only included in single blog requests
+	Excerpt          string         `json:"excerpt"`
+	RichExcerpt      string         `json:"rich_excerpt,omitempty"` // Sanitized HTML allowing strong, em and a
+	Author           string         `json:"author"`
+	Published        bool           `json:"published"`
+	Featured         bool           `json:"featured"`
+	AllowComments    bool           `json:"allow_comments"`
+	Tags             []string       `json:"tags"`
+	TagOverflow      int            `json:"tag_overflow,omitempty"` // Tags left out by ?max_tags=
+	IsNew            *bool          `json:"is_new,omitempty"`       // Published after ?since=; only set when it is given
+	MetaTitle        string         `json:"meta_title,omitempty"`
+	MetaDesc         string         `json:"meta_description,omitempty"`
+	ReadingTime      int            `json:"reading_time"`
+	AudioLength      int            `json:"audio_length_seconds"` // Estimated text-to-speech listening time
+	HeadingCount     int            `json:"heading_count"`
+	ViewCount        int            `json:"view_count"`
+	Version          int            `json:"version"`
+	FeaturedImage    string         `json:"featured_image,omitempty"`
+	FeaturedImageAlt string         `json:"featured_image_alt,omitempty"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	PublishedAt      *time.Time     `json:"published_at"`
+	Language         string         `json:"language"`
+	TranslationGroup string         `json:"translation_group"`
+	SeriesID         *uint          `json:"series_id,omitempty"`
+	SeriesOrder      int            `json:"series_order,omitempty"`
+	Translations     []Translation  `json:"translations,omitempty"` // Only included in single blog requests
+	Meta             *OpenGraphMeta `json:"meta,omitempty"`         // Only included in single blog requests
+	JSONLD           string         `json:"json_ld,omitempty"`      // schema.org BlogPosting markup
+}
+
+// BlogDetailResponse is a single-post response with navigation to the
+// adjacent published posts; Prev and Next are null at either end
+type BlogDetailResponse struct {
+	BlogResponse
+	Prev   *AdjacentPost  `json:"prev"`
+	Next   *AdjacentPost  `json:"next"`
+	Series *SeriesContext `json:"series,omitempty"` // Position within the post's series, if any
+}
+
+// AdjacentPost identifies a neighbouring post for previous/next navigation
+type AdjacentPost struct {
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+// SuggestionResponse is a lightweight search autocomplete entry
+type SuggestionResponse struct {
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
+
+// AuthorCount is an author name with the number of posts they have published
+type AuthorCount struct {
+	Author    string `json:"author"`
+	Slug      string `json:"slug" gorm:"-"` // Identifies the author in feed URLs
+	PostCount int    `json:"post_count"`
+}
+
+// ArchiveMonth is a calendar month with the number of posts published in it
+type ArchiveMonth struct {
+	Year      int `json:"year"`
+	Month     int `json:"month"`
+	PostCount int `json:"post_count"`
+}
+
+// ContentStats represents word count and readability statistics for a blog post
+type ContentStats struct {
+	WordCount    int     `json:"word_count"`
+	ReadingTime  int     `json:"reading_time"`
+	HeadingCount int     `json:"heading_count"`
+	ImageCount   int     `json:"image_count"`
+	LinkCount    int     `json:"link_count"`
+	GradeLevel   float64 `json:"grade_level"` // Flesch-Kincaid grade level
 }
 
+// PublishedAll is the published filter value listing drafts and published posts together
+const PublishedAll = "all"
+
 // BlogListResponse represents paginated blog list response
 type BlogListResponse struct {
-	Blogs      []BlogResponse `json:"blogs"`
-	Total      int64          `json:"total"`
-	Page       int            `json:"page"`
-	Limit      int            `json:"limit"`
-	TotalPages int            `json:"total_pages"`
-	HasNext    bool           `json:"has_next"`
-	HasPrev    bool           `json:"has_prev"`
+	Blogs      []BlogResponse  `json:"blogs"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	Limit      int             `json:"limit"`
+	TotalPages int             `json:"total_pages"`
+	HasNext    bool            `json:"has_next"`
+	HasPrev    bool            `json:"has_prev"`
+	Links      PaginationLinks `json:"links"`
+}
+
+// PaginationLinks holds absolute URLs for navigating a paginated response
+type PaginationLinks struct {
+	First string `json:"first"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last"`
 }
 
 // CreateBlogRequest represents the request structure for creating a blog
 type CreateBlogRequest struct {
-	Title     string `json:"title" validate:"required,min=1,max=255"`
-	Content   string `json:"content" validate:"required,min=10"`
-	Excerpt   string `json:"excerpt" validate:"max=500"`
-	Author    string `json:"author" validate:"required,min=1,max=100"`
-	Published bool   `json:"published"`
-	Featured  bool   `json:"featured"`
-	Tags      string `json:"tags"`
-	MetaTitle string `json:"meta_title" validate:"max=60"`
-	MetaDesc  string `json:"meta_description" validate:"max=160"`
+	Title            string `json:"title" validate:"required,min=1,max=255"`
+	Content          string `json:"content" validate:"required,min=10"`
+	Excerpt          string `json:"excerpt" validate:"max=500"`
+	Author           string `json:"author" validate:"required,min=1,max=100"`
+	Published        *bool  `json:"published"` // Omitted means DEFAULT_PUBLISHED
+	Featured         bool   `json:"featured"`
+	AllowComments    *bool  `json:"allow_comments"` // Omitted means comments are allowed
+	Tags             string `json:"tags"`
+	MetaTitle        string `json:"meta_title" validate:"max=60"`
+	MetaDesc         string `json:"meta_description" validate:"max=160"`
+	Language         string `json:"language" validate:"omitempty,len=2"`
+	TranslationGroup string `json:"translation_group" validate:"omitempty,uuid"` // Links this post to existing language variants
+	SeriesID         uint   `json:"series_id"`                                   // Adds the post to an existing series
+	SeriesOrder      int    `json:"series_order" validate:"omitempty,min=1"`     // Omitted means after the series' last post
+	FeaturedImage    string `json:"featured_image" validate:"omitempty,url"`
+	FeaturedImageAlt string `json:"featured_image_alt"` // Checked by the handler, which rejects a missing or long alt with a 422
 }
 
 // UpdateBlogRequest represents the request structure for updating a blog
 type UpdateBlogRequest struct {
-	Title     *string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
-	Content   *string `json:"content,omitempty" validate:"omitempty,min=10"`
-	Excerpt   *string `json:"excerpt,omitempty" validate:"omitempty,max=500"`
-	Author    *string `json:"author,omitempty" validate:"omitempty,min=1,max=100"`
-	Published *bool   `json:"published,omitempty"`
-	Featured  *bool   `json:"featured,omitempty"`
-	Tags      *string `json:"tags,omitempty"`
-	MetaTitle *string `json:"meta_title,omitempty" validate:"omitempty,max=60"`
-	MetaDesc  *string `json:"meta_description,omitempty" validate:"omitempty,max=160"`
+	Title            *string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Content          *string `json:"content,omitempty" validate:"omitempty,min=10"`
+	Excerpt          *string `json:"excerpt,omitempty" validate:"omitempty,max=500"`
+	Author           *string `json:"author,omitempty" validate:"omitempty,min=1,max=100"`
+	Published        *bool   `json:"published,omitempty"`
+	Featured         *bool   `json:"featured,omitempty"`
+	AllowComments    *bool   `json:"allow_comments,omitempty"`
+	Tags             *string `json:"tags,omitempty"`
+	MetaTitle        *string `json:"meta_title,omitempty" validate:"omitempty,max=60"`
+	MetaDesc         *string `json:"meta_description,omitempty" validate:"omitempty,max=160"`
+	Language         *string `json:"language,omitempty" validate:"omitempty,len=2"`
+	FeaturedImage    *string `json:"featured_image,omitempty" validate:"omitempty,url"`
+	FeaturedImageAlt *string `json:"featured_image_alt,omitempty"`
+	SeriesID         *uint   `json:"series_id,omitempty"` // 0 removes the post from its series
+	SeriesOrder      *int    `json:"series_order,omitempty" validate:"omitempty,min=1"`
+	Version          *int    `json:"version,omitempty"` // Expected current version when If-Match is not sent
+}
+
+// SetFeaturedRequest represents the request structure for toggling featured status
+type SetFeaturedRequest struct {
+	Featured *bool `json:"featured" validate:"required"`
+}
+
+// MaxBatchSize caps the number of posts a single batch request may touch
+const MaxBatchSize = 100
+
+// BatchRequest represents a request acting on several posts by ID
+type BatchRequest struct {
+	IDs []uint `json:"ids" validate:"required,min=1,max=100"`
+}
+
+// BatchPublishRequest represents a request publishing or unpublishing several posts
+type BatchPublishRequest struct {
+	IDs       []uint `json:"ids" validate:"required,min=1,max=100"`
+	Published *bool  `json:"published" validate:"required"`
+}
+
+// Batch publish result statuses
+const (
+	BatchStatusUpdated   = "updated"
+	BatchStatusUnchanged = "unchanged"
+	BatchStatusNotFound  = "not_found"
+)
+
+// BatchPublishResult reports the outcome of a batch publish for one ID
+type BatchPublishResult struct {
+	ID          uint       `json:"id"`
+	Status      string     `json:"status"` // updated, unchanged or not_found
+	Published   bool       `json:"published"`
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+// BatchPublishResponse lists a batch publish result per requested ID, in request order
+type BatchPublishResponse struct {
+	Results []BatchPublishResult `json:"results"`
+}
+
+// MaxBatchSlugs caps the number of posts fetched by slug in one request
+const MaxBatchSlugs = 50
+
+// BatchBlogsResponse lists posts fetched by slug in the requested order,
+// along with the requested slugs that match no published post
+type BatchBlogsResponse struct {
+	Blogs    []BlogResponse `json:"blogs"`
+	NotFound []string       `json:"not_found"`
+}
+
+// BatchDeleteResponse reports which IDs a batch delete removed
+type BatchDeleteResponse struct {
+	Deleted  []uint `json:"deleted"`
+	NotFound []uint `json:"not_found"`
 }
 
 // BeforeCreate hook to generate slug and calculate reading time
@@ -89,6 +244,18 @@ func (b *Blog) BeforeCreate(scope *gorm.Scope) error {
 		b.Slug = GenerateSlug(b.Title)
 	}
 	b.ReadingTime = CalculateReadingTime(b.Content)
+	b.ContentHash = ContentHash(b.Content)
+	b.HeadingCount = CountHeadings(b.Content)
+	b.RichExcerpt = GenerateRichExcerpt(b.Content, excerptLength)
+	if b.Version == 0 {
+		b.Version = 1
+	}
+	if b.Language == "" {
+		b.Language = DefaultLanguage
+	}
+	if b.TranslationGroup == "" {
+		b.TranslationGroup = NewTranslationGroup()
+	}
 	if b.Published && b.PublishedAt == nil {
 		now := time.Now()
 		b.PublishedAt = &now
@@ -98,18 +265,68 @@ func (b *Blog) BeforeCreate(scope *gorm.Scope) error {
 
 // BeforeUpdate hook to update reading time and published date
 func (b *Blog) BeforeUpdate(scope *gorm.Scope) error {
-	if scope.HasColumn("content") {
-		b.ReadingTime = CalculateReadingTime(b.Content)
+	if isUpdatingColumn(scope, "content") {
+		if err := scope.SetColumn("ReadingTime", CalculateReadingTime(b.Content)); err != nil {
+			return err
+		}
+		if err := scope.SetColumn("ContentHash", ContentHash(b.Content)); err != nil {
+			return err
+		}
+		if err := scope.SetColumn("HeadingCount", CountHeadings(b.Content)); err != nil {
+			return err
+		}
+		if err := scope.SetColumn("RichExcerpt", GenerateRichExcerpt(b.Content, excerptLength)); err != nil {
+			return err
+		}
 	}
-	if b.Published && b.PublishedAt == nil {
-		now := time.Now()
-		b.PublishedAt = &now
-	} else if !b.Published {
-		b.PublishedAt = nil
+	// Map updates only write the columns they name, so publish-state
+	// changes must go through SetColumn to reach published_at
+	if published, ok := updatingPublished(b, scope); ok {
+		if published && b.PublishedAt == nil {
+			now := time.Now()
+			if err := scope.SetColumn("PublishedAt", &now); err != nil {
+				return err
+			}
+		} else if !published && b.PublishedAt != nil {
+			if err := scope.SetColumn("PublishedAt", nil); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// updatingPublished returns the published state an update writes, reading it
+// from the update map rather than relying on the struct having been populated
+func updatingPublished(b *Blog, scope *gorm.Scope) (bool, bool) {
+	if !isUpdatingColumn(scope, "published") {
+		return false, false
+	}
+	if attrs, ok := scope.InstanceGet("gorm:update_attrs"); ok {
+		if updates, ok := attrs.(map[string]interface{}); ok {
+			if published, ok := updates["published"].(bool); ok {
+				return published, true
+			}
+		}
+	}
+	return b.Published, true
+}
+
+// isUpdatingColumn reports whether an update writes the given column. Full saves
+// write every column; map updates only write the keys they were given.
+func isUpdatingColumn(scope *gorm.Scope, column string) bool {
+	attrs, ok := scope.InstanceGet("gorm:update_attrs")
+	if !ok {
+		return true
+	}
+	updates, ok := attrs.(map[string]interface{})
+	if !ok {
+		return true
+	}
+	_, ok = updates[column]
+	return ok
+}
+
 // ToResponse converts Blog to BlogResponse
 func (b *Blog) ToResponse(includeContent bool) BlogResponse {
 	tags := []string{}
@@ -121,19 +338,30 @@ func (b *Blog) ToResponse(includeContent bool) BlogResponse {
 	}
 
 	response := BlogResponse{
-		ID:          b.ID,
-		Title:       b.Title,
-		Slug:        b.Slug,
-		Excerpt:     b.Excerpt,
-		Author:      b.Author,
-		Published:   b.Published,
-		Featured:    b.Featured,
-		Tags:        tags,
-		ReadingTime: b.ReadingTime,
-		ViewCount:   b.ViewCount,
-		CreatedAt:   b.CreatedAt,
-		UpdatedAt:   b.UpdatedAt,
-		PublishedAt: b.PublishedAt,
+		ID:               b.ID,
+		Title:            b.Title,
+		Slug:             b.Slug,
+		Excerpt:          b.Excerpt,
+		RichExcerpt:      b.RichExcerpt,
+		Author:           b.Author,
+		Published:        b.Published,
+		Featured:         b.Featured,
+		AllowComments:    b.AllowComments,
+		Tags:             tags,
+		ReadingTime:      b.ReadingTime,
+		AudioLength:      CalculateAudioLength(b.Content),
+		HeadingCount:     b.HeadingCount,
+		ViewCount:        b.ViewCount,
+		Version:          b.Version,
+		FeaturedImage:    b.FeaturedImage,
+		FeaturedImageAlt: b.FeaturedImageAlt,
+		CreatedAt:        b.CreatedAt,
+		UpdatedAt:        b.UpdatedAt,
+		PublishedAt:      b.PublishedAt,
+		Language:         b.Language,
+		TranslationGroup: b.TranslationGroup,
+		SeriesID:         b.SeriesID,
+		SeriesOrder:      b.SeriesOrder,
 	}
 
 	if includeContent {
@@ -144,3 +372,18 @@ func (b *Blog) ToResponse(includeContent bool) BlogResponse {
 
 	return response
 }
+
+// MarkNew sets IsNew to whether the post was published after since
+func (r *BlogResponse) MarkNew(since time.Time) {
+	isNew := r.PublishedAt != nil && r.PublishedAt.After(since)
+	r.IsNew = &isNew
+}
+
+// LimitTags keeps the first max tags, counting the rest in TagOverflow
+func (r *BlogResponse) LimitTags(max int) {
+	if max < 0 || len(r.Tags) <= max {
+		return
+	}
+	r.TagOverflow = len(r.Tags) - max
+	r.Tags = r.Tags[:max]
+}
@@ -0,0 +1,157 @@
+package models
+
+import (
+	"regexp"
+	"sort"
+	"time"
+)
+
+// Comment moderation states; only approved comments are shown to readers
+const (
+	CommentPending  = "pending"
+	CommentApproved = "approved"
+	CommentRejected = "rejected"
+)
+
+// Comment limits enforced on create
+const (
+	CommentAuthorMaxLength  = 100
+	CommentContentMaxLength = 2000
+)
+
+// DefaultCommentMaxDepth is the number of reply levels shown when COMMENT_MAX_DEPTH is unset
+const DefaultCommentMaxDepth = 3
+
+// Comment is a reader comment on a blog post, held for moderation until approved
+type Comment struct {
+	ID        uint      `json:"id" gorm:"primary_key"`
+	BlogID    uint      `json:"blog_id" gorm:"not null;index"`
+	ParentID  *uint     `json:"parent_id" gorm:"index"` // Comment this one replies to; nil for top-level comments
+	Author    string    `json:"author" gorm:"not null;size:100"`
+	Content   string    `json:"content" gorm:"type:text;not null"`
+	Status    string    `json:"status" gorm:"size:20;not null;default:'pending';index"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CommentResponse represents a comment in API responses
+type CommentResponse struct {
+	ID        uint              `json:"id"`
+	BlogID    uint              `json:"blog_id"`
+	ParentID  *uint             `json:"parent_id"`
+	Author    string            `json:"author"`
+	Content   string            `json:"content"`
+	Status    string            `json:"status,omitempty"` // Only included in moderation responses
+	CreatedAt time.Time         `json:"created_at"`
+	Replies   []CommentResponse `json:"replies,omitempty"` // Only included in threaded listings
+}
+
+// CommentListResponse represents a paginated comment list, shaped like BlogListResponse
+type CommentListResponse struct {
+	CommentsEnabled bool              `json:"comments_enabled"` // False when the post has comments turned off
+	Comments        []CommentResponse `json:"comments"`
+	Total           int64             `json:"total"`
+	Page            int               `json:"page"`
+	Limit           int               `json:"limit"`
+	TotalPages      int               `json:"total_pages"`
+	HasNext         bool              `json:"has_next"`
+	HasPrev         bool              `json:"has_prev"`
+	Links           PaginationLinks   `json:"links"`
+}
+
+// CreateCommentRequest represents the request structure for posting a comment
+type CreateCommentRequest struct {
+	Author   string `json:"author" validate:"required,min=1,max=100"`
+	Content  string `json:"content" validate:"required,min=1,max=2000"`
+	ParentID *uint  `json:"parent_id"` // Reply to this comment on the same post
+}
+
+// ModerateCommentRequest represents the request structure for changing a comment's status
+type ModerateCommentRequest struct {
+	Status string `json:"status" validate:"required,oneof=pending approved rejected"`
+}
+
+// IsValidCommentStatus reports whether status is a known moderation state
+func IsValidCommentStatus(status string) bool {
+	return status == CommentPending || status == CommentApproved || status == CommentRejected
+}
+
+// ToResponse converts Comment to CommentResponse
+func (c *Comment) ToResponse(includeStatus bool) CommentResponse {
+	response := CommentResponse{
+		ID:        c.ID,
+		BlogID:    c.BlogID,
+		ParentID:  c.ParentID,
+		Author:    c.Author,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt,
+	}
+	if includeStatus {
+		response.Status = c.Status
+	}
+	return response
+}
+
+// commentSpaceRegex matches runs of spaces left behind by removed markup
+var commentSpaceRegex = regexp.MustCompile(`[ \t]+`)
+
+// CommentText reduces submitted comment text to plain text, dropping any
+// markup while keeping line breaks
+func CommentText(text string) string {
+	return SanitizeString(commentSpaceRegex.ReplaceAllString(stripHTMLTags(text), " "))
+}
+
+// BuildCommentThreads nests replies under their top-level comments, oldest
+// reply first. Replies nested deeper than maxDepth levels are collapsed into
+// the deepest level in chronological order; their ParentID still names the
+// comment they answer. Replies whose parent is not in the set are dropped.
+func BuildCommentThreads(roots, replies []Comment, maxDepth int) []CommentResponse {
+	if maxDepth < 1 {
+		maxDepth = 1
+	}
+
+	children := make(map[uint][]Comment)
+	for _, reply := range replies {
+		if reply.ParentID != nil {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+	}
+	for parent := range children {
+		sort.SliceStable(children[parent], func(i, j int) bool {
+			return children[parent][i].CreatedAt.Before(children[parent][j].CreatedAt)
+		})
+	}
+
+	var build func(comment Comment, depth int) CommentResponse
+	build = func(comment Comment, depth int) CommentResponse {
+		response := comment.ToResponse(false)
+		if depth < maxDepth-1 {
+			for _, child := range children[comment.ID] {
+				response.Replies = append(response.Replies, build(child, depth+1))
+			}
+			return response
+		}
+
+		// Collapse the rest of the thread into this level
+		var descendants []Comment
+		pending := append([]Comment(nil), children[comment.ID]...)
+		for len(pending) > 0 {
+			next := pending[0]
+			pending = append(pending[1:], children[next.ID]...)
+			descendants = append(descendants, next)
+		}
+		sort.SliceStable(descendants, func(i, j int) bool {
+			return descendants[i].CreatedAt.Before(descendants[j].CreatedAt)
+		})
+		for _, descendant := range descendants {
+			response.Replies = append(response.Replies, descendant.ToResponse(false))
+		}
+		return response
+	}
+
+	threads := make([]CommentResponse, len(roots))
+	for i, root := range roots {
+		threads[i] = build(root, 0)
+	}
+	return threads
+}
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CSPReport is a Content-Security-Policy violation reported by a browser
+type CSPReport struct {
+	ID                 uint      `json:"id" gorm:"primary_key"`
+	DocumentURI        string    `json:"document_uri" gorm:"size:2048"`
+	Referrer           string    `json:"referrer,omitempty" gorm:"size:2048"`
+	BlockedURI         string    `json:"blocked_uri" gorm:"size:2048"`
+	ViolatedDirective  string    `json:"violated_directive" gorm:"size:255"`
+	EffectiveDirective string    `json:"effective_directive" gorm:"size:255;index"`
+	Disposition        string    `json:"disposition,omitempty" gorm:"size:20"`
+	SourceFile         string    `json:"source_file,omitempty" gorm:"size:2048"`
+	LineNumber         int       `json:"line_number,omitempty"`
+	ColumnNumber       int       `json:"column_number,omitempty"`
+	StatusCode         int       `json:"status_code,omitempty"`
+	Sample             string    `json:"sample,omitempty" gorm:"size:255"`
+	UserAgent          string    `json:"user_agent,omitempty" gorm:"size:512"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// legacyCSPReport is the application/csp-report body: {"csp-report": {...}}
+type legacyCSPReport struct {
+	Report struct {
+		DocumentURI        string `json:"document-uri"`
+		Referrer           string `json:"referrer"`
+		BlockedURI         string `json:"blocked-uri"`
+		ViolatedDirective  string `json:"violated-directive"`
+		EffectiveDirective string `json:"effective-directive"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"source-file"`
+		LineNumber         int    `json:"line-number"`
+		ColumnNumber       int    `json:"column-number"`
+		StatusCode         int    `json:"status-code"`
+		ScriptSample       string `json:"script-sample"`
+	} `json:"csp-report"`
+}
+
+// reportingAPIReport is one entry of an application/reports+json array
+type reportingAPIReport struct {
+	Type      string `json:"type"`
+	UserAgent string `json:"user_agent"`
+	Body      struct {
+		DocumentURL        string `json:"documentURL"`
+		Referrer           string `json:"referrer"`
+		BlockedURL         string `json:"blockedURL"`
+		EffectiveDirective string `json:"effectiveDirective"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"sourceFile"`
+		LineNumber         int    `json:"lineNumber"`
+		ColumnNumber       int    `json:"columnNumber"`
+		StatusCode         int    `json:"statusCode"`
+		Sample             string `json:"sample"`
+	} `json:"body"`
+}
+
+// Clamp trims every field to its column size so oversized reports can be stored
+func (r *CSPReport) Clamp() {
+	r.DocumentURI = clip(r.DocumentURI, 2048)
+	r.Referrer = clip(r.Referrer, 2048)
+	r.BlockedURI = clip(r.BlockedURI, 2048)
+	r.ViolatedDirective = clip(r.ViolatedDirective, 255)
+	r.EffectiveDirective = clip(r.EffectiveDirective, 255)
+	r.Disposition = clip(r.Disposition, 20)
+	r.SourceFile = clip(r.SourceFile, 2048)
+	r.Sample = clip(r.Sample, 255)
+	r.UserAgent = clip(r.UserAgent, 512)
+}
+
+// clip cuts s to at most max runes
+func clip(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
+
+// ErrEmptyCSPReport is returned when a payload contains no CSP violations
+var ErrEmptyCSPReport = errors.New("no CSP violations found in report")
+
+// ParseCSPReports decodes either the legacy {"csp-report": {...}} wrapper or a
+// Reporting API array, keeping only csp-violation entries from the latter
+func ParseCSPReports(body []byte) ([]CSPReport, error) {
+	body = bytes.TrimSpace(body)
+
+	if len(body) > 0 && body[0] == '[' {
+		var entries []reportingAPIReport
+		if err := json.Unmarshal(body, &entries); err != nil {
+			return nil, err
+		}
+
+		reports := []CSPReport{}
+		for _, entry := range entries {
+			if entry.Type != "csp-violation" {
+				continue
+			}
+			reports = append(reports, CSPReport{
+				DocumentURI:        entry.Body.DocumentURL,
+				Referrer:           entry.Body.Referrer,
+				BlockedURI:         entry.Body.BlockedURL,
+				ViolatedDirective:  entry.Body.EffectiveDirective,
+				EffectiveDirective: entry.Body.EffectiveDirective,
+				Disposition:        entry.Body.Disposition,
+				SourceFile:         entry.Body.SourceFile,
+				LineNumber:         entry.Body.LineNumber,
+				ColumnNumber:       entry.Body.ColumnNumber,
+				StatusCode:         entry.Body.StatusCode,
+				Sample:             entry.Body.Sample,
+				UserAgent:          entry.UserAgent,
+			})
+		}
+		if len(reports) == 0 {
+			return nil, ErrEmptyCSPReport
+		}
+		return reports, nil
+	}
+
+	var legacy legacyCSPReport
+	if err := json.Unmarshal(body, &legacy); err != nil {
+		return nil, err
+	}
+	r := legacy.Report
+	if r.DocumentURI == "" && r.ViolatedDirective == "" && r.EffectiveDirective == "" {
+		return nil, ErrEmptyCSPReport
+	}
+
+	effective := r.EffectiveDirective
+	if effective == "" {
+		effective = r.ViolatedDirective
+	}
+	return []CSPReport{{
+		DocumentURI:        r.DocumentURI,
+		Referrer:           r.Referrer,
+		BlockedURI:         r.BlockedURI,
+		ViolatedDirective:  r.ViolatedDirective,
+		EffectiveDirective: effective,
+		Disposition:        r.Disposition,
+		SourceFile:         r.SourceFile,
+		LineNumber:         r.LineNumber,
+		ColumnNumber:       r.ColumnNumber,
+		StatusCode:         r.StatusCode,
+		Sample:             r.ScriptSample,
+	}}, nil
+}
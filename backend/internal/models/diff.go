@@ -0,0 +1,97 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Diff line operations
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// DiffLine is one line of a field diff; changed lines appear as a delete
+// followed by an insert
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// FieldDiff is the line diff of one revisable field
+type FieldDiff struct {
+	Changed bool       `json:"changed"`
+	Lines   []DiffLine `json:"lines"`
+}
+
+// RevisionDiffResponse compares the revisable fields of two revisions
+type RevisionDiffResponse struct {
+	From    int       `json:"from"`
+	To      int       `json:"to"`
+	Title   FieldDiff `json:"title"`
+	Excerpt FieldDiff `json:"excerpt"`
+	Content FieldDiff `json:"content"`
+}
+
+// diffBlockEndRegex matches the end of a block element, where content is split into lines
+var diffBlockEndRegex = regexp.MustCompile(`(?i)(</(?:p|h[1-6]|li|ul|ol|blockquote|pre|figure|figcaption|table|thead|tbody|tfoot|tr|div|details|summary|dl|dt|dd)>|<br\s*/?>|<hr\s*/?>)`)
+
+// contentLines splits HTML content into lines at block element boundaries and
+// newlines, so each paragraph or list item diffs as a unit
+func contentLines(content string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(diffBlockEndRegex.ReplaceAllString(content, "$1\n"), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// diffLines returns the line diff turning a into b
+func diffLines(a, b []string) FieldDiff {
+	diff := FieldDiff{Lines: []DiffLine{}}
+	matcher := difflib.NewMatcherWithJunk(a, b, false, nil)
+	for _, op := range matcher.GetOpCodes() {
+		if op.Tag == 'e' {
+			for _, line := range a[op.I1:op.I2] {
+				diff.Lines = append(diff.Lines, DiffLine{Op: DiffEqual, Text: line})
+			}
+			continue
+		}
+		diff.Changed = true
+		for _, line := range a[op.I1:op.I2] {
+			diff.Lines = append(diff.Lines, DiffLine{Op: DiffDelete, Text: line})
+		}
+		for _, line := range b[op.J1:op.J2] {
+			diff.Lines = append(diff.Lines, DiffLine{Op: DiffInsert, Text: line})
+		}
+	}
+	return diff
+}
+
+// DiffRevisions compares the title, excerpt and content of two revisions.
+// Content is diffed per block element; title and excerpt per line.
+func DiffRevisions(from, to BlogRevision) RevisionDiffResponse {
+	return RevisionDiffResponse{
+		From:    from.Version,
+		To:      to.Version,
+		Title:   diffLines(textLines(from.Title), textLines(to.Title)),
+		Excerpt: diffLines(textLines(from.Excerpt), textLines(to.Excerpt)),
+		Content: diffLines(contentLines(from.Content), contentLines(to.Content)),
+	}
+}
+
+// textLines splits plain text into its non-blank lines
+func textLines(text string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	from := BlogRevision{Version: 2, Title: "Captions", Excerpt: "Why captions matter",
+		Content: "<h2>Why</h2><p>Captions help.</p><ul><li>Noise</li><li>Deafness</li></ul>"}
+	to := BlogRevision{Version: 5, Title: "Captions", Excerpt: "Why captions matter\nand how to write them",
+		Content: "<h2>Why</h2><p>Captions help everyone.</p><ul><li>Noise</li><li>Deafness</li></ul><p>Write them early.</p>"}
+
+	diff := DiffRevisions(from, to)
+	if diff.From != 2 || diff.To != 5 {
+		t.Errorf("versions = %d to %d, want 2 to 5", diff.From, diff.To)
+	}
+	if diff.Title.Changed || !reflect.DeepEqual(diff.Title.Lines, []DiffLine{{Op: DiffEqual, Text: "Captions"}}) {
+		t.Errorf("title diff = %+v, want one unchanged line", diff.Title)
+	}
+	wantExcerpt := []DiffLine{{Op: DiffEqual, Text: "Why captions matter"}, {Op: DiffInsert, Text: "and how to write them"}}
+	if !diff.Excerpt.Changed || !reflect.DeepEqual(diff.Excerpt.Lines, wantExcerpt) {
+		t.Errorf("excerpt diff = %+v, want %+v", diff.Excerpt.Lines, wantExcerpt)
+	}
+
+	// Content diffs per block element: a changed paragraph is a delete then an insert
+	wantContent := []DiffLine{
+		{Op: DiffEqual, Text: "<h2>Why</h2>"},
+		{Op: DiffDelete, Text: "<p>Captions help.</p>"},
+		{Op: DiffInsert, Text: "<p>Captions help everyone.</p>"},
+		{Op: DiffEqual, Text: "<ul><li>Noise</li>"},
+		{Op: DiffEqual, Text: "<li>Deafness</li>"},
+		{Op: DiffEqual, Text: "</ul>"},
+		{Op: DiffInsert, Text: "<p>Write them early.</p>"},
+	}
+	if !diff.Content.Changed || !reflect.DeepEqual(diff.Content.Lines, wantContent) {
+		t.Errorf("content diff = %+v, want %+v", diff.Content.Lines, wantContent)
+	}
+}
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// BlogDraft is an editor's autosaved working copy of a post, kept apart from
+// the live row. Each post has at most one draft, replaced on every autosave.
+type BlogDraft struct {
+	BlogID           uint   `gorm:"primary_key;auto_increment:false"`
+	Title            string `gorm:"size:255"`
+	Content          string `gorm:"type:text"`
+	Excerpt          string `gorm:"type:text"`
+	Tags             string `gorm:"type:text"`
+	MetaTitle        string `gorm:"size:255"`
+	MetaDesc         string `gorm:"type:text"`
+	FeaturedImage    string `gorm:"size:500"`
+	FeaturedImageAlt string `gorm:"size:255"`
+	UpdatedAt        time.Time
+}
+
+// AutosaveRequest is the working copy sent by the editor. Fields are stored
+// as typed, without validation, so incomplete posts can be saved.
+type AutosaveRequest struct {
+	Title            string `json:"title"`
+	Content          string `json:"content"`
+	Excerpt          string `json:"excerpt"`
+	Tags             string `json:"tags"` // Comma-separated, as entered
+	MetaTitle        string `json:"meta_title"`
+	MetaDesc         string `json:"meta_description"`
+	FeaturedImage    string `json:"featured_image"`
+	FeaturedImageAlt string `json:"featured_image_alt"`
+}
+
+// BlogDraftResponse is an autosaved working copy
+type BlogDraftResponse struct {
+	BlogID           uint      `json:"blog_id"`
+	Title            string    `json:"title"`
+	Content          string    `json:"content"`
+	Excerpt          string    `json:"excerpt"`
+	Tags             string    `json:"tags"`
+	MetaTitle        string    `json:"meta_title"`
+	MetaDesc         string    `json:"meta_description"`
+	FeaturedImage    string    `json:"featured_image"`
+	FeaturedImageAlt string    `json:"featured_image_alt"`
+	SavedAt          time.Time `json:"saved_at"`
+}
+
+// NewBlogDraft builds the draft stored for a post from an autosave request,
+// stripping control characters and disallowed HTML
+func NewBlogDraft(blogID uint, req AutosaveRequest) BlogDraft {
+	return BlogDraft{
+		BlogID:           blogID,
+		Title:            SanitizeString(req.Title),
+		Content:          SanitizeHTML(SanitizeString(req.Content)),
+		Excerpt:          SanitizeString(req.Excerpt),
+		Tags:             SanitizeString(req.Tags),
+		MetaTitle:        SanitizeString(req.MetaTitle),
+		MetaDesc:         SanitizeString(req.MetaDesc),
+		FeaturedImage:    SanitizeString(req.FeaturedImage),
+		FeaturedImageAlt: SanitizeString(req.FeaturedImageAlt),
+	}
+}
+
+// ToResponse converts BlogDraft to BlogDraftResponse
+func (d *BlogDraft) ToResponse() BlogDraftResponse {
+	return BlogDraftResponse{
+		BlogID:           d.BlogID,
+		Title:            d.Title,
+		Content:          d.Content,
+		Excerpt:          d.Excerpt,
+		Tags:             d.Tags,
+		MetaTitle:        d.MetaTitle,
+		MetaDesc:         d.MetaDesc,
+		FeaturedImage:    d.FeaturedImage,
+		FeaturedImageAlt: d.FeaturedImageAlt,
+		SavedAt:          d.UpdatedAt,
+	}
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// FeedTitle is the channel title shared by every RSS feed
+const FeedTitle = "TechnoPrise Blog"
+
+// RSS is an RSS 2.0 document
+type RSS struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	DCNS    string     `xml:"xmlns:dc,attr"`
+	Channel RSSChannel `xml:"channel"`
+}
+
+// RSSChannel describes a feed and holds its items
+type RSSChannel struct {
+	Title         string      `xml:"title"`
+	Link          string      `xml:"link"`
+	Description   string      `xml:"description"`
+	Language      string      `xml:"language"`
+	LastBuildDate string      `xml:"lastBuildDate,omitempty"`
+	AtomLink      RSSAtomLink `xml:"atom:link"`
+	Items         []RSSItem   `xml:"item"`
+}
+
+// RSSAtomLink is the self reference feed readers use to identify the feed URL
+type RSSAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// RSSItem is a single post in a feed
+type RSSItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Author      string   `xml:"dc:creator,omitempty"`
+	GUID        RSSGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+	Categories  []string `xml:"category"`
+}
+
+// RSSGUID uniquely identifies an item; post URLs are used as permalinks
+type RSSGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// NewRSSFeed builds an RSS document for posts, newest first as given. selfURL
+// is the URL the feed itself is served from.
+func NewRSSFeed(title, link, description, selfURL, siteURL string, blogs []Blog) RSS {
+	channel := RSSChannel{
+		Title:       title,
+		Link:        link,
+		Description: description,
+		Language:    DefaultLanguage,
+		AtomLink:    RSSAtomLink{Href: selfURL, Rel: "self", Type: "application/rss+xml"},
+		Items:       make([]RSSItem, len(blogs)),
+	}
+
+	var lastBuild time.Time
+	for i, blog := range blogs {
+		url := BlogURL(siteURL, blog.Slug)
+		item := RSSItem{
+			Title:       blog.Title,
+			Link:        url,
+			Description: blog.Excerpt,
+			Author:      blog.Author,
+			GUID:        RSSGUID{IsPermaLink: true, Value: url},
+			Categories:  blog.ToResponse(false).Tags,
+		}
+		if blog.PublishedAt != nil {
+			item.PubDate = blog.PublishedAt.UTC().Format(time.RFC1123Z)
+		}
+		channel.Items[i] = item
+		if blog.UpdatedAt.After(lastBuild) {
+			lastBuild = blog.UpdatedAt
+		}
+	}
+	if !lastBuild.IsZero() {
+		channel.LastBuildDate = lastBuild.UTC().Format(time.RFC1123Z)
+	}
+
+	return RSS{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		DCNS:    "http://purl.org/dc/elements/1.1/",
+		Channel: channel,
+	}
+}
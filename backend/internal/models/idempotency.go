@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+// IdempotencyKey maps a client-supplied Idempotency-Key to the blog it created
+type IdempotencyKey struct {
+	Key       string    `json:"key" gorm:"primary_key;size:300"` // Namespaced, e.g. "blogs:create:<key>"
+	BlogID    uint      `json:"blog_id" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null;index"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// DefaultLanguage is the language assigned to posts that don't specify one
+const DefaultLanguage = "en"
+
+var languageCodeRegex = regexp.MustCompile(`^[a-z]{2}$`)
+
+// Translation identifies a language variant of a blog post
+type Translation struct {
+	Language string `json:"language"`
+	Slug     string `json:"slug"`
+}
+
+// NormalizeLanguage lowercases and trims a language code
+func NormalizeLanguage(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// IsValidLanguage reports whether code looks like an ISO 639-1 language code
+func IsValidLanguage(code string) bool {
+	return languageCodeRegex.MatchString(code)
+}
+
+// IsValidTranslationGroup reports whether group is a well-formed UUID
+func IsValidTranslationGroup(group string) bool {
+	_, err := uuid.Parse(group)
+	return err == nil
+}
+
+// NewTranslationGroup generates a fresh translation group ID
+func NewTranslationGroup() string {
+	return uuid.New().String()
+}
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Link check outcomes
+const (
+	LinkOK       = "ok"
+	LinkBroken   = "broken"
+	LinkRedirect = "redirect"
+)
+
+// LinkStatus is the result of checking a single link
+type LinkStatus struct {
+	URL        string `json:"url"`
+	Status     string `json:"status"` // ok, broken or redirect
+	StatusCode int    `json:"status_code,omitempty"`
+	Location   string `json:"location,omitempty"` // Redirect target
+	Error      string `json:"error,omitempty"`
+}
+
+// LinkCheckResponse reports the status of every link in a post
+type LinkCheckResponse struct {
+	BlogID    uint         `json:"blog_id"`
+	Checked   int          `json:"checked"`
+	Broken    int          `json:"broken"`
+	Redirects int          `json:"redirects"`
+	Links     []LinkStatus `json:"links"`
+	CheckedAt time.Time    `json:"checked_at"`
+}
+
+// Reasons an internal link is reported as broken
+const (
+	InternalLinkMissing     = "missing"     // No post has ever had the slug
+	InternalLinkDeleted     = "deleted"     // The post has been deleted
+	InternalLinkUnpublished = "unpublished" // The post exists but is not published
+)
+
+// BrokenInternalLink is a link from a published post to a post readers cannot open
+type BrokenInternalLink struct {
+	BlogID     uint   `json:"blog_id"`
+	BlogSlug   string `json:"blog_slug"`
+	Link       string `json:"link"`        // The href as written in the content
+	TargetSlug string `json:"target_slug"` // Slug the link points at
+	Reason     string `json:"reason"`      // missing, deleted or unpublished
+}
+
+// BrokenInternalLinksResponse reports broken internal links across published posts
+type BrokenInternalLinksResponse struct {
+	Scanned int                  `json:"scanned"` // Published posts scanned
+	Checked int                  `json:"checked"` // Internal links found
+	Broken  []BrokenInternalLink `json:"broken"`
+}
+
+// internalBlogPrefixes are the paths under which a post is addressed by slug:
+// the frontend post page and the API
+var internalBlogPrefixes = []string{"/blog/", "/blogs/", "/api/v1/blogs/"}
+
+// InternalLinkSlug returns the post slug an href points at when it is a
+// relative link or an absolute link to the site host, matching /blog/:slug,
+// /blogs/:slug or /api/v1/blogs/:slug
+func InternalLinkSlug(href, siteURL string) (string, bool) {
+	target, err := url.Parse(href)
+	if err != nil {
+		return "", false
+	}
+	if target.Host != "" {
+		site, err := url.Parse(siteURL)
+		if err != nil || !strings.EqualFold(target.Host, site.Host) {
+			return "", false
+		}
+	}
+
+	path := target.Path
+	for _, prefix := range internalBlogPrefixes {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		slug := strings.TrimSuffix(path[len(prefix):], "/")
+		if slug == "" || strings.Contains(slug, "/") {
+			return "", false
+		}
+		return slug, true
+	}
+	return "", false
+}
+
+// InternalLinkSlugs returns the distinct post slugs content links to, in document order
+func InternalLinkSlugs(content, siteURL string) []string {
+	seen := make(map[string]bool)
+	slugs := []string{}
+	for _, link := range ExtractLinks(content) {
+		slug, ok := InternalLinkSlug(link, siteURL)
+		if !ok {
+			continue
+		}
+		slug = CanonicalSlug(slug)
+		if !seen[slug] {
+			seen[slug] = true
+			slugs = append(slugs, slug)
+		}
+	}
+	return slugs
+}
+
+// ExtractLinks returns the distinct href targets of anchors in content, in
+// document order, skipping in-page anchors and mailto, tel and javascript links
+func ExtractLinks(content string) []string {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	seen := make(map[string]bool)
+	links := []string{}
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return links
+		}
+		if tokenType != html.StartTagToken && tokenType != html.SelfClosingTagToken {
+			continue
+		}
+
+		token := tokenizer.Token()
+		if token.Data != "a" {
+			continue
+		}
+		for _, attr := range token.Attr {
+			if attr.Key != "href" {
+				continue
+			}
+			href := strings.TrimSpace(attr.Val)
+			if href == "" || strings.HasPrefix(href, "#") || seen[href] {
+				break
+			}
+			lower := strings.ToLower(href)
+			if strings.HasPrefix(lower, "mailto:") || strings.HasPrefix(lower, "tel:") || strings.HasPrefix(lower, "javascript:") {
+				break
+			}
+			seen[href] = true
+			links = append(links, href)
+			break
+		}
+	}
+}
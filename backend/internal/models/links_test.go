@@ -0,0 +1,45 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInternalLinkSlug(t *testing.T) {
+	const site = "https://blog.example.com"
+	tests := []struct {
+		href string
+		slug string
+		ok   bool
+	}{
+		{"/blog/first-post", "first-post", true},
+		{"/blogs/first-post/", "first-post", true},
+		{"/api/v1/blogs/first-post?ref=home#intro", "first-post", true},
+		{"https://blog.example.com/blog/first-post", "first-post", true},
+		{"https://BLOG.example.com/blog/First-Post", "First-Post", true},
+		{"https://other.example.com/blog/first-post", "", false},
+		{"/blog/", "", false},
+		{"/blog/first-post/comments", "", false},
+		{"/about", "", false},
+		{"%zz", "", false},
+	}
+	for _, tt := range tests {
+		slug, ok := InternalLinkSlug(tt.href, site)
+		if slug != tt.slug || ok != tt.ok {
+			t.Errorf("InternalLinkSlug(%q) = %q, %v; want %q, %v", tt.href, slug, ok, tt.slug, tt.ok)
+		}
+	}
+}
+
+func TestInternalLinkSlugs(t *testing.T) {
+	content := `<p><a href="/blog/Beta/">b</a> <a href="https://blog.example.com/blogs/alpha">a</a> ` +
+		`<a href="/blog/beta">again</a> <a href="https://other.example.com/blog/gamma">external</a> ` +
+		`<a href="#top">top</a> <a href="/about">about</a></p>`
+	got := InternalLinkSlugs(content, "https://blog.example.com")
+	if want := []string{"beta", "alpha"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InternalLinkSlugs = %q, want %q", got, want)
+	}
+	if got := InternalLinkSlugs("<p>No links</p>", "https://blog.example.com"); got == nil || len(got) != 0 {
+		t.Errorf("InternalLinkSlugs without links = %#v, want an empty slice", got)
+	}
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Changes made to content for readers who prefer reduced motion
+const (
+	MotionAutoplayRemoved = "autoplay"     // autoplay attribute removed; controls are added so media can still be started
+	MotionLoopRemoved     = "loop"         // loop attribute removed
+	MotionAnimatedImage   = "animated-gif" // GIF image flagged with data-animated="true" for clients to pause or swap
+)
+
+// motionMediaTags are the elements whose autoplay or loop attributes start motion
+var motionMediaTags = map[string]bool{"video": true, "audio": true}
+
+// MotionChange is a reduced motion change made to one kind of element, with
+// the number of times it was made
+type MotionChange struct {
+	Element string
+	Change  string
+	Count   int
+}
+
+// String formats the change for the X-Reduced-Motion-Altered header, e.g. "video autoplay=2"
+func (m MotionChange) String() string {
+	return m.Element + " " + m.Change + "=" + strconv.Itoa(m.Count)
+}
+
+// ReducedMotionHTML neutralizes markup that starts motion on its own: autoplay
+// and loop are removed from media, and GIF images are flagged as possibly
+// animated. Everything else is returned unchanged. The changes are listed in
+// order of first occurrence.
+func ReducedMotionHTML(content string) (string, []MotionChange) {
+	changes := []MotionChange{}
+	index := make(map[[2]string]int)
+	record := func(element, change string) {
+		key := [2]string{element, change}
+		if i, ok := index[key]; ok {
+			changes[i].Count++
+			return
+		}
+		index[key] = len(changes)
+		changes = append(changes, MotionChange{Element: element, Change: change, Count: 1})
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	var out strings.Builder
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return out.String(), changes
+		}
+		if tokenType != html.StartTagToken && tokenType != html.SelfClosingTagToken {
+			out.Write(tokenizer.Raw())
+			continue
+		}
+
+		raw := string(tokenizer.Raw())
+		token := tokenizer.Token()
+		altered := false
+		attrs := make([]html.Attribute, 0, len(token.Attr)+1)
+		hasControls := false
+		for _, attr := range token.Attr {
+			switch {
+			case motionMediaTags[token.Data] && attr.Key == "autoplay":
+				record(token.Data, MotionAutoplayRemoved)
+				altered = true
+				continue
+			case motionMediaTags[token.Data] && attr.Key == "loop":
+				record(token.Data, MotionLoopRemoved)
+				altered = true
+				continue
+			case attr.Key == "controls":
+				hasControls = true
+			}
+			attrs = append(attrs, attr)
+		}
+		if motionMediaTags[token.Data] && altered && !hasControls {
+			attrs = append(attrs, html.Attribute{Key: "controls", Val: "controls"})
+		}
+		if token.Data == "img" && isGIF(token.Attr) {
+			record(token.Data, MotionAnimatedImage)
+			attrs = append(attrs, html.Attribute{Key: "data-animated", Val: "true"})
+			altered = true
+		}
+
+		if !altered {
+			out.WriteString(raw)
+			continue
+		}
+		out.WriteString("<" + token.Data)
+		for _, attr := range attrs {
+			out.WriteString(" " + attr.Key + `="` + attributeEscaper.Replace(attr.Val) + `"`)
+		}
+		if tokenType == html.SelfClosingTagToken {
+			out.WriteString(" />")
+		} else {
+			out.WriteString(">")
+		}
+	}
+}
+
+// isGIF reports whether an image's src points at a .gif file
+func isGIF(attrs []html.Attribute) bool {
+	for _, attr := range attrs {
+		if attr.Key != "src" {
+			continue
+		}
+		src := attr.Val
+		if parsed, err := url.Parse(strings.TrimSpace(src)); err == nil {
+			src = parsed.Path
+		}
+		return strings.EqualFold(path.Ext(src), ".gif")
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReducedMotionHTML(t *testing.T) {
+	content := `<p>Watch <video src="/intro.mp4" autoplay loop></video></p>` +
+		`<video src="/demo.mp4" autoplay controls></video>` +
+		`<audio src="/theme.mp3" loop></audio>` +
+		`<img src="/cat.GIF?size=large" alt="Dancing cat"><img src="/dog.gif" alt="Dog" />` +
+		`<img src="/chart.png" alt="Chart"><div loop="loop">Not media</div>`
+
+	got, changes := ReducedMotionHTML(content)
+	want := `<p>Watch <video src="/intro.mp4" controls="controls"></video></p>` +
+		`<video src="/demo.mp4" controls=""></video>` +
+		`<audio src="/theme.mp3" controls="controls"></audio>` +
+		`<img src="/cat.GIF?size=large" alt="Dancing cat" data-animated="true"><img src="/dog.gif" alt="Dog" data-animated="true" />` +
+		`<img src="/chart.png" alt="Chart"><div loop="loop">Not media</div>`
+	if got != want {
+		t.Errorf("ReducedMotionHTML = %q, want %q", got, want)
+	}
+
+	wantChanges := []MotionChange{
+		{Element: "video", Change: MotionAutoplayRemoved, Count: 2},
+		{Element: "video", Change: MotionLoopRemoved, Count: 1},
+		{Element: "audio", Change: MotionLoopRemoved, Count: 1},
+		{Element: "img", Change: MotionAnimatedImage, Count: 2},
+	}
+	if !reflect.DeepEqual(changes, wantChanges) {
+		t.Errorf("changes = %+v, want %+v", changes, wantChanges)
+	}
+	if s := changes[0].String(); s != "video autoplay=2" {
+		t.Errorf("String() = %q, want video autoplay=2", s)
+	}
+
+	still := `<p>Nothing <img src="/photo.jpg" alt="Photo"> moves</p>`
+	if got, changes := ReducedMotionHTML(still); got != still || len(changes) != 0 {
+		t.Errorf("still content = %q with %+v, want it unchanged", got, changes)
+	}
+}
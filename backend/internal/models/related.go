@@ -0,0 +1,19 @@
+package models
+
+// MaxRelatedPosts caps the tag-based related posts returned for a post
+const MaxRelatedPosts = 5
+
+// RelatedPost is a published post sharing tags with another post
+type RelatedPost struct {
+	Slug       string `json:"slug"`
+	Title      string `json:"title"`
+	SharedTags int    `json:"shared_tags"`
+}
+
+// RelatedPostsResponse lists posts related to a post by shared tags and by
+// internal links in either direction
+type RelatedPostsResponse struct {
+	Related    []RelatedPost  `json:"related"`     // Most shared tags first
+	LinksTo    []AdjacentPost `json:"links_to"`    // Published posts this post links to, in link order
+	LinkedFrom []AdjacentPost `json:"linked_from"` // Published posts linking to this post, newest first
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+// BlogRevision is a snapshot of a blog post taken before it was edited
+type BlogRevision struct {
+	ID        uint      `json:"id" gorm:"primary_key"`
+	BlogID    uint      `json:"blog_id" gorm:"not null;index"`
+	Version   int       `json:"version" gorm:"not null"`
+	Title     string    `json:"title" gorm:"not null;size:255"`
+	Content   string    `json:"content" gorm:"type:text"`
+	Excerpt   string    `json:"excerpt" gorm:"size:500"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BlogRevisionResponse represents a revision in list responses
+type BlogRevisionResponse struct {
+	Version   int       `json:"version"`
+	Title     string    `json:"title"`
+	Excerpt   string    `json:"excerpt"`
+	Content   string    `json:"content,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewBlogRevision snapshots the revisable fields of a blog
+func NewBlogRevision(b *Blog, version int) BlogRevision {
+	return BlogRevision{
+		BlogID:  b.ID,
+		Version: version,
+		Title:   b.Title,
+		Content: b.Content,
+		Excerpt: b.Excerpt,
+	}
+}
+
+// ToResponse converts BlogRevision to BlogRevisionResponse
+func (r *BlogRevision) ToResponse(includeContent bool) BlogRevisionResponse {
+	response := BlogRevisionResponse{
+		Version:   r.Version,
+		Title:     r.Title,
+		Excerpt:   r.Excerpt,
+		CreatedAt: r.CreatedAt,
+	}
+	if includeContent {
+		response.Content = r.Content
+	}
+	return response
+}
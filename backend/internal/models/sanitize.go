@@ -0,0 +1,360 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// SanitizerPolicy is the allowlist of HTML tags, attributes and URL schemes
+// kept in post content. Everything else is stripped, keeping its text.
+type SanitizerPolicy struct {
+	Tags             map[string][]string `json:"tags"`              // Allowed tag -> attributes allowed on it
+	GlobalAttributes []string            `json:"global_attributes"` // Attributes allowed on every allowed tag
+	URLSchemes       []string            `json:"url_schemes"`       // Schemes allowed in URL attributes; relative URLs are always allowed
+}
+
+// DefaultSanitizerPolicy returns the policy used when SANITIZER_POLICY is unset.
+// It covers the common blog formatting tags and no embeds.
+func DefaultSanitizerPolicy() SanitizerPolicy {
+	return SanitizerPolicy{
+		Tags: map[string][]string{
+			"a": {"href", "rel", "target"}, "abbr": {}, "b": {}, "blockquote": {"cite"}, "br": {},
+			"caption": {}, "code": {}, "dd": {}, "del": {}, "details": {}, "div": {}, "dl": {}, "dt": {},
+			"em": {}, "figcaption": {}, "figure": {}, "h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {},
+			"h6": {}, "hr": {}, "i": {}, "img": {"src", "alt", "width", "height", "loading"}, "ins": {},
+			"kbd": {}, "li": {}, "mark": {}, "ol": {"start", "type"}, "p": {}, "pre": {}, "q": {"cite"},
+			"s": {}, "samp": {}, "small": {}, "span": {}, "strong": {}, "sub": {}, "summary": {}, "sup": {},
+			"table": {}, "tbody": {}, "td": {"colspan", "rowspan", "headers"}, "tfoot": {},
+			"th": {"colspan", "rowspan", "scope", "headers"}, "thead": {}, "time": {"datetime"}, "tr": {},
+			"u": {}, "ul": {},
+		},
+		GlobalAttributes: []string{
+			"class", "id", "title", "lang", "dir", "role",
+			"aria-label", "aria-labelledby", "aria-describedby", "aria-hidden",
+		},
+		URLSchemes: []string{"http", "https", "mailto", "tel"},
+	}
+}
+
+// policyNameRegex matches valid tag and attribute names
+var policyNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// forbiddenTags can never be allowed because their content is executable
+var forbiddenTags = map[string]bool{"script": true, "style": true}
+
+// forbiddenSchemes can never be allowed because they run code when followed
+var forbiddenSchemes = map[string]bool{"javascript": true, "vbscript": true}
+
+// urlAttributes hold URLs and are checked against the allowed schemes
+var urlAttributes = map[string]bool{"href": true, "src": true, "cite": true, "poster": true}
+
+// dropContentTags are removed together with everything inside them
+var dropContentTags = map[string]bool{"script": true, "style": true}
+
+// Validate checks that the policy allows at least one tag and nothing unsafe
+func (p SanitizerPolicy) Validate() error {
+	if len(p.Tags) == 0 {
+		return fmt.Errorf("policy must allow at least one tag")
+	}
+	for tag, attrs := range p.Tags {
+		if !policyNameRegex.MatchString(tag) {
+			return fmt.Errorf("invalid tag name %q", tag)
+		}
+		if forbiddenTags[tag] {
+			return fmt.Errorf("tag %q cannot be allowed", tag)
+		}
+		for _, attr := range attrs {
+			if err := validatePolicyAttribute(attr); err != nil {
+				return fmt.Errorf("tag %q: %v", tag, err)
+			}
+		}
+	}
+	for _, attr := range p.GlobalAttributes {
+		if err := validatePolicyAttribute(attr); err != nil {
+			return fmt.Errorf("global attributes: %v", err)
+		}
+	}
+	for _, scheme := range p.URLSchemes {
+		if !policyNameRegex.MatchString(scheme) {
+			return fmt.Errorf("invalid URL scheme %q", scheme)
+		}
+		if forbiddenSchemes[scheme] {
+			return fmt.Errorf("URL scheme %q cannot be allowed", scheme)
+		}
+	}
+	return nil
+}
+
+// validatePolicyAttribute rejects malformed and event handler attribute names
+func validatePolicyAttribute(attr string) error {
+	if !policyNameRegex.MatchString(attr) {
+		return fmt.Errorf("invalid attribute name %q", attr)
+	}
+	if strings.HasPrefix(attr, "on") {
+		return fmt.Errorf("event handler attribute %q cannot be allowed", attr)
+	}
+	return nil
+}
+
+// Summary describes the allowlist on one line for startup logs
+func (p SanitizerPolicy) Summary() string {
+	tags := make([]string, 0, len(p.Tags))
+	for tag, attrs := range p.Tags {
+		if len(attrs) == 0 {
+			tags = append(tags, tag)
+			continue
+		}
+		sorted := append([]string(nil), attrs...)
+		sort.Strings(sorted)
+		tags = append(tags, tag+"["+strings.Join(sorted, ",")+"]")
+	}
+	sort.Strings(tags)
+
+	return fmt.Sprintf("tags: %s; global attributes: %s; URL schemes: %s",
+		strings.Join(tags, " "), listOrNone(p.GlobalAttributes), listOrNone(p.URLSchemes))
+}
+
+// listOrNone joins names with commas, or returns "none" for an empty list
+func listOrNone(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ",")
+}
+
+// LoadSanitizerPolicy reads a policy from a JSON file, returning the default
+// policy when path is empty
+func LoadSanitizerPolicy(path string) (SanitizerPolicy, error) {
+	if path == "" {
+		return DefaultSanitizerPolicy(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return SanitizerPolicy{}, err
+	}
+
+	var policy SanitizerPolicy
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&policy); err != nil {
+		return SanitizerPolicy{}, fmt.Errorf("invalid policy JSON: %v", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return SanitizerPolicy{}, err
+	}
+	return policy, nil
+}
+
+// compiledPolicy is a SanitizerPolicy indexed for lookups
+type compiledPolicy struct {
+	tags    map[string]map[string]bool
+	global  map[string]bool
+	schemes map[string]bool
+}
+
+// compile indexes the policy for sanitization
+func (p SanitizerPolicy) compile() *compiledPolicy {
+	compiled := &compiledPolicy{
+		tags:    make(map[string]map[string]bool, len(p.Tags)),
+		global:  make(map[string]bool, len(p.GlobalAttributes)),
+		schemes: make(map[string]bool, len(p.URLSchemes)),
+	}
+	for tag, attrs := range p.Tags {
+		allowed := make(map[string]bool, len(attrs))
+		for _, attr := range attrs {
+			allowed[attr] = true
+		}
+		compiled.tags[tag] = allowed
+	}
+	for _, attr := range p.GlobalAttributes {
+		compiled.global[attr] = true
+	}
+	for _, scheme := range p.URLSchemes {
+		compiled.schemes[scheme] = true
+	}
+	return compiled
+}
+
+// sanitizer is the active policy used by SanitizeHTML
+var sanitizer = DefaultSanitizerPolicy().compile()
+
+// SetSanitizerPolicy configures the policy used by SanitizeHTML
+func SetSanitizerPolicy(policy SanitizerPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	sanitizer = policy.compile()
+	return nil
+}
+
+// Escapers for re-encoding text and attribute values
+var (
+	textEscaper      = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	attributeEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// Reasons reported for markup removed by the sanitizer
+const (
+	RemovedWithContent    = "removed with its content"
+	RemovedTagNotAllowed  = "tag not allowed; its text is kept"
+	RemovedAttrNotAllowed = "attribute not allowed"
+	RemovedURLScheme      = "URL scheme not allowed"
+	RemovedDuplicateAttr  = "duplicate attribute"
+)
+
+// SanitizerRemoval is an element or attribute stripped by the sanitizer,
+// with the number of times it was removed
+type SanitizerRemoval struct {
+	Element   string `json:"element"`
+	Attribute string `json:"attribute,omitempty"` // Empty when the whole element was removed
+	Reason    string `json:"reason"`
+	Count     int    `json:"count"`
+}
+
+// SanitizePreviewRequest is raw content to run through the sanitizer
+type SanitizePreviewRequest struct {
+	Content string `json:"content" validate:"required"`
+}
+
+// SanitizePreviewResponse is sanitized content and what was removed from it
+type SanitizePreviewResponse struct {
+	HTML    string             `json:"html"`
+	Removed []SanitizerRemoval `json:"removed"` // In order of first removal
+}
+
+// removals collects sanitizer removals, merging repeats
+type removals struct {
+	list  []SanitizerRemoval
+	index map[SanitizerRemoval]int
+}
+
+// add records one removal of the element, or of its attribute when set
+func (r *removals) add(element, attribute, reason string) {
+	if r == nil {
+		return
+	}
+	key := SanitizerRemoval{Element: element, Attribute: attribute, Reason: reason}
+	if i, ok := r.index[key]; ok {
+		r.list[i].Count++
+		return
+	}
+	r.index[key] = len(r.list)
+	key.Count = 1
+	r.list = append(r.list, key)
+}
+
+// SanitizeHTML strips tags and attributes outside the configured policy from
+// content, keeping the text of removed tags except script and style
+func SanitizeHTML(content string) string {
+	return sanitizeHTML(content, nil)
+}
+
+// SanitizeHTMLReport sanitizes content like SanitizeHTML and also lists the
+// elements and attributes that were removed
+func SanitizeHTMLReport(content string) (string, []SanitizerRemoval) {
+	removed := &removals{list: []SanitizerRemoval{}, index: make(map[SanitizerRemoval]int)}
+	return sanitizeHTML(content, removed), removed.list
+}
+
+// sanitizeHTML applies the active policy, recording removals when removed is not nil
+func sanitizeHTML(content string, removed *removals) string {
+	policy := sanitizer
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+
+	var out strings.Builder
+	skipDepth := 0
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			// io.EOF or malformed input; either way the output so far is safe
+			return out.String()
+
+		case html.TextToken:
+			if skipDepth == 0 {
+				out.WriteString(textEscaper.Replace(string(tokenizer.Text())))
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if dropContentTags[token.Data] {
+				if skipDepth == 0 {
+					removed.add(token.Data, "", RemovedWithContent)
+				}
+				if tokenType == html.StartTagToken {
+					skipDepth++
+				}
+				continue
+			}
+			if skipDepth > 0 {
+				continue
+			}
+			allowedAttrs, ok := policy.tags[token.Data]
+			if !ok {
+				removed.add(token.Data, "", RemovedTagNotAllowed)
+				continue
+			}
+
+			out.WriteString("<" + token.Data)
+			seen := make(map[string]bool, len(token.Attr))
+			for _, attr := range token.Attr {
+				if attr.Namespace != "" || !(allowedAttrs[attr.Key] || policy.global[attr.Key]) {
+					removed.add(token.Data, attr.Key, RemovedAttrNotAllowed)
+					continue
+				}
+				if seen[attr.Key] {
+					removed.add(token.Data, attr.Key, RemovedDuplicateAttr)
+					continue
+				}
+				if urlAttributes[attr.Key] && !policy.allowsURL(attr.Val) {
+					removed.add(token.Data, attr.Key, RemovedURLScheme)
+					continue
+				}
+				seen[attr.Key] = true
+				out.WriteString(" " + attr.Key + `="` + attributeEscaper.Replace(attr.Val) + `"`)
+			}
+			if tokenType == html.SelfClosingTagToken {
+				out.WriteString(" />")
+			} else {
+				out.WriteString(">")
+			}
+
+		case html.EndTagToken:
+			token := tokenizer.Token()
+			if dropContentTags[token.Data] {
+				if skipDepth > 0 {
+					skipDepth--
+				}
+				continue
+			}
+			if _, ok := policy.tags[token.Data]; skipDepth == 0 && ok {
+				out.WriteString("</" + token.Data + ">")
+			}
+		}
+	}
+}
+
+// allowsURL reports whether a URL attribute value is relative or uses an
+// allowed scheme. Whitespace and control characters are ignored, as browsers do.
+func (p *compiledPolicy) allowsURL(value string) bool {
+	cleaned := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, value)
+
+	index := strings.IndexAny(cleaned, ":/?#")
+	if index < 0 || cleaned[index] != ':' {
+		return true
+	}
+	return p.schemes[strings.ToLower(cleaned[:index])]
+}
@@ -0,0 +1,119 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// embedContent holds a video embed alongside markup no policy should keep
+const embedContent = `<p onclick="steal()">Watch <script>alert(1)</script>this:</p>` +
+	`<iframe src="https://www.youtube.com/embed/abc" allowfullscreen onload="steal()">Video</iframe>` +
+	`<iframe src="javascript:alert(1)">Bad</iframe>`
+
+// writePolicy writes a policy file to a temporary directory and returns its path
+func writePolicy(t *testing.T, policy string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte(policy), 0o600); err != nil {
+		t.Fatalf("write policy: %v", err)
+	}
+	return path
+}
+
+func TestSanitizeHTMLDefaultPolicy(t *testing.T) {
+	want := "<p>Watch this:</p>VideoBad"
+	if got := SanitizeHTML(embedContent); got != want {
+		t.Errorf("SanitizeHTML = %q, want %q", got, want)
+	}
+	if got := SanitizeHTML(`<a href="javascript:alert(1)" title="Docs">link</a>`); got != `<a title="Docs">link</a>` {
+		t.Errorf("javascript link = %q, want the href removed", got)
+	}
+}
+
+func TestLoadSanitizerPolicyCustom(t *testing.T) {
+	t.Cleanup(func() { SetSanitizerPolicy(DefaultSanitizerPolicy()) })
+
+	path := writePolicy(t, `{
+		"tags": {"p": [], "iframe": ["src", "allowfullscreen"]},
+		"global_attributes": ["class"],
+		"url_schemes": ["https"]
+	}`)
+	policy, err := LoadSanitizerPolicy(path)
+	if err != nil {
+		t.Fatalf("load policy: %v", err)
+	}
+	if want := "tags: iframe[allowfullscreen,src] p; global attributes: class; URL schemes: https"; policy.Summary() != want {
+		t.Errorf("Summary() = %q, want %q", policy.Summary(), want)
+	}
+	if err := SetSanitizerPolicy(policy); err != nil {
+		t.Fatalf("set policy: %v", err)
+	}
+
+	got := SanitizeHTML(embedContent)
+	if !strings.Contains(got, `<iframe src="https://www.youtube.com/embed/abc" allowfullscreen`) {
+		t.Errorf("allowed iframe did not survive sanitization: %q", got)
+	}
+	for _, removed := range []string{"onclick", "onload", "script", "alert", "javascript"} {
+		if strings.Contains(got, removed) {
+			t.Errorf("sanitized content still contains %q: %q", removed, got)
+		}
+	}
+	if got := SanitizeHTML(`<p><strong>Bold</strong></p>`); got != "<p>Bold</p>" {
+		t.Errorf("tag outside the custom policy = %q, want it stripped", got)
+	}
+}
+
+func TestLoadSanitizerPolicyRejectsUnsafePolicies(t *testing.T) {
+	if policy, err := LoadSanitizerPolicy(""); err != nil || policy.Summary() != DefaultSanitizerPolicy().Summary() {
+		t.Errorf("empty path: policy %q, error %v; want the default policy", policy.Summary(), err)
+	}
+
+	policies := map[string]string{
+		"script tag":      `{"tags": {"script": []}}`,
+		"style tag":       `{"tags": {"p": [], "style": []}}`,
+		"event handler":   `{"tags": {"img": ["src", "onerror"]}}`,
+		"global handler":  `{"tags": {"p": []}, "global_attributes": ["onclick"]}`,
+		"javascript URLs": `{"tags": {"a": ["href"]}, "url_schemes": ["https", "javascript"]}`,
+		"no tags":         `{"tags": {}}`,
+		"bad tag name":    `{"tags": {"<p>": []}}`,
+		"unknown field":   `{"tags": {"p": []}, "allow_everything": true}`,
+		"malformed JSON":  `{"tags": `,
+	}
+	for name, policy := range policies {
+		if _, err := LoadSanitizerPolicy(writePolicy(t, policy)); err == nil {
+			t.Errorf("%s: policy accepted", name)
+		}
+	}
+	if _, err := LoadSanitizerPolicy(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("missing policy file accepted")
+	}
+	if err := SetSanitizerPolicy(SanitizerPolicy{Tags: map[string][]string{"script": nil}}); err == nil {
+		t.Error("SetSanitizerPolicy accepted an unsafe policy")
+	}
+}
+
+func TestSanitizeHTMLReport(t *testing.T) {
+	content := embedContent + `<a href="javascript:alert(1)" title="Docs" title="Again">link</a>`
+	got, removed := SanitizeHTMLReport(content)
+	if want := SanitizeHTML(content); got != want {
+		t.Errorf("SanitizeHTMLReport HTML = %q, want SanitizeHTML's %q", got, want)
+	}
+
+	want := []SanitizerRemoval{
+		{Element: "p", Attribute: "onclick", Reason: RemovedAttrNotAllowed, Count: 1},
+		{Element: "script", Reason: RemovedWithContent, Count: 1},
+		{Element: "iframe", Reason: RemovedTagNotAllowed, Count: 2},
+		{Element: "a", Attribute: "href", Reason: RemovedURLScheme, Count: 1},
+		{Element: "a", Attribute: "title", Reason: RemovedDuplicateAttr, Count: 1},
+	}
+	if !reflect.DeepEqual(removed, want) {
+		t.Errorf("removed = %+v, want %+v", removed, want)
+	}
+
+	if _, removed := SanitizeHTMLReport(`<p>Nothing <strong>to</strong> remove</p>`); len(removed) != 0 {
+		t.Errorf("clean content removed = %+v, want none", removed)
+	}
+}
@@ -0,0 +1,154 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// IntroductionAnchor is the anchor of the section holding content before the first heading
+const IntroductionAnchor = "introduction"
+
+// ContentSection is a part of a post starting at a top-level heading
+type ContentSection struct {
+	Anchor  string `json:"anchor"`  // Heading id, or one derived from its text
+	Heading string `json:"heading"` // Plain heading text; empty for the introduction
+	HTML    string `json:"html"`    // Section markup, including its heading
+}
+
+// Heading is an h1-h6 element found in post content
+type Heading struct {
+	Level  int    // 1 for h1 through 6 for h6
+	Text   string // Plain text of the heading
+	Anchor string // The heading's id, or one derived from its text, unique within the content
+	Start  int    // Byte offset of the heading's start tag in the content
+}
+
+// voidElements have no end tag, so they never open a nesting level
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// headingLevel returns 1-6 for h1-h6 and 0 for any other tag
+func headingLevel(tag string) int {
+	if len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6' {
+		return int(tag[1] - '0')
+	}
+	return 0
+}
+
+// ParseHeadings returns the headings that are not nested inside other
+// elements, in document order, each with an anchor unique within the content
+func ParseHeadings(content string) []Heading {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	headings := []Heading{}
+	used := map[string]bool{IntroductionAnchor: true}
+	offset := 0
+	depth := 0
+	var current *Heading
+	var text strings.Builder
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return headings
+		}
+		start := offset
+		offset += len(tokenizer.Raw())
+
+		switch tokenType {
+		case html.StartTagToken:
+			token := tokenizer.Token()
+			if voidElements[token.Data] {
+				continue
+			}
+			if level := headingLevel(token.Data); level > 0 && depth == 0 && current == nil {
+				current = &Heading{Level: level, Start: start}
+				for _, attr := range token.Attr {
+					if attr.Key == "id" {
+						current.Anchor = strings.TrimSpace(attr.Val)
+					}
+				}
+				text.Reset()
+			}
+			depth++
+
+		case html.EndTagToken:
+			if depth > 0 {
+				depth--
+			}
+			token := tokenizer.Token()
+			if current != nil && depth == 0 && headingLevel(token.Data) > 0 {
+				current.Text = strings.Join(strings.Fields(text.String()), " ")
+				current.Anchor = uniqueAnchor(current.Anchor, current.Text, used)
+				headings = append(headings, *current)
+				current = nil
+			}
+
+		case html.TextToken:
+			if current != nil {
+				text.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+// uniqueAnchor picks the heading id, or a slug of its text, suffixed until unused
+func uniqueAnchor(id, text string, used map[string]bool) string {
+	anchor := id
+	if anchor == "" {
+		anchor = GenerateSlug(text)
+	}
+	if anchor == "" {
+		anchor = "section"
+	}
+	candidate := anchor
+	for n := 2; used[candidate]; n++ {
+		candidate = anchor + "-" + strconv.Itoa(n)
+	}
+	used[candidate] = true
+	return candidate
+}
+
+// SplitSections divides content at its highest-level headings. Content before
+// the first of them becomes an introduction section; content without headings
+// is returned as a single section.
+func SplitSections(content string) []ContentSection {
+	headings := ParseHeadings(content)
+
+	topLevel := 7
+	for _, heading := range headings {
+		if heading.Level < topLevel {
+			topLevel = heading.Level
+		}
+	}
+	splits := []Heading{}
+	for _, heading := range headings {
+		if heading.Level == topLevel {
+			splits = append(splits, heading)
+		}
+	}
+
+	if len(splits) == 0 {
+		return []ContentSection{{Anchor: IntroductionAnchor, HTML: strings.TrimSpace(content)}}
+	}
+
+	sections := []ContentSection{}
+	if intro := strings.TrimSpace(content[:splits[0].Start]); intro != "" {
+		sections = append(sections, ContentSection{Anchor: IntroductionAnchor, HTML: intro})
+	}
+	for i, heading := range splits {
+		end := len(content)
+		if i+1 < len(splits) {
+			end = splits[i+1].Start
+		}
+		sections = append(sections, ContentSection{
+			Anchor:  heading.Anchor,
+			Heading: heading.Text,
+			HTML:    strings.TrimSpace(content[heading.Start:end]),
+		})
+	}
+	return sections
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseHeadings(t *testing.T) {
+	content := `<h1 id="top">Guide</h1><p>Intro</p>` +
+		`<h2>Getting <em>started</em></h2><p>Text</p>` +
+		`<h2>Getting started</h2>` +
+		`<div><h2>Boxed heading</h2></div>` +
+		`<h3 id=" custom ">Details</h3><br><img src="a.png">` +
+		`<h2>Introduction</h2><h2>!!!</h2>`
+	got := ParseHeadings(content)
+	at := func(markup string) int { return strings.Index(content, markup) }
+	want := []Heading{
+		{Level: 1, Text: "Guide", Anchor: "top", Start: 0},
+		{Level: 2, Text: "Getting started", Anchor: "getting-started", Start: at("<h2>Getting <em>")},
+		{Level: 2, Text: "Getting started", Anchor: "getting-started-2", Start: at("<h2>Getting started</h2>")},
+		{Level: 3, Text: "Details", Anchor: "custom", Start: at("<h3")},
+		{Level: 2, Text: "Introduction", Anchor: "introduction-2", Start: at("<h2>Introduction")},
+		{Level: 2, Text: "!!!", Anchor: GenerateSlug("!!!"), Start: at("<h2>!!!")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseHeadings =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestSplitSections(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []ContentSection
+	}{
+		{
+			"multiple headings with an introduction",
+			"<p>Welcome.</p>\n<h2>Setup</h2><p>Install it.</p><h3>On Linux</h3><p>Use apt.</p>\n<h2 id=\"use\">Usage</h2><p>Run it.</p>",
+			[]ContentSection{
+				{Anchor: IntroductionAnchor, HTML: "<p>Welcome.</p>"},
+				{Anchor: "setup", Heading: "Setup", HTML: "<h2>Setup</h2><p>Install it.</p><h3>On Linux</h3><p>Use apt.</p>"},
+				{Anchor: "use", Heading: "Usage", HTML: `<h2 id="use">Usage</h2><p>Run it.</p>`},
+			},
+		},
+		{
+			"split at the highest level present",
+			"<h1>Title</h1><p>Lead.</p><h2>Part</h2><p>Body.</p>",
+			[]ContentSection{
+				{Anchor: "title", Heading: "Title", HTML: "<h1>Title</h1><p>Lead.</p><h2>Part</h2><p>Body.</p>"},
+			},
+		},
+		{
+			"heading first has no introduction",
+			"  <h3>Only</h3><p>Text.</p>  ",
+			[]ContentSection{
+				{Anchor: "only", Heading: "Only", HTML: "<h3>Only</h3><p>Text.</p>"},
+			},
+		},
+		{
+			"no headings",
+			"  <p>Just one paragraph.</p><p>And another.</p>\n",
+			[]ContentSection{
+				{Anchor: IntroductionAnchor, HTML: "<p>Just one paragraph.</p><p>And another.</p>"},
+			},
+		},
+		{
+			"nested headings do not split",
+			"<p>Intro.</p><blockquote><h2>Quoted</h2></blockquote><p>More.</p>",
+			[]ContentSection{
+				{Anchor: IntroductionAnchor, HTML: "<p>Intro.</p><blockquote><h2>Quoted</h2></blockquote><p>More.</p>"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		if got := SplitSections(tt.content); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: SplitSections =\n%+v\nwant\n%+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultSiteURL is the public site URL used in development when SITE_URL is unset
+const DefaultSiteURL = "http://localhost:8080"
+
+// ParseSiteURL validates that raw is an absolute http or https URL without a
+// query or fragment, returning it without a trailing slash
+func ParseSiteURL(raw string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", errors.New("must be an absolute http or https URL, e.g. https://blog.example.com")
+	}
+	if parsed.Host == "" {
+		return "", errors.New("must include a host, e.g. https://blog.example.com")
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", errors.New("must not include a query string or fragment")
+	}
+	return strings.TrimRight(parsed.String(), "/"), nil
+}
+
+// OpenGraphMeta holds OpenGraph tags for a single blog post
+type OpenGraphMeta struct {
+	Title         string `json:"og:title"`
+	Description   string `json:"og:description"`
+	Type          string `json:"og:type"`
+	URL           string `json:"og:url"`
+	Image         string `json:"og:image,omitempty"`
+	ImageAlt      string `json:"og:image:alt,omitempty"`
+	PublishedTime string `json:"article:published_time,omitempty"`
+	Author        string `json:"article:author"`
+}
+
+// jsonLDAuthor is the schema.org Person embedded in BlogPosting markup
+type jsonLDAuthor struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// jsonLDBlogPosting is the schema.org BlogPosting structured data
+type jsonLDBlogPosting struct {
+	Context          string       `json:"@context"`
+	Type             string       `json:"@type"`
+	Headline         string       `json:"headline"`
+	Description      string       `json:"description"`
+	URL              string       `json:"url"`
+	Image            string       `json:"image,omitempty"`
+	MainEntityOfPage string       `json:"mainEntityOfPage"`
+	Author           jsonLDAuthor `json:"author"`
+	DatePublished    string       `json:"datePublished,omitempty"`
+	DateModified     string       `json:"dateModified"`
+	Keywords         string       `json:"keywords,omitempty"`
+}
+
+// SEOWarning is a non-blocking note that an SEO field could be improved
+type SEOWarning struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// SEOWarnings flags a meta title or description shorter than recommended
+func SEOWarnings(metaTitle, metaDesc string) []SEOWarning {
+	warnings := []SEOWarning{}
+	if length := utf8.RuneCountInString(metaTitle); length < MetaTitleMinLength {
+		warnings = append(warnings, SEOWarning{
+			Field:   "meta_title",
+			Message: "meta_title is " + strconv.Itoa(length) + " characters; " + strconv.Itoa(MetaTitleMinLength) + " to " + strconv.Itoa(MetaTitleMaxLength) + " make a better search snippet",
+		})
+	}
+	if length := utf8.RuneCountInString(metaDesc); length < MetaDescMinLength {
+		warnings = append(warnings, SEOWarning{
+			Field:   "meta_description",
+			Message: "meta_description is " + strconv.Itoa(length) + " characters; " + strconv.Itoa(MetaDescMinLength) + " to " + strconv.Itoa(MetaDescMaxLength) + " make a better search snippet",
+		})
+	}
+	return warnings
+}
+
+// BlogURL builds the absolute public URL of a blog post
+func BlogURL(siteURL, slug string) string {
+	return strings.TrimRight(siteURL, "/") + "/blog/" + slug
+}
+
+// seoTitle returns the meta title, falling back to the post title
+func (b *Blog) seoTitle() string {
+	if b.MetaTitle != "" {
+		return b.MetaTitle
+	}
+	return b.Title
+}
+
+// seoDescription returns the meta description, falling back to the excerpt
+func (b *Blog) seoDescription() string {
+	if b.MetaDesc != "" {
+		return b.MetaDesc
+	}
+	return b.Excerpt
+}
+
+// OpenGraph builds the OpenGraph tags for a blog post
+func (b *Blog) OpenGraph(siteURL string) OpenGraphMeta {
+	meta := OpenGraphMeta{
+		Title:       b.seoTitle(),
+		Description: b.seoDescription(),
+		Type:        "article",
+		URL:         BlogURL(siteURL, b.Slug),
+		Image:       b.FeaturedImage,
+		ImageAlt:    b.FeaturedImageAlt,
+		Author:      b.Author,
+	}
+	if b.PublishedAt != nil {
+		meta.PublishedTime = b.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	return meta
+}
+
+// JSONLD builds schema.org BlogPosting markup for a blog post
+func (b *Blog) JSONLD(siteURL string) (string, error) {
+	url := BlogURL(siteURL, b.Slug)
+	posting := jsonLDBlogPosting{
+		Context:          "https://schema.org",
+		Type:             "BlogPosting",
+		Headline:         b.seoTitle(),
+		Description:      b.seoDescription(),
+		URL:              url,
+		Image:            b.FeaturedImage,
+		MainEntityOfPage: url,
+		Author:           jsonLDAuthor{Type: "Person", Name: b.Author},
+		DateModified:     b.UpdatedAt.UTC().Format(time.RFC3339),
+		Keywords:         b.Tags,
+	}
+	if b.PublishedAt != nil {
+		posting.DatePublished = b.PublishedAt.UTC().Format(time.RFC3339)
+	}
+
+	data, err := json.Marshal(posting)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
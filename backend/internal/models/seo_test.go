@@ -0,0 +1,71 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSiteURL(t *testing.T) {
+	valid := map[string]string{
+		"https://blog.example.com":       "https://blog.example.com",
+		"https://blog.example.com/":      "https://blog.example.com",
+		" http://localhost:8080 ":        "http://localhost:8080",
+		"https://example.com/news/blog/": "https://example.com/news/blog",
+	}
+	for raw, want := range valid {
+		if got, err := ParseSiteURL(raw); err != nil || got != want {
+			t.Errorf("ParseSiteURL(%q) = %q, %v; want %q", raw, got, err, want)
+		}
+	}
+
+	for _, raw := range []string{"", "blog.example.com", "//blog.example.com", "ftp://blog.example.com", "https://", "https://blog.example.com?x=1", "https://blog.example.com#top", "http://[::1"} {
+		if got, err := ParseSiteURL(raw); err == nil {
+			t.Errorf("ParseSiteURL(%q) = %q, want an error", raw, got)
+		}
+	}
+}
+
+func TestBlogURL(t *testing.T) {
+	for _, site := range []string{"https://blog.example.com", "https://blog.example.com/"} {
+		if got := BlogURL(site, "first-post"); got != "https://blog.example.com/blog/first-post" {
+			t.Errorf("BlogURL(%q) = %q, want https://blog.example.com/blog/first-post", site, got)
+		}
+	}
+}
+
+func TestSEOWarnings(t *testing.T) {
+	title := func(n int) string { return strings.Repeat("t", n) }
+	desc := func(n int) string { return strings.Repeat("d", n) }
+	tests := []struct {
+		name      string
+		metaTitle string
+		metaDesc  string
+		fields    []string
+	}{
+		{"well sized", title(MetaTitleMinLength), desc(MetaDescMinLength), []string{}},
+		{"at the maximums", title(MetaTitleMaxLength), desc(MetaDescMaxLength), []string{}},
+		{"short title", title(MetaTitleMinLength - 1), desc(100), []string{"meta_title"}},
+		{"short description", title(40), desc(MetaDescMinLength - 1), []string{"meta_description"}},
+		{"both empty", "", "", []string{"meta_title", "meta_description"}},
+		{"multibyte counted in characters", strings.Repeat("é", MetaTitleMinLength), strings.Repeat("é", MetaDescMinLength), []string{}},
+	}
+	for _, tt := range tests {
+		warnings := SEOWarnings(tt.metaTitle, tt.metaDesc)
+		fields := []string{}
+		for _, warning := range warnings {
+			fields = append(fields, warning.Field)
+			if !strings.HasPrefix(warning.Message, warning.Field+" is ") {
+				t.Errorf("%s: message %q does not name its field", tt.name, warning.Message)
+			}
+		}
+		if !reflect.DeepEqual(fields, tt.fields) {
+			t.Errorf("%s: warnings on %q, want %q", tt.name, fields, tt.fields)
+		}
+	}
+
+	want := SEOWarning{Field: "meta_title", Message: "meta_title is 10 characters; 30 to 60 make a better search snippet"}
+	if got := SEOWarnings(title(10), desc(80)); len(got) != 1 || got[0] != want {
+		t.Errorf("SEOWarnings = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// Series is an ordered collection of posts, such as a multi-part guide
+type Series struct {
+	ID          uint      `json:"id" gorm:"primary_key"`
+	Title       string    `json:"title" gorm:"not null;size:255"`
+	Slug        string    `json:"slug" gorm:"unique;not null;size:255"`
+	Description string    `json:"description" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateSeriesRequest represents the request structure for creating a series
+type CreateSeriesRequest struct {
+	Title       string `json:"title" validate:"required,min=1,max=255"`
+	Description string `json:"description" validate:"max=2000"`
+}
+
+// SeriesPost is a published post listed in its series
+type SeriesPost struct {
+	Slug        string     `json:"slug"`
+	Title       string     `json:"title"`
+	Order       int        `json:"order"` // Position within the series, starting at 1
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+// SeriesResponse is a series with its published posts in reading order
+type SeriesResponse struct {
+	ID          uint         `json:"id"`
+	Title       string       `json:"title"`
+	Slug        string       `json:"slug"`
+	Description string       `json:"description"`
+	Posts       []SeriesPost `json:"posts"`
+}
+
+// SeriesContext places a post within its series for part-by-part navigation;
+// Prev and Next are null at either end
+type SeriesContext struct {
+	Slug     string        `json:"slug"`
+	Title    string        `json:"title"`
+	Position int           `json:"position"` // 1-based position among published posts
+	Total    int           `json:"total"`
+	Prev     *AdjacentPost `json:"prev"`
+	Next     *AdjacentPost `json:"next"`
+}
+
+// SeriesOrdering orders posts within a series, breaking ties by publish time
+const SeriesOrdering = "series_order ASC, published_at ASC, id ASC"
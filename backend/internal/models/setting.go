@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// SettingReadingWPM records the reading speed existing reading times were computed with
+const SettingReadingWPM = "reading_wpm"
+
+// SettingSeededAt records when the sample blog posts were last seeded (RFC3339)
+const SettingSeededAt = "seeded_at"
+
+// Setting is a persisted key/value used to track one-off migration and seeding state
+type Setting struct {
+	Key       string    `json:"key" gorm:"primary_key;size:100"`
+	Value     string    `json:"value" gorm:"size:255"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SeedStatus reports the state of the sample blog posts
+type SeedStatus struct {
+	Seeded             bool       `json:"seeded"`
+	SeededAt           *time.Time `json:"seeded_at"` // Null for databases seeded before it was recorded
+	SeedPosts          int        `json:"seed_posts"`
+	RemainingSeedPosts int        `json:"remaining_seed_posts"` // Sample posts not deleted
+	TotalPosts         int        `json:"total_posts"`
+	SeedingEnabled     bool       `json:"seeding_enabled"` // SEED_DATABASE, applied at startup
+}
+
+// SeedResponse reports the sample posts created by an explicit seed
+type SeedResponse struct {
+	Created int `json:"created"`
+	Skipped int `json:"skipped"` // Sample posts whose slugs were already in use
+}
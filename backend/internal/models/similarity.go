@@ -0,0 +1,107 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"technoprise-blog-backend/internal/scanner"
+)
+
+// DefaultSimilarTitleThreshold is the token overlap above which two titles are
+// reported as similar
+const DefaultSimilarTitleThreshold = 0.6
+
+// maxSimilarPosts caps the number of similar posts reported on create
+const maxSimilarPosts = 5
+
+// titleStopWords are ignored when comparing titles
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "the": true, "of": true, "to": true,
+	"in": true, "on": true, "for": true, "with": true, "your": true, "how": true,
+}
+
+// SimilarPost is an existing post whose title closely matches a new one
+type SimilarPost struct {
+	ID         uint    `json:"id"`
+	Slug       string  `json:"slug"`
+	Title      string  `json:"title"`
+	Similarity float64 `json:"similarity"` // Token overlap from 0 to 1
+}
+
+// CreateBlogResponse is the response to creating a post, warning about
+// existing posts with similar titles, content flagged by the scanner and
+// short SEO fields
+type CreateBlogResponse struct {
+	BlogResponse
+	SimilarPosts    []SimilarPost     `json:"similar_posts,omitempty"`
+	ContentWarnings []scanner.Finding `json:"content_warnings,omitempty"`
+	SEOWarnings     []SEOWarning      `json:"seo_warnings,omitempty"`
+}
+
+// UpdateBlogResponse is the response to updating a post, warning about
+// content flagged by the scanner and short SEO fields
+type UpdateBlogResponse struct {
+	BlogResponse
+	ContentWarnings []scanner.Finding `json:"content_warnings,omitempty"`
+	SEOWarnings     []SEOWarning      `json:"seo_warnings,omitempty"`
+}
+
+// titleTokens lowercases a title and splits it into its distinct words,
+// dropping punctuation and stop words
+func titleTokens(title string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make(map[string]bool, len(words))
+	for _, word := range words {
+		if !titleStopWords[word] {
+			tokens[word] = true
+		}
+	}
+	return tokens
+}
+
+// TitleSimilarity returns the Jaccard overlap of the normalized words of two
+// titles, from 0 for no shared words to 1 for the same words
+func TitleSimilarity(a, b string) float64 {
+	tokensA, tokensB := titleTokens(a), titleTokens(b)
+	if len(tokensA) == 0 || len(tokensB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for token := range tokensA {
+		if tokensB[token] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(tokensA)+len(tokensB)-shared)
+}
+
+// FindSimilarTitles returns the posts whose titles overlap title by at least
+// threshold, most similar first
+func FindSimilarTitles(title string, posts []Blog, threshold float64) []SimilarPost {
+	var similar []SimilarPost
+	for _, post := range posts {
+		score := TitleSimilarity(title, post.Title)
+		if score >= threshold {
+			similar = append(similar, SimilarPost{
+				ID:         post.ID,
+				Slug:       post.Slug,
+				Title:      post.Title,
+				Similarity: math.Round(score*100) / 100,
+			})
+		}
+	}
+
+	sort.SliceStable(similar, func(i, j int) bool {
+		return similar[i].Similarity > similar[j].Similarity
+	})
+	if len(similar) > maxSimilarPosts {
+		similar = similar[:maxSimilarPosts]
+	}
+	return similar
+}
@@ -0,0 +1,40 @@
+package models
+
+import "testing"
+
+func TestTitleSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"Building Accessible React Components", "building accessible react components!", 1},
+		{"The Guide to Dark Mode", "Dark mode: a guide", 1},                                          // Stop words and punctuation ignored
+		{"Building Accessible React Components", "Accessible React components: building guide", 0.8}, // 4 shared of 5
+		{"Dark Mode Accessibility", "Voice User Interfaces", 0},
+		{"The", "Dark mode", 0}, // Nothing left to compare
+	}
+	for _, tt := range tests {
+		if got := TitleSimilarity(tt.a, tt.b); got != tt.want {
+			t.Errorf("TitleSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFindSimilarTitles(t *testing.T) {
+	posts := []Blog{
+		{ID: 1, Slug: "dark-mode-colors", Title: "Dark Mode Colors"},
+		{ID: 2, Slug: "react-components", Title: "Accessible React Components"},
+		{ID: 3, Slug: "accessible-react-components-guide", Title: "Accessible React Components Guide"},
+	}
+
+	similar := FindSimilarTitles("Accessible React Components", posts, 0.6)
+	if len(similar) != 2 || similar[0].ID != 2 || similar[1].ID != 3 {
+		t.Fatalf("similar = %+v, want posts 2 then 3", similar)
+	}
+	if similar[0].Similarity != 1 || similar[1].Similarity != 0.75 {
+		t.Errorf("similarities = %v, %v; want 1 and 0.75", similar[0].Similarity, similar[1].Similarity)
+	}
+	if similar := FindSimilarTitles("Accessible React Components", posts, 0.9); len(similar) != 1 {
+		t.Errorf("at threshold 0.9: similar = %+v, want only the exact match", similar)
+	}
+}
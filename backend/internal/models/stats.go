@@ -0,0 +1,25 @@
+package models
+
+// AdminStats is the editorial overview returned by the admin stats endpoint
+type AdminStats struct {
+	TotalPosts         int            `json:"total_posts"`
+	PublishedPosts     int            `json:"published_posts"`
+	DraftPosts         int            `json:"draft_posts"`
+	FeaturedPosts      int            `json:"featured_posts"`
+	TotalViews         int64          `json:"total_views"`
+	AverageReadingTime float64        `json:"average_reading_time"` // Minutes, rounded to one decimal
+	TopTags            []TagCount     `json:"top_tags"`
+	PublishedPerMonth  []MonthlyCount `json:"published_per_month"` // Oldest month first
+}
+
+// TagCount is a tag with the number of posts using it
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// MonthlyCount is the number of posts published in a calendar month
+type MonthlyCount struct {
+	Month string `json:"month"` // YYYY-MM
+	Count int    `json:"count"`
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// SubscriberEmailMaxLength is the longest address accepted, per RFC 5321
+const SubscriberEmailMaxLength = 254
+
+// Subscriber is a reader who asked to be emailed about new posts. Only
+// confirmed subscribers receive notifications.
+type Subscriber struct {
+	ID        uint      `json:"id" gorm:"primary_key"`
+	Email     string    `json:"email" gorm:"unique;not null;size:254"`
+	Confirmed bool      `json:"confirmed" gorm:"not null;default:false;index"`
+	Token     string    `json:"-" gorm:"unique;not null;size:64"` // Secret used by the confirm and unsubscribe links
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SubscribeRequest represents the request structure for subscribing
+type SubscribeRequest struct {
+	Email string `json:"email" validate:"required,max=254"`
+}
+
+// SubscribeResponse acknowledges a subscription request. It is the same whether
+// or not the address was already subscribed, so addresses cannot be probed.
+type SubscribeResponse struct {
+	Email string `json:"email"`
+}
+
+// SubscriberResponse represents a subscriber after confirming
+type SubscriberResponse struct {
+	Email     string `json:"email"`
+	Confirmed bool   `json:"confirmed"`
+}
+
+// NormalizeEmail trims and lowercases a bare email address, reporting false
+// when it is not a valid address or carries a display name
+func NormalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" || len(email) > SubscriberEmailMaxLength {
+		return "", false
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", false
+	}
+	return email, true
+}
+
+// NewSubscriberToken returns a random 64-character hex token
+func NewSubscriberToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// ToResponse converts Subscriber to SubscriberResponse
+func (s *Subscriber) ToResponse() SubscriberResponse {
+	return SubscriberResponse{
+		Email:     s.Email,
+		Confirmed: s.Confirmed,
+	}
+}
@@ -0,0 +1,264 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tag limits enforced on create and update
+const (
+	MaxTags      = 10
+	MinTagLength = 2
+	MaxTagLength = 30
+)
+
+// TagValidationError describes why a tag list was rejected
+type TagValidationError struct {
+	Count   int      `json:"count,omitempty"`
+	Invalid []string `json:"invalid_tags,omitempty"`
+}
+
+// Error implements the error interface
+func (e *TagValidationError) Error() string {
+	if len(e.Invalid) > 0 {
+		return fmt.Sprintf("tags must be between %d and %d characters: %s", MinTagLength, MaxTagLength, strings.Join(e.Invalid, ", "))
+	}
+	return fmt.Sprintf("at most %d tags are allowed, got %d", MaxTags, e.Count)
+}
+
+// ParseTags splits a comma-separated tag string into trimmed, lowercased,
+// de-duplicated tags, preserving first-seen order
+func ParseTags(raw string) []string {
+	seen := make(map[string]bool)
+	tags := []string{}
+	for _, tag := range strings.Split(raw, ",") {
+		tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// DefaultTagSynonyms maps canonical tags to the synonyms replaced by them on
+// save when TAG_SYNONYMS is not set
+func DefaultTagSynonyms() map[string][]string {
+	return map[string][]string{
+		"accessibility":   {"a11y"},
+		"user experience": {"ux"},
+	}
+}
+
+// tagSynonyms maps each synonym to its canonical tag
+var tagSynonyms = indexTagSynonyms(DefaultTagSynonyms())
+
+// indexTagSynonyms inverts a canonical -> synonyms map into synonym -> canonical
+func indexTagSynonyms(synonyms map[string][]string) map[string]string {
+	index := make(map[string]string)
+	for canonical, aliases := range synonyms {
+		for _, alias := range aliases {
+			index[ParseTags(alias)[0]] = ParseTags(canonical)[0]
+		}
+	}
+	return index
+}
+
+// ValidateTagSynonyms checks that every tag in a synonym map is a valid tag,
+// no synonym is listed twice and no canonical tag is also a synonym
+func ValidateTagSynonyms(synonyms map[string][]string) error {
+	canonicals := make(map[string]bool, len(synonyms))
+	for canonical := range synonyms {
+		tags := ParseTags(canonical)
+		if len(tags) != 1 || !validTagLength(tags[0]) {
+			return fmt.Errorf("canonical tag %q must be a single tag of %d to %d characters", canonical, MinTagLength, MaxTagLength)
+		}
+		canonicals[tags[0]] = true
+	}
+
+	seen := make(map[string]string)
+	for canonical, aliases := range synonyms {
+		for _, alias := range aliases {
+			tags := ParseTags(alias)
+			if len(tags) != 1 || !validTagLength(tags[0]) {
+				return fmt.Errorf("synonym %q of %q must be a single tag of %d to %d characters", alias, canonical, MinTagLength, MaxTagLength)
+			}
+			if canonicals[tags[0]] {
+				return fmt.Errorf("synonym %q is also a canonical tag", alias)
+			}
+			if other, ok := seen[tags[0]]; ok {
+				return fmt.Errorf("synonym %q is listed for both %q and %q", alias, other, canonical)
+			}
+			seen[tags[0]] = canonical
+		}
+	}
+	return nil
+}
+
+// LoadTagSynonyms reads a canonical -> synonyms map from a JSON file, returning
+// the default synonyms when path is empty
+func LoadTagSynonyms(path string) (map[string][]string, error) {
+	if path == "" {
+		return DefaultTagSynonyms(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var synonyms map[string][]string
+	if err := json.Unmarshal(data, &synonyms); err != nil {
+		return nil, fmt.Errorf("invalid synonyms JSON: %v", err)
+	}
+	if err := ValidateTagSynonyms(synonyms); err != nil {
+		return nil, err
+	}
+	return synonyms, nil
+}
+
+// SetTagSynonyms configures the synonyms replaced by their canonical tag on save
+func SetTagSynonyms(synonyms map[string][]string) error {
+	if err := ValidateTagSynonyms(synonyms); err != nil {
+		return err
+	}
+	tagSynonyms = indexTagSynonyms(synonyms)
+	return nil
+}
+
+// TagSynonyms returns the configured synonyms, each mapped to its canonical tag
+func TagSynonyms() map[string]string {
+	synonyms := make(map[string]string, len(tagSynonyms))
+	for alias, canonical := range tagSynonyms {
+		synonyms[alias] = canonical
+	}
+	return synonyms
+}
+
+// CanonicalTag returns the canonical form of a single tag
+func CanonicalTag(tag string) string {
+	tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+	if canonical, ok := tagSynonyms[tag]; ok {
+		return canonical
+	}
+	return tag
+}
+
+// validTagLength reports whether a tag is within the tag length limits
+func validTagLength(tag string) bool {
+	length := utf8.RuneCountInString(tag)
+	return length >= MinTagLength && length <= MaxTagLength
+}
+
+// NormalizeTags parses and validates a comma-separated tag string, returning
+// the canonical stored form with synonyms replaced by their canonical tag
+func NormalizeTags(raw string) (string, error) {
+	seen := make(map[string]bool)
+	tags := []string{}
+	for _, tag := range ParseTags(raw) {
+		tag = CanonicalTag(tag)
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	var invalid []string
+	for _, tag := range tags {
+		if !validTagLength(tag) {
+			invalid = append(invalid, tag)
+		}
+	}
+	if len(invalid) > 0 {
+		return "", &TagValidationError{Invalid: invalid}
+	}
+	if len(tags) > MaxTags {
+		return "", &TagValidationError{Count: len(tags)}
+	}
+
+	return strings.Join(tags, ", "), nil
+}
+
+// Tag cloud weight bounds
+const (
+	MinTagWeight = 1
+	MaxTagWeight = 5
+)
+
+// TagCloudEntry is a tag with its post count and display weight
+type TagCloudEntry struct {
+	Tag    string `json:"tag"`
+	Count  int    `json:"count"`
+	Weight int    `json:"weight"`
+}
+
+// BuildTagCloud counts tags across comma-separated tag strings and buckets
+// each count linearly into a weight between MinTagWeight and MaxTagWeight.
+// Entries are sorted alphabetically.
+func BuildTagCloud(tagLists []string) []TagCloudEntry {
+	counts := make(map[string]int)
+	for _, raw := range tagLists {
+		for _, tag := range ParseTags(raw) {
+			counts[tag]++
+		}
+	}
+
+	minCount, maxCount := 0, 0
+	for _, count := range counts {
+		if minCount == 0 || count < minCount {
+			minCount = count
+		}
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	entries := make([]TagCloudEntry, 0, len(counts))
+	for tag, count := range counts {
+		weight := MinTagWeight
+		if maxCount > minCount {
+			weight += int(math.Round(float64(count-minCount) * float64(MaxTagWeight-MinTagWeight) / float64(maxCount-minCount)))
+		}
+		entries = append(entries, TagCloudEntry{Tag: tag, Count: count, Weight: weight})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Tag < entries[j].Tag
+	})
+
+	return entries
+}
+
+// TopTags counts tags across comma-separated tag strings and returns the n
+// most used, ties broken alphabetically
+func TopTags(tagLists []string, n int) []TagCount {
+	counts := make(map[string]int)
+	for _, raw := range tagLists {
+		for _, tag := range ParseTags(raw) {
+			counts[tag]++
+		}
+	}
+
+	entries := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		entries = append(entries, TagCount{Tag: tag, Count: count})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Tag < entries[j].Tag
+	})
+
+	if n > 0 && len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tenTags := "one, two, three, four, five, six, seven, eight, nine, ten"
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		count   int
+		invalid []string
+	}{
+		{"trimmed and lowercased", "  React ,  Web   Design ,CSS", "react, web design, css", 0, nil},
+		{"duplicates collapsed", "react, React, REACT ,  react", "react", 0, nil},
+		{"duplicates keep first order", "css, html, Css, HTML, js", "css, html, js", 0, nil},
+		{"blank items dropped", "react,, ,css,", "react, css", 0, nil},
+		{"at the tag limit", tenTags, tenTags, 0, nil},
+		{"duplicates under the limit", tenTags + ", One, TEN", tenTags, 0, nil},
+		{"too many tags", tenTags + ", eleven", "", 11, nil},
+		{"at the length limits", "ab, " + strings.Repeat("x", MaxTagLength), "ab, " + strings.Repeat("x", MaxTagLength), 0, nil},
+		{"too long tag", "react, " + strings.Repeat("x", MaxTagLength+1), "", 0, []string{strings.Repeat("x", MaxTagLength+1)}},
+		{"too short tags", "a, react, b", "", 0, []string{"a", "b"}},
+		{"multibyte length counted in characters", strings.Repeat("é", MaxTagLength), strings.Repeat("é", MaxTagLength), 0, nil},
+		{"empty", "", "", 0, nil},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeTags(tt.raw)
+		if tt.count == 0 && tt.invalid == nil {
+			if err != nil || got != tt.want {
+				t.Errorf("%s: NormalizeTags = %q, %v; want %q", tt.name, got, err, tt.want)
+			}
+			continue
+		}
+		tagErr, ok := err.(*TagValidationError)
+		if !ok {
+			t.Errorf("%s: error = %v, want a TagValidationError", tt.name, err)
+			continue
+		}
+		if tagErr.Count != tt.count || !reflect.DeepEqual(tagErr.Invalid, tt.invalid) {
+			t.Errorf("%s: error = %+v, want count %d invalid %q", tt.name, tagErr, tt.count, tt.invalid)
+		}
+	}
+}
+
+func TestBuildTagCloudEqualCounts(t *testing.T) {
+	cloud := BuildTagCloud([]string{"css, react", "React, CSS", ""})
+	want := []TagCloudEntry{{Tag: "css", Count: 2, Weight: MinTagWeight}, {Tag: "react", Count: 2, Weight: MinTagWeight}}
+	if !reflect.DeepEqual(cloud, want) {
+		t.Errorf("cloud = %+v, want %+v", cloud, want)
+	}
+	if cloud := BuildTagCloud(nil); len(cloud) != 0 {
+		t.Errorf("empty cloud = %+v", cloud)
+	}
+}
+
+func TestNormalizeTagsSynonyms(t *testing.T) {
+	t.Cleanup(func() { SetTagSynonyms(DefaultTagSynonyms()) })
+
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"A11Y, react", "accessibility, react"},
+		{"ux,  User   Experience", "user experience"},
+		{"accessibility, a11y", "accessibility"},
+		{"css, a11y, css", "css, accessibility"},
+	}
+	for _, tt := range tests {
+		if got, err := NormalizeTags(tt.raw); err != nil || got != tt.want {
+			t.Errorf("NormalizeTags(%q) = %q, %v; want %q", tt.raw, got, err, tt.want)
+		}
+	}
+
+	if err := SetTagSynonyms(map[string][]string{"JavaScript": {"js", "ECMAScript"}}); err != nil {
+		t.Fatalf("SetTagSynonyms: %v", err)
+	}
+	if got, _ := NormalizeTags("ecmascript, JS, a11y"); got != "javascript, a11y" {
+		t.Errorf("configured synonyms: NormalizeTags = %q, want %q", got, "javascript, a11y")
+	}
+	if want := map[string]string{"js": "javascript", "ecmascript": "javascript"}; !reflect.DeepEqual(TagSynonyms(), want) {
+		t.Errorf("TagSynonyms() = %v, want %v", TagSynonyms(), want)
+	}
+}
+
+func TestValidateTagSynonyms(t *testing.T) {
+	tests := []struct {
+		name     string
+		synonyms map[string][]string
+		valid    bool
+	}{
+		{"defaults", DefaultTagSynonyms(), true},
+		{"empty", map[string][]string{}, true},
+		{"canonical list", map[string][]string{"go, rust": {"golang"}}, false},
+		{"too short synonym", map[string][]string{"go": {"g"}}, false},
+		{"synonym is canonical", map[string][]string{"go": {"golang"}, "golang": {"go lang"}}, false},
+		{"synonym listed twice", map[string][]string{"go": {"golang"}, "google go": {"GoLang"}}, false},
+	}
+	for _, tt := range tests {
+		if err := ValidateTagSynonyms(tt.synonyms); (err == nil) != tt.valid {
+			t.Errorf("%s: ValidateTagSynonyms error = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
+
+func TestLoadTagSynonyms(t *testing.T) {
+	if synonyms, err := LoadTagSynonyms(""); err != nil || !reflect.DeepEqual(synonyms, DefaultTagSynonyms()) {
+		t.Errorf("LoadTagSynonyms(\"\") = %v, %v; want the defaults", synonyms, err)
+	}
+
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		return path
+	}
+
+	synonyms, err := LoadTagSynonyms(write("valid.json", `{"kubernetes": ["k8s"]}`))
+	if err != nil || !reflect.DeepEqual(synonyms, map[string][]string{"kubernetes": {"k8s"}}) {
+		t.Errorf("valid file = %v, %v", synonyms, err)
+	}
+	for name, data := range map[string]string{"syntax.json": `{"kubernetes": `, "invalid.json": `{"kubernetes": ["k"]}`} {
+		if _, err := LoadTagSynonyms(write(name, data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := LoadTagSynonyms(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("missing file: expected an error")
+	}
+}
@@ -1,53 +1,299 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"math"
+	"net/url"
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// transliterations maps letters that NFKD decomposition does not reduce to ASCII
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g",
+}
+
+// asciiFold strips diacritics via NFKD normalization and transliterates known
+// letters, so "Café" becomes "cafe" and "Привет" becomes "privet"
+func asciiFold(text string) string {
+	decomposed, _, err := transform.String(transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn))), text)
+	if err != nil {
+		decomposed = text
+	}
+
+	var result strings.Builder
+	for _, r := range decomposed {
+		if replacement, ok := transliterations[r]; ok {
+			result.WriteString(replacement)
+			continue
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
+
+// Slug length bounds. The maximum must fit the 255-character slug column; the
+// minimum leaves room for the hashed fallback slug and a uniqueness suffix.
+const (
+	DefaultSlugMaxLength = 100
+	MinSlugMaxLength     = 20
+	SlugColumnLength     = 255
 )
 
-// GenerateSlug creates a URL-friendly slug from a title
+// slugMaxLength is the configured slug length cap
+var slugMaxLength = DefaultSlugMaxLength
+
+// SetSlugMaxLength configures the length GenerateSlug truncates slugs to
+func SetSlugMaxLength(length int) error {
+	if length < MinSlugMaxLength || length > SlugColumnLength {
+		return fmt.Errorf("slug max length must be between %d and %d, got %d", MinSlugMaxLength, SlugColumnLength, length)
+	}
+	slugMaxLength = length
+	return nil
+}
+
+// SlugMaxLength returns the configured slug length cap
+func SlugMaxLength() int {
+	return slugMaxLength
+}
+
+// TruncateSlug shortens a slug to at most length characters without leaving
+// a trailing hyphen
+func TruncateSlug(slug string, length int) string {
+	if len(slug) <= length {
+		return slug
+	}
+	return strings.Trim(slug[:length], "-")
+}
+
+// GenerateSlug creates a URL-friendly slug from a title, at most SlugMaxLength characters long
 func GenerateSlug(title string) string {
-	// Convert to lowercase
-	slug := strings.ToLower(title)
-	
+	// Convert to lowercase and fold Unicode to ASCII
+	slug := asciiFold(strings.ToLower(title))
+
 	// Replace spaces and special characters with hyphens
 	reg := regexp.MustCompile(`[^a-z0-9]+`)
 	slug = reg.ReplaceAllString(slug, "-")
-	
+
 	// Remove leading and trailing hyphens
 	slug = strings.Trim(slug, "-")
-	
-	// Limit length to 100 characters
-	if len(slug) > 100 {
-		slug = slug[:100]
-		slug = strings.Trim(slug, "-")
+
+	// Limit length to the configured maximum
+	slug = TruncateSlug(slug, slugMaxLength)
+
+	// Fall back to a stable hashed slug for titles with no transliterable characters
+	if slug == "" && strings.TrimSpace(title) != "" {
+		sum := sha256.Sum256([]byte(strings.TrimSpace(title)))
+		slug = "post-" + hex.EncodeToString(sum[:])[:12]
 	}
-	
+
 	return slug
 }
 
-// CalculateReadingTime estimates reading time based on content length
-// Average reading speed: 200 words per minute
+// CanonicalSlug normalizes a requested slug to its stored form: trimmed,
+// lowercase and without trailing slashes
+func CanonicalSlug(slug string) string {
+	return strings.ToLower(strings.TrimRight(strings.TrimSpace(slug), "/"))
+}
+
+// DefaultReadingWPM is the reading speed used when READING_WPM is not set
+const DefaultReadingWPM = 200
+
+// readingWPM is the configured reading speed in words per minute
+var readingWPM = DefaultReadingWPM
+
+// SetReadingWPM configures the reading speed used by CalculateReadingTime
+func SetReadingWPM(wpm int) error {
+	if wpm < 1 {
+		return fmt.Errorf("reading speed must be a positive number of words per minute, got %d", wpm)
+	}
+	readingWPM = wpm
+	return nil
+}
+
+// ReadingWPM returns the configured reading speed in words per minute
+func ReadingWPM() int {
+	return readingWPM
+}
+
+// CalculateReadingTime estimates reading time based on content length at the
+// configured reading speed
 func CalculateReadingTime(content string) int {
+	return CalculateReadingTimeAt(content, readingWPM)
+}
+
+// CalculateReadingTimeAt estimates reading time at wpm words per minute
+// Average reading speed: 200 words per minute
+func CalculateReadingTimeAt(content string, wpm int) int {
 	if content == "" {
 		return 0
 	}
-	
+
 	// Count words (simple word count by splitting on whitespace)
 	words := strings.Fields(stripHTMLTags(content))
 	wordCount := len(words)
-	
+
 	// Calculate reading time (minimum 1 minute)
-	readingTime := int(math.Ceil(float64(wordCount) / 200.0))
+	if wpm < 1 {
+		wpm = DefaultReadingWPM
+	}
+	readingTime := int(math.Ceil(float64(wordCount) / float64(wpm)))
 	if readingTime < 1 {
 		readingTime = 1
 	}
-	
+
 	return readingTime
 }
 
+// DefaultSpeakingWPM is the text-to-speech rate used when SPEAKING_WPM is not set
+const DefaultSpeakingWPM = 150
+
+// speakingWPM is the configured text-to-speech rate in words per minute
+var speakingWPM = DefaultSpeakingWPM
+
+// SetSpeakingWPM configures the speaking rate used by CalculateAudioLength
+func SetSpeakingWPM(wpm int) error {
+	if wpm < 1 {
+		return fmt.Errorf("speaking rate must be a positive number of words per minute, got %d", wpm)
+	}
+	speakingWPM = wpm
+	return nil
+}
+
+// SpeakingWPM returns the configured speaking rate in words per minute
+func SpeakingWPM() int {
+	return speakingWPM
+}
+
+// CalculateAudioLength estimates how many seconds a screen reader or
+// text-to-speech engine takes to read content aloud at the configured rate
+func CalculateAudioLength(content string) int {
+	return CalculateAudioLengthAt(content, speakingWPM)
+}
+
+// CalculateAudioLengthAt estimates listening time in seconds at wpm words per minute
+func CalculateAudioLengthAt(content string, wpm int) int {
+	if wpm < 1 {
+		wpm = DefaultSpeakingWPM
+	}
+	return int(math.Ceil(float64(CountWords(content)) * 60 / float64(wpm)))
+}
+
+var (
+	headingTagRegex  = regexp.MustCompile(`(?i)<h[1-6][\s>]`)
+	imageTagRegex    = regexp.MustCompile(`(?i)<img[\s/>]`)
+	linkTagRegex     = regexp.MustCompile(`(?i)<a\s[^>]*href\s*=`)
+	sentenceEndRegex = regexp.MustCompile(`[.!?]+`)
+	blockEndRegex    = regexp.MustCompile(`(?i)</(p|li|h[1-6]|div|pre|blockquote|td|th|dd|dt)>|<br\s*/?>`)
+	vowelGroupRegex  = regexp.MustCompile(`[aeiouy]+`)
+	nonLetterRegex   = regexp.MustCompile(`[^a-z]+`)
+)
+
+// CountWords counts the words in content once HTML tags are removed
+func CountWords(content string) int {
+	return len(strings.Fields(stripHTMLTags(content)))
+}
+
+// CountHeadings counts the h1-h6 elements in content
+func CountHeadings(content string) int {
+	return len(headingTagRegex.FindAllString(content, -1))
+}
+
+// CountImages counts the img elements in content
+func CountImages(content string) int {
+	return len(imageTagRegex.FindAllString(content, -1))
+}
+
+// CountLinks counts the anchor elements with an href in content
+func CountLinks(content string) int {
+	return len(linkTagRegex.FindAllString(content, -1))
+}
+
+// countSentences counts sentences in plain text, treating runs of terminal
+// punctuation as a single boundary (minimum 1 for non-empty text)
+func countSentences(text string) int {
+	count := 0
+	for _, sentence := range sentenceEndRegex.Split(text, -1) {
+		if strings.IndexFunc(sentence, unicode.IsLetter) >= 0 {
+			count++
+		}
+	}
+	if count == 0 && strings.TrimSpace(text) != "" {
+		count = 1
+	}
+	return count
+}
+
+// countSyllables estimates the syllables in an English word by counting vowel
+// groups and discounting a trailing silent "e"
+func countSyllables(word string) int {
+	word = nonLetterRegex.ReplaceAllString(strings.ToLower(word), "")
+	if word == "" {
+		return 0
+	}
+
+	count := len(vowelGroupRegex.FindAllString(word, -1))
+	if strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "le") && count > 1 {
+		count--
+	}
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+// FleschKincaidGrade estimates the US school grade level needed to read content
+func FleschKincaidGrade(content string) float64 {
+	// Block elements such as headings and list items end a sentence even without punctuation
+	text := stripHTMLTags(blockEndRegex.ReplaceAllString(content, ". "))
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return 0
+	}
+
+	syllables := 0
+	counted := 0
+	for _, word := range words {
+		if n := countSyllables(word); n > 0 {
+			syllables += n
+			counted++
+		}
+	}
+	if counted == 0 {
+		return 0
+	}
+
+	sentences := countSentences(text)
+	grade := 0.39*(float64(counted)/float64(sentences)) + 11.8*(float64(syllables)/float64(counted)) - 15.59
+	return math.Round(grade*10) / 10
+}
+
+// CalculateContentStats computes word, structure, and readability statistics for content
+func CalculateContentStats(content string) ContentStats {
+	return ContentStats{
+		WordCount:    CountWords(content),
+		ReadingTime:  CalculateReadingTime(content),
+		HeadingCount: CountHeadings(content),
+		ImageCount:   CountImages(content),
+		LinkCount:    CountLinks(content),
+		GradeLevel:   FleschKincaidGrade(content),
+	}
+}
+
 // stripHTMLTags removes HTML tags from content for word counting
 func stripHTMLTags(content string) string {
 	// Simple HTML tag removal regex
@@ -55,6 +301,68 @@ func stripHTMLTags(content string) string {
 	return reg.ReplaceAllString(content, " ")
 }
 
+// SEO field limits matching the meta_title and meta_desc column sizes
+const (
+	MetaTitleMaxLength = 60
+	MetaDescMaxLength  = 160
+)
+
+// Shortest SEO fields that still make a useful search snippet; shorter ones
+// are saved with a warning
+const (
+	MetaTitleMinLength = 30
+	MetaDescMinLength  = 70
+)
+
+// Excerpt limits; ExcerptMaxLength matches the excerpt column size in characters
+const (
+	ExcerptMaxLength     = 500
+	DefaultExcerptLength = 300
+)
+
+// ExcerptBoundary selects where a generated excerpt that is too long is cut
+type ExcerptBoundary string
+
+// Excerpt boundaries
+const (
+	ExcerptBoundaryWord     ExcerptBoundary = "word"     // Last word that fits, followed by an ellipsis
+	ExcerptBoundarySentence ExcerptBoundary = "sentence" // Last sentence that fits, falling back to the word boundary
+)
+
+// ParseExcerptBoundary validates an excerpt boundary name
+func ParseExcerptBoundary(name string) (ExcerptBoundary, bool) {
+	switch boundary := ExcerptBoundary(strings.ToLower(strings.TrimSpace(name))); boundary {
+	case ExcerptBoundaryWord, ExcerptBoundarySentence:
+		return boundary, true
+	}
+	return "", false
+}
+
+// FeaturedImageAltMaxLength caps featured image alt text, matching the column size
+const FeaturedImageAltMaxLength = 125
+
+// MinVisibleContentLength is the minimum number of visible characters a post must contain
+const MinVisibleContentLength = 50
+
+// VisibleText returns content with HTML tags removed and whitespace collapsed
+func VisibleText(content string) string {
+	return strings.Join(strings.Fields(stripHTMLTags(content)), " ")
+}
+
+// VisibleTextLength counts the characters of content once HTML tags are removed
+// and whitespace is collapsed
+func VisibleTextLength(content string) int {
+	return utf8.RuneCountInString(VisibleText(content))
+}
+
+// ContentHash returns a hash of the visible text of content, ignoring markup,
+// case, and whitespace differences
+func ContentHash(content string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(stripHTMLTags(content))), " ")
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
 // SanitizeString removes or replaces potentially harmful characters
 func SanitizeString(input string) string {
 	// Remove control characters except newlines and tabs
@@ -68,33 +376,316 @@ func SanitizeString(input string) string {
 	return strings.TrimSpace(result.String())
 }
 
-// truncateText truncates text to specified length with ellipsis
+// truncateText truncates text to at most maxLength characters, ellipsis
+// included, counting runes so multibyte text is never split mid-character
 func truncateText(text string, maxLength int) string {
-	if len(text) <= maxLength {
+	const ellipsis = "..."
+
+	if utf8.RuneCountInString(text) <= maxLength {
 		return text
 	}
-	
-	// Find the last space before maxLength to avoid cutting words
-	truncated := text[:maxLength]
-	lastSpace := strings.LastIndex(truncated, " ")
-	if lastSpace > 0 {
-		truncated = text[:lastSpace]
+	if maxLength <= len(ellipsis) {
+		return string([]rune(text)[:maxLength])
+	}
+
+	return TruncateAtWord(text, maxLength-len(ellipsis)) + ellipsis
+}
+
+// TruncateAtWord shortens text to at most maxLength characters without cutting a
+// word in half. Unlike truncateText it adds no ellipsis, so the result always
+// fits a column of maxLength.
+func TruncateAtWord(text string, maxLength int) string {
+	text = strings.TrimSpace(text)
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+
+	truncated := string(runes[:maxLength])
+	// Keep the cut only if it falls between words
+	if runes[maxLength] != ' ' {
+		if lastSpace := strings.LastIndex(truncated, " "); lastSpace > 0 {
+			truncated = truncated[:lastSpace]
+		}
 	}
-	
-	return truncated + "..."
+
+	return strings.TrimRight(truncated, " ,;:-")
+}
+
+// GenerateMetaTitle creates an SEO meta title from the post title
+func GenerateMetaTitle(title string) string {
+	return TruncateAtWord(title, MetaTitleMaxLength)
+}
+
+// GenerateMetaDesc creates an SEO meta description from the excerpt
+func GenerateMetaDesc(excerpt string) string {
+	return TruncateAtWord(excerpt, MetaDescMaxLength)
+}
+
+// excerptLength is the configured length of excerpts generated when posts are saved
+var excerptLength = DefaultExcerptLength
+
+// SetExcerptLength configures the length rich excerpts are generated at when
+// posts are saved, clamped like ClampExcerptLength
+func SetExcerptLength(length int) {
+	excerptLength = ClampExcerptLength(length)
+}
+
+// ExcerptLength returns the configured excerpt length
+func ExcerptLength() int {
+	return excerptLength
 }
 
-// GenerateExcerpt creates an excerpt from content if not provided
-func GenerateExcerpt(content string, maxLength int) string {
-	if maxLength == 0 {
-		maxLength = 300
+// ClampExcerptLength bounds a generated excerpt length to the excerpt column,
+// using DefaultExcerptLength for non-positive values
+func ClampExcerptLength(length int) int {
+	if length <= 0 {
+		return DefaultExcerptLength
 	}
-	
-	// Strip HTML tags and clean up
-	cleaned := stripHTMLTags(content)
+	if length > ExcerptMaxLength {
+		return ExcerptMaxLength
+	}
+	return length
+}
+
+// GenerateExcerpt creates an excerpt from content if not provided. The excerpt
+// is plain text: entities such as &amp; and &#39; are decoded, and outputs that
+// embed it in markup, like the RSS feed, escape it themselves. Long content is
+// cut at the given boundary.
+func GenerateExcerpt(content string, maxLength int, boundary ExcerptBoundary) string {
+	maxLength = ClampExcerptLength(maxLength)
+
+	// Strip HTML tags before decoding so escaped markup stays text, then clean up
+	cleaned := html.UnescapeString(stripHTMLTags(content))
+	cleaned = strings.ReplaceAll(cleaned, "\u00a0", " ")
 	cleaned = strings.ReplaceAll(cleaned, "\n", " ")
 	cleaned = regexp.MustCompile(`\s+`).ReplaceAllString(cleaned, " ")
 	cleaned = strings.TrimSpace(cleaned)
-	
+
+	if boundary == ExcerptBoundarySentence {
+		if sentences, ok := truncateAtSentence(cleaned, maxLength); ok {
+			return sentences
+		}
+	}
 	return truncateText(cleaned, maxLength)
 }
+
+// truncateAtSentence shortens text to the whole sentences that fit in
+// maxLength characters. It fails when the last sentence end falls in the first
+// half of the limit, since such a preview would be too short to be useful.
+func truncateAtSentence(text string, maxLength int) (string, bool) {
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text, true
+	}
+
+	// A sentence ends at . ! or ? followed by a space, after any closing quotes or brackets
+	for end := maxLength; end > maxLength/2; end-- {
+		if runes[end] != ' ' {
+			continue
+		}
+		last := end - 1
+		for last > 0 && strings.ContainsRune(`"')]`+"\u201d\u2019", runes[last]) {
+			last--
+		}
+		if strings.ContainsRune(".!?", runes[last]) {
+			return string(runes[:end]), true
+		}
+	}
+	return "", false
+}
+
+// richExcerptTags maps the inline tags kept in a rich excerpt to the tag written
+var richExcerptTags = map[string]string{"strong": "strong", "b": "strong", "em": "em", "i": "em", "a": "a"}
+
+// paragraphTags end the first paragraph of a rich excerpt
+var paragraphTags = map[string]bool{
+	"p": true, "div": true, "blockquote": true, "pre": true, "ul": true, "ol": true, "li": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"table": true, "figure": true, "hr": true,
+}
+
+// headingTags are skipped when they come before the first paragraph
+var headingTags = map[string]bool{"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true}
+
+// GenerateRichExcerpt creates an excerpt that keeps strong, em and link markup.
+// It stops at the end of the first paragraph or after maxLength visible
+// characters, ellipsis included, and always closes the tags it opens.
+func GenerateRichExcerpt(content string, maxLength int) string {
+	const ellipsis = "..."
+	maxLength = ClampExcerptLength(maxLength)
+
+	excerpt, truncated := richExcerpt(content, maxLength, "")
+	if truncated && maxLength > len(ellipsis) {
+		// Cut again leaving room for the ellipsis
+		excerpt, _ = richExcerpt(content, maxLength-len(ellipsis), ellipsis)
+	}
+	return excerpt
+}
+
+// richExcerpt writes the first paragraph of content with only the rich excerpt
+// tags, cutting it after limit visible characters and appending suffix when cut
+func richExcerpt(content string, limit int, suffix string) (string, bool) {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	var out []byte
+	var open []string
+	length := 0
+	skipDepth := 0
+	truncated := false
+
+	// A space between words is held back until more text follows, at the
+	// position it had relative to any tags written in between
+	pendingSpace := false
+	spaceAt := -1
+	holdSpace := func() {
+		if length > 0 && spaceAt < 0 {
+			pendingSpace = true
+			spaceAt = len(out)
+		}
+	}
+	writeSpace := func() {
+		if pendingSpace {
+			out = append(out[:spaceAt], append([]byte(" "), out[spaceAt:]...)...)
+			length++
+		}
+		pendingSpace = false
+		spaceAt = -1
+	}
+
+tokens:
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			break tokens
+
+		case html.TextToken:
+			if skipDepth > 0 {
+				continue
+			}
+			raw := string(tokenizer.Text())
+			if strings.TrimLeftFunc(raw, unicode.IsSpace) != raw {
+				holdSpace()
+			}
+			words := strings.Fields(raw)
+			if len(words) == 0 {
+				continue
+			}
+			text := strings.Join(words, " ")
+			spaced := 0
+			if pendingSpace {
+				spaced = 1
+			}
+
+			if length+spaced+utf8.RuneCountInString(text) > limit {
+				cut := ""
+				if budget := limit - length - spaced; budget > 0 {
+					cut = TruncateAtWord(text, budget)
+				}
+				// Only cut mid-word when nothing else would be shown
+				if next := len(cut); length > 0 && next < len(text) && !strings.ContainsRune(" ,;:-", rune(text[next])) {
+					cut = ""
+				}
+				if cut != "" {
+					writeSpace()
+				}
+				out = append(out, textEscaper.Replace(cut)+suffix...)
+				truncated = true
+				break tokens
+			}
+			writeSpace()
+			out = append(out, textEscaper.Replace(text)...)
+			length += utf8.RuneCountInString(text)
+			if strings.TrimRightFunc(raw, unicode.IsSpace) != raw {
+				holdSpace()
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if dropContentTags[token.Data] || (headingTags[token.Data] && length == 0) {
+				if tokenType == html.StartTagToken {
+					skipDepth++
+				}
+				continue
+			}
+			if skipDepth > 0 {
+				continue
+			}
+			if paragraphTags[token.Data] {
+				if length > 0 {
+					break tokens
+				}
+				continue
+			}
+			if token.Data == "br" {
+				holdSpace()
+				continue
+			}
+			name, ok := richExcerptTags[token.Data]
+			if !ok || tokenType == html.SelfClosingTagToken {
+				continue
+			}
+			tag := "<" + name + ">"
+			if name == "a" {
+				href := ""
+				for _, attr := range token.Attr {
+					if attr.Key == "href" && attr.Namespace == "" && sanitizer.allowsURL(attr.Val) {
+						href = attr.Val
+					}
+				}
+				if href == "" {
+					continue
+				}
+				tag = `<a href="` + attributeEscaper.Replace(href) + `">`
+			}
+			out = append(out, tag...)
+			open = append(open, name)
+
+		case html.EndTagToken:
+			token := tokenizer.Token()
+			if dropContentTags[token.Data] || (headingTags[token.Data] && length == 0) {
+				if skipDepth > 0 {
+					skipDepth--
+				}
+				continue
+			}
+			if skipDepth > 0 {
+				continue
+			}
+			if paragraphTags[token.Data] {
+				if length > 0 {
+					break tokens
+				}
+				continue
+			}
+			name, ok := richExcerptTags[token.Data]
+			if !ok {
+				continue
+			}
+			// Close back to the matching tag so the markup stays well nested
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == name {
+					for j := len(open) - 1; j >= i; j-- {
+						out = append(out, "</"+open[j]+">"...)
+					}
+					open = open[:i]
+					break
+				}
+			}
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		out = append(out, "</"+open[i]+">"...)
+	}
+	return string(out), truncated
+}
+
+// IsValidImageURL reports whether raw is an absolute http or https URL with a host
+func IsValidImageURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.User == nil
+}
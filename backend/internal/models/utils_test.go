@@ -0,0 +1,465 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// cutsMidWord reports whether truncated ends partway through a word of text
+func cutsMidWord(text, truncated string) bool {
+	rest := strings.TrimPrefix(text, truncated)
+	return rest != "" && rest[0] != ' ' && rest[0] != ',' && truncated != ""
+}
+
+func TestGenerateMetaFields(t *testing.T) {
+	words := strings.Repeat("accessibility matters ", 20)
+	tests := []struct {
+		name     string
+		generate func(string) string
+		max      int
+		input    string
+	}{
+		{"short title", GenerateMetaTitle, MetaTitleMaxLength, "A short title"},
+		{"long title", GenerateMetaTitle, MetaTitleMaxLength, words},
+		{"title cut at a space", GenerateMetaTitle, MetaTitleMaxLength, strings.Repeat("a", MetaTitleMaxLength) + " tail"},
+		{"title one long word", GenerateMetaTitle, MetaTitleMaxLength, strings.Repeat("x", 100)},
+		{"multibyte title", GenerateMetaTitle, MetaTitleMaxLength, strings.Repeat("café ünïcødé ", 10)},
+		{"short description", GenerateMetaDesc, MetaDescMaxLength, "A short excerpt."},
+		{"long description", GenerateMetaDesc, MetaDescMaxLength, words + words},
+		{"multibyte description", GenerateMetaDesc, MetaDescMaxLength, strings.Repeat("日本語の テキスト ", 30)},
+	}
+	for _, tt := range tests {
+		got := tt.generate(tt.input)
+		if length := utf8.RuneCountInString(got); length > tt.max {
+			t.Errorf("%s: %d characters, want at most %d", tt.name, length, tt.max)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("%s: %q is not valid UTF-8", tt.name, got)
+		}
+		input := strings.TrimSpace(tt.input)
+		if !strings.HasPrefix(input, got) {
+			t.Errorf("%s: %q is not a prefix of the input", tt.name, got)
+		}
+		if utf8.RuneCountInString(input) <= tt.max && got != input {
+			t.Errorf("%s: %q was truncated though it fits", tt.name, got)
+		}
+		if strings.Contains(input, " ") && cutsMidWord(input, got) {
+			t.Errorf("%s: %q ends mid-word", tt.name, got)
+		}
+	}
+
+	// A single word longer than the limit is cut hard rather than dropped
+	if got := GenerateMetaTitle(strings.Repeat("x", 100)); got != strings.Repeat("x", MetaTitleMaxLength) {
+		t.Errorf("long word title = %q, want %d x characters", got, MetaTitleMaxLength)
+	}
+	if got := GenerateMetaTitle(strings.Repeat("a", MetaTitleMaxLength) + " tail"); got != strings.Repeat("a", MetaTitleMaxLength) {
+		t.Errorf("title cut at a space = %q, want the first word", got)
+	}
+}
+
+func TestCountSyllables(t *testing.T) {
+	tests := map[string]int{
+		"cat":           1,
+		"the":           1,
+		"make":          1,
+		"table":         2,
+		"helps":         1,
+		"reading":       2,
+		"everyone":      3,
+		"usability":     5,
+		"accessibility": 6,
+		"Accessible,":   4,
+		"42":            0,
+	}
+	for word, want := range tests {
+		if got := countSyllables(word); got != want {
+			t.Errorf("countSyllables(%q) = %d, want %d", word, got, want)
+		}
+	}
+}
+
+func TestFleschKincaidGrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    float64
+	}{
+		// 14 one-syllable words in 2 sentences: 0.39*7 + 11.8*1 - 15.59
+		{"simple sentences", "<p>The cat sat on the mat. The dog ran to the big red ball!</p>", -1.1},
+		// 5 words with 16 syllables in 1 sentence: 0.39*5 + 11.8*3.2 - 15.59
+		{"long words", "<p>Accessibility helps usability for everyone.</p>", 24.1},
+		// Headings end a sentence without punctuation, giving the same sentences as above
+		{"heading as sentence", "<h2>The cat sat on the mat</h2><p>The dog ran to the big red ball</p>", -1.1},
+		{"empty", "<p></p>", 0},
+	}
+	for _, tt := range tests {
+		if got := FleschKincaidGrade(tt.content); got != tt.want {
+			t.Errorf("%s: grade = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateContentStats(t *testing.T) {
+	content := `<h1>Title</h1><p>One two <a href="/a">three</a> four.</p>` +
+		`<h2 class="x">Section</h2><img src="a.png" alt="A"><img src="b.png"/>` +
+		`<p><a href="https://example.com">five</a> <a name="anchor">six</a></p>`
+	stats := CalculateContentStats(content)
+	want := ContentStats{WordCount: 8, ReadingTime: 1, HeadingCount: 2, ImageCount: 2, LinkCount: 2, GradeLevel: stats.GradeLevel}
+	if stats != want {
+		t.Errorf("stats = %+v, want %+v", stats, want)
+	}
+}
+
+func TestGenerateSlugUnicode(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Café Accessibility ☕", "cafe-accessibility"},
+		{"Crème brûlée für Ærøskøbing", "creme-brulee-fur-aeroskobing"},
+		{"Straße ½ Ñandú", "strasse-1-2-nandu"},
+		{"Привет, мир", "privet-mir"},
+		{"Доступность для всех", "dostupnost-dlya-vsekh"},
+		{"React ⚛️ and Vue 🚀 tips", "react-and-vue-tips"},
+		{"Ｆｕｌｌｗｉｄｔｈ ＡＢＣ", "fullwidth-abc"},
+	}
+	for _, tt := range tests {
+		if got := GenerateSlug(tt.title); got != tt.want {
+			t.Errorf("GenerateSlug(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+
+	// Titles with nothing to transliterate get a stable hashed slug
+	for _, title := range []string{"アクセシビリティ", "无障碍设计", "☕🚀"} {
+		slug := GenerateSlug(title)
+		if !regexp.MustCompile(`^post-[0-9a-f]{12}$`).MatchString(slug) {
+			t.Errorf("GenerateSlug(%q) = %q, want a post-<hash> slug", title, slug)
+		}
+		if again := GenerateSlug(title); again != slug {
+			t.Errorf("GenerateSlug(%q) is not stable: %q then %q", title, slug, again)
+		}
+	}
+	if GenerateSlug("アクセシビリティ") == GenerateSlug("无障碍设计") {
+		t.Error("different CJK titles share a hashed slug")
+	}
+	if got := GenerateSlug("   "); got != "" {
+		t.Errorf("GenerateSlug of a blank title = %q, want empty", got)
+	}
+}
+
+func TestGenerateExcerptMultibyte(t *testing.T) {
+	tests := map[string]string{
+		"accented words": strings.Repeat("café crème ", 60),
+		"unbroken CJK":   strings.Repeat("日本語のテキスト", 70),
+		"emoji":          strings.Repeat("🎉 ", 300),
+		"mixed":          strings.Repeat("naïve 😀 文字 ", 80),
+	}
+	for name, text := range tests {
+		for _, limit := range []int{DefaultExcerptLength, ExcerptMaxLength} {
+			excerpt := GenerateExcerpt("<p>"+text+"</p>", limit, ExcerptBoundaryWord)
+			if !utf8.ValidString(excerpt) {
+				t.Errorf("%s at %d: excerpt is not valid UTF-8: %q", name, limit, excerpt)
+			}
+			if n := utf8.RuneCountInString(excerpt); n > limit || n < limit-20 {
+				t.Errorf("%s at %d: excerpt has %d characters, want just under %d", name, limit, n, limit)
+			}
+			if !strings.HasSuffix(excerpt, "...") {
+				t.Errorf("%s at %d: truncated excerpt has no ellipsis: %q", name, limit, excerpt)
+			}
+		}
+	}
+
+	// Text that fits is returned whole, however many bytes it takes
+	fits := strings.Repeat("é", ExcerptMaxLength)
+	if got := GenerateExcerpt(fits, ExcerptMaxLength, ExcerptBoundaryWord); got != fits {
+		t.Errorf("%d accented characters were truncated to %d", ExcerptMaxLength, utf8.RuneCountInString(got))
+	}
+}
+
+func TestGenerateExcerptDecodesEntities(t *testing.T) {
+	tests := map[string]string{
+		"<p>Tips &amp; tricks for screen readers</p>":             "Tips & tricks for screen readers",
+		"<p>Don&#39;t rely on colour alone</p>":                   "Don't rely on colour alone",
+		"<p>Caf&#233; and &#x1F600; emoji&nbsp;text</p>":          "Café and 😀 emoji text",
+		"<p>Write &lt;script&gt; as text, &quot;quoted&quot;</p>": `Write <script> as text, "quoted"`,
+		"<p>Unknown &bogus; entity</p>":                           "Unknown &bogus; entity",
+	}
+	for content, want := range tests {
+		if got := GenerateExcerpt(content, DefaultExcerptLength, ExcerptBoundaryWord); got != want {
+			t.Errorf("GenerateExcerpt(%q) = %q, want %q", content, got, want)
+		}
+	}
+
+	// Entities count as the single character they stand for when truncating
+	content := "<p>" + strings.Repeat("a &amp; b ", 40) + "</p>"
+	excerpt := GenerateExcerpt(content, 120, ExcerptBoundaryWord)
+	if strings.Contains(excerpt, "&amp") || utf8.RuneCountInString(excerpt) < 100 {
+		t.Errorf("excerpt = %q, want decoded text just under 120 characters", excerpt)
+	}
+}
+
+func TestGenerateExcerptBoundaries(t *testing.T) {
+	tests := []struct {
+		content, word, sentence string
+	}{
+		{
+			"<p>Captions help deaf readers and everyone else.</p><p>They also help in noisy places like trains.</p>",
+			"Captions help deaf readers and everyone else. They also...",
+			"Captions help deaf readers and everyone else.",
+		},
+		// Closing quotes stay with the sentence they end
+		{
+			`<p>Ask first! The reviewer said "Ship it." Then the release went out to every reader at once.</p>`,
+			`Ask first! The reviewer said "Ship it." Then the release...`,
+			`Ask first! The reviewer said "Ship it."`,
+		},
+		// A sentence end in the first half of the limit falls back to the word boundary
+		{
+			"<p>Short one. Then a very long sentence that keeps going well past the limit without stopping.</p>",
+			"Short one. Then a very long sentence that keeps going...",
+			"Short one. Then a very long sentence that keeps going...",
+		},
+		{
+			"<p>Captions help. Everyone reads them.</p>",
+			"Captions help. Everyone reads them.",
+			"Captions help. Everyone reads them.",
+		},
+	}
+	for _, tt := range tests {
+		if got := GenerateExcerpt(tt.content, 60, ExcerptBoundaryWord); got != tt.word {
+			t.Errorf("word excerpt of %q = %q, want %q", tt.content, got, tt.word)
+		}
+		if got := GenerateExcerpt(tt.content, 60, ExcerptBoundarySentence); got != tt.sentence {
+			t.Errorf("sentence excerpt of %q = %q, want %q", tt.content, got, tt.sentence)
+		}
+	}
+}
+
+func TestParseExcerptBoundary(t *testing.T) {
+	for name, want := range map[string]ExcerptBoundary{"word": ExcerptBoundaryWord, " Sentence ": ExcerptBoundarySentence} {
+		if got, ok := ParseExcerptBoundary(name); !ok || got != want {
+			t.Errorf("ParseExcerptBoundary(%q) = %q, %v; want %q", name, got, ok, want)
+		}
+	}
+	for _, name := range []string{"", "paragraph"} {
+		if _, ok := ParseExcerptBoundary(name); ok {
+			t.Errorf("ParseExcerptBoundary(%q) succeeded, want failure", name)
+		}
+	}
+}
+
+func TestCalculateReadingTimeScalesWithSpeed(t *testing.T) {
+	t.Cleanup(func() { SetReadingWPM(DefaultReadingWPM) })
+	content := "<p>" + strings.Repeat("word ", 1000) + "</p>"
+
+	tests := []struct {
+		wpm  int
+		want int
+	}{
+		{DefaultReadingWPM, 5},
+		{100, 10},
+		{400, 3},
+		{250, 4},
+		{5000, 1},
+	}
+	for _, tt := range tests {
+		if err := SetReadingWPM(tt.wpm); err != nil {
+			t.Fatalf("SetReadingWPM(%d): %v", tt.wpm, err)
+		}
+		if got := CalculateReadingTime(content); got != tt.want {
+			t.Errorf("1000 words at %d wpm = %d minutes, want %d", tt.wpm, got, tt.want)
+		}
+	}
+
+	for _, wpm := range []int{0, -200} {
+		if err := SetReadingWPM(wpm); err == nil {
+			t.Errorf("SetReadingWPM(%d) accepted a non-positive speed", wpm)
+		}
+	}
+	if ReadingWPM() != 5000 {
+		t.Errorf("rejected speeds changed the reading speed to %d", ReadingWPM())
+	}
+}
+
+func TestCalculateAudioLength(t *testing.T) {
+	t.Cleanup(func() { SetSpeakingWPM(DefaultSpeakingWPM) })
+	words := func(n int) string { return "<p>" + strings.Repeat("word ", n) + "</p>" }
+
+	tests := []struct {
+		words int
+		wpm   int
+		want  int
+	}{
+		{150, DefaultSpeakingWPM, 60},
+		{300, DefaultSpeakingWPM, 120},
+		{1500, DefaultSpeakingWPM, 600},
+		{1, DefaultSpeakingWPM, 1},
+		{0, DefaultSpeakingWPM, 0},
+		{300, 100, 180},
+		{300, 200, 90},
+		{100, 7, 858},
+	}
+	for _, tt := range tests {
+		if err := SetSpeakingWPM(tt.wpm); err != nil {
+			t.Fatalf("SetSpeakingWPM(%d): %v", tt.wpm, err)
+		}
+		if got := CalculateAudioLength(words(tt.words)); got != tt.want {
+			t.Errorf("%d words at %d wpm = %d seconds, want %d", tt.words, tt.wpm, got, tt.want)
+		}
+		if got := CalculateAudioLengthAt(words(tt.words), tt.wpm); got != tt.want {
+			t.Errorf("CalculateAudioLengthAt(%d words, %d) = %d, want %d", tt.words, tt.wpm, got, tt.want)
+		}
+	}
+
+	// The speaking rate is independent of the reading speed
+	t.Cleanup(func() { SetReadingWPM(DefaultReadingWPM) })
+	SetSpeakingWPM(DefaultSpeakingWPM)
+	SetReadingWPM(1000)
+	if got := CalculateAudioLength(words(150)); got != 60 {
+		t.Errorf("audio length after changing the reading speed = %d, want 60", got)
+	}
+
+	for _, wpm := range []int{0, -150} {
+		if err := SetSpeakingWPM(wpm); err == nil {
+			t.Errorf("SetSpeakingWPM(%d) accepted a non-positive rate", wpm)
+		}
+	}
+	if SpeakingWPM() != DefaultSpeakingWPM {
+		t.Errorf("rejected rates changed the speaking rate to %d", SpeakingWPM())
+	}
+	if got := CalculateAudioLengthAt(words(150), 0); got != 60 {
+		t.Errorf("CalculateAudioLengthAt with no rate = %d, want the default rate's 60", got)
+	}
+}
+
+func TestClampExcerptLength(t *testing.T) {
+	for length, want := range map[int]int{
+		-5:                   DefaultExcerptLength,
+		0:                    DefaultExcerptLength,
+		1:                    1,
+		120:                  120,
+		ExcerptMaxLength:     ExcerptMaxLength,
+		ExcerptMaxLength + 1: ExcerptMaxLength,
+		10000:                ExcerptMaxLength,
+	} {
+		if got := ClampExcerptLength(length); got != want {
+			t.Errorf("ClampExcerptLength(%d) = %d, want %d", length, got, want)
+		}
+	}
+
+	// Lengths past the column are cut back to it, whatever was asked for
+	content := "<p>" + strings.Repeat("Inclusive design helps everyone. ", 40) + "</p>"
+	for _, length := range []int{120, DefaultExcerptLength, ExcerptMaxLength, 2000} {
+		excerpt := GenerateExcerpt(content, length, ExcerptBoundaryWord)
+		if n, limit := utf8.RuneCountInString(excerpt), ClampExcerptLength(length); n > limit || n < limit-40 {
+			t.Errorf("excerpt at length %d has %d characters, want just under %d", length, n, limit)
+		}
+	}
+}
+
+func TestGenerateRichExcerpt(t *testing.T) {
+	tests := []struct {
+		content string
+		length  int
+		want    string
+	}{
+		{`<p>Hello <strong>bold</strong> and <em>soft</em> world.</p><p>Second</p>`, 100, `Hello <strong>bold</strong> and <em>soft</em> world.`},
+		{`<h2>Title</h2><p>Read <a href="https://example.com/x?a=1&amp;b=2">the guide</a> now.</p>`, 100, `Read <a href="https://example.com/x?a=1&amp;b=2">the guide</a> now.`},
+		{`<p>Some <strong>very <em>nested important</em> words</strong> here</p>`, 20, `Some <strong>very <em>nested...</em></strong>`},
+		{`<p>Some <strong>very <em>nested important</em> words</strong> here</p>`, 12, `Some <strong>very<em>...</em></strong>`},
+		{`<p><a href="javascript:alert(1)">bad</a> <span style="x">plain</span> <b>b</b> <i>i</i> <script>evil()</script><img src=x.png></p>`, 100, `bad plain <strong>b</strong> <em>i</em>`},
+		{`<p>Fish &amp; chips &lt;tag&gt;</p>`, 100, `Fish &amp; chips &lt;tag&gt;`},
+		{`<p><strong>unclosed <em>tags everywhere`, 100, `<strong>unclosed <em>tags everywhere</em></strong>`},
+		{`<p>Supercalifragilistic</p>`, 8, `Super...`},
+		{`<p>a<br>b</p>`, 100, `a b`},
+		{`<p>x</strong></em> y</p>`, 100, `x y`},
+	}
+	for _, tt := range tests {
+		if got := GenerateRichExcerpt(tt.content, tt.length); got != tt.want {
+			t.Errorf("GenerateRichExcerpt(%q, %d) = %q, want %q", tt.content, tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateRichExcerptClosesTags(t *testing.T) {
+	content := `<p>Start <strong>bold <em>both <a href="/guide">linked <b>deep</b> text</a> still</em> bold</strong> ` +
+		`and <i>an <em>em</em> run</i> to the end of the first paragraph.</p><p>Never shown</p>`
+	tags := regexp.MustCompile(`<[^>]*>`)
+	visibleLength := func(excerpt string) int {
+		return utf8.RuneCountInString(html.UnescapeString(tags.ReplaceAllString(excerpt, "")))
+	}
+	full := GenerateRichExcerpt(content, ExcerptMaxLength)
+	for length := 1; length <= visibleLength(full)+1; length++ {
+		excerpt := GenerateRichExcerpt(content, length)
+
+		var open []string
+		tokenizer := html.NewTokenizer(strings.NewReader(excerpt))
+		for tokenType := tokenizer.Next(); tokenType != html.ErrorToken; tokenType = tokenizer.Next() {
+			name, _ := tokenizer.TagName()
+			switch tokenType {
+			case html.StartTagToken:
+				open = append(open, string(name))
+			case html.EndTagToken:
+				if len(open) == 0 || open[len(open)-1] != string(name) {
+					t.Fatalf("length %d: %q closes </%s> out of order (open %v)", length, excerpt, name, open)
+				}
+				open = open[:len(open)-1]
+			}
+		}
+		if len(open) > 0 {
+			t.Errorf("length %d: %q leaves %v open", length, excerpt, open)
+		}
+		if visible := visibleLength(excerpt); visible > length {
+			t.Errorf("length %d: %q shows %d characters", length, excerpt, visible)
+		}
+		if strings.Contains(excerpt, "Never shown") {
+			t.Errorf("length %d: %q runs past the first paragraph", length, excerpt)
+		}
+	}
+}
+
+func TestGenerateSlugMaxLength(t *testing.T) {
+	t.Cleanup(func() { SetSlugMaxLength(DefaultSlugMaxLength) })
+	// Every tenth slug character is a hyphen
+	long := strings.Repeat("Assistive ", 30)
+
+	if got := GenerateSlug(long); got != strings.Repeat("assistive-", 10)[:DefaultSlugMaxLength-1] {
+		t.Errorf("default cap: GenerateSlug = %q (%d characters), want %d characters without a trailing hyphen", got, len(got), DefaultSlugMaxLength-1)
+	}
+
+	tests := []struct {
+		length int
+		title  string
+		want   string
+	}{
+		{MinSlugMaxLength, "Accessibility rocks today", "accessibility-rocks"},
+		{MinSlugMaxLength, "Accessible designers unite", "accessible-designers"},
+		{MinSlugMaxLength, "Short title", "short-title"},
+		{SlugColumnLength - 5, long, strings.Repeat("assistive-", 25)[:SlugColumnLength-6]},
+		{SlugColumnLength, long, strings.Repeat("assistive-", 26)[:SlugColumnLength]},
+	}
+	for _, tt := range tests {
+		if err := SetSlugMaxLength(tt.length); err != nil {
+			t.Fatalf("SetSlugMaxLength(%d): %v", tt.length, err)
+		}
+		if got := GenerateSlug(tt.title); got != tt.want {
+			t.Errorf("max %d: GenerateSlug(%q) = %q, want %q", tt.length, tt.title, got, tt.want)
+		}
+	}
+	if SlugMaxLength() != SlugColumnLength {
+		t.Errorf("SlugMaxLength() = %d, want %d", SlugMaxLength(), SlugColumnLength)
+	}
+
+	for _, length := range []int{0, MinSlugMaxLength - 1, SlugColumnLength + 1} {
+		if err := SetSlugMaxLength(length); err == nil {
+			t.Errorf("SetSlugMaxLength(%d) accepted", length)
+		}
+	}
+	if SlugMaxLength() != SlugColumnLength {
+		t.Errorf("rejected lengths changed the cap to %d", SlugMaxLength())
+	}
+}
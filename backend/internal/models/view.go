@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// PostView records a single timestamped view of a blog post
+type PostView struct {
+	ID       uint      `json:"id" gorm:"primary_key"`
+	BlogID   uint      `json:"blog_id" gorm:"not null;index"`
+	ViewedAt time.Time `json:"viewed_at" gorm:"not null;index"`
+}
+
+// SetViewCountRequest represents the request structure for setting a view count
+type SetViewCountRequest struct {
+	ViewCount *int `json:"view_count" validate:"required,min=0"`
+}
+
+// ViewCountResponse reports a manual view count change
+type ViewCountResponse struct {
+	ID                uint `json:"id"`
+	PreviousViewCount int  `json:"previous_view_count"`
+	ViewCount         int  `json:"view_count"`
+}
@@ -0,0 +1,99 @@
+package preview
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTTL is how long a preview token stays valid when no TTL is configured
+const DefaultTTL = 24 * time.Hour
+
+// Token verification errors
+var (
+	ErrInvalidToken = errors.New("preview token is malformed or has been tampered with")
+	ErrExpiredToken = errors.New("preview token has expired")
+	ErrWrongPost    = errors.New("preview token was issued for a different post")
+)
+
+// Signer mints and verifies HMAC-signed preview tokens scoped to a blog ID
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewSigner creates a signer. An empty secret is replaced with random bytes,
+// so tokens only survive until the process restarts.
+func NewSigner(secret string, ttl time.Duration) *Signer {
+	key := []byte(secret)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalf("Failed to generate preview token secret: %v", err)
+		}
+		log.Println("PREVIEW_TOKEN_SECRET not set; preview tokens will not survive a restart")
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Signer{secret: key, ttl: ttl}
+}
+
+// TTL returns how long minted tokens stay valid
+func (s *Signer) TTL() time.Duration {
+	return s.ttl
+}
+
+// Mint returns a token for blogID that expires after the signer's TTL
+func (s *Signer) Mint(blogID uint, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(s.ttl).UTC().Truncate(time.Second)
+	payload := strconv.FormatUint(uint64(blogID), 10) + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return encoded + "." + s.sign(encoded), expiresAt
+}
+
+// Verify checks the token's signature, expiry and that it was issued for blogID
+func (s *Signer) Verify(token string, blogID uint, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 || !hmac.Equal([]byte(s.sign(parts[0])), []byte(parts[1])) {
+		return ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	fields := strings.Split(string(payload), ".")
+	if len(fields) != 2 {
+		return ErrInvalidToken
+	}
+	tokenBlogID, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	expiresAt, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if uint(tokenBlogID) != blogID {
+		return ErrWrongPost
+	}
+	if now.Unix() >= expiresAt {
+		return ErrExpiredToken
+	}
+	return nil
+}
+
+// sign returns the base64url HMAC-SHA256 of the encoded payload
+func (s *Signer) sign(encoded string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
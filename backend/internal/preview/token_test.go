@@ -0,0 +1,67 @@
+package preview
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignerVerify(t *testing.T) {
+	signer := NewSigner("secret", time.Hour)
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	token, expiresAt := signer.Mint(7, now)
+	if !expiresAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("expires at %v, want %v", expiresAt, now.Add(time.Hour))
+	}
+
+	payload, signature, _ := strings.Cut(token, ".")
+	tampered := func(s string) string {
+		if s[0] == 'A' {
+			return "B" + s[1:]
+		}
+		return "A" + s[1:]
+	}
+	otherToken, _ := signer.Mint(8, now)
+	otherPayload, _, _ := strings.Cut(otherToken, ".")
+
+	tests := []struct {
+		name   string
+		signer *Signer
+		token  string
+		blogID uint
+		at     time.Time
+		want   error
+	}{
+		{"valid", signer, token, 7, now, nil},
+		{"just before expiry", signer, token, 7, expiresAt.Add(-time.Second), nil},
+		{"expired", signer, token, 7, expiresAt, ErrExpiredToken},
+		{"wrong post", signer, token, 8, now, ErrWrongPost},
+		{"tampered signature", signer, payload + "." + tampered(signature), 7, now, ErrInvalidToken},
+		{"payload swapped for another post", signer, otherPayload + "." + signature, 8, now, ErrInvalidToken},
+		{"other secret", NewSigner("other-secret", time.Hour), token, 7, now, ErrInvalidToken},
+		{"malformed", signer, "not-a-token", 7, now, ErrInvalidToken},
+		{"empty", signer, "", 7, now, ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		if err := tt.signer.Verify(tt.token, tt.blogID, tt.at); err != tt.want {
+			t.Errorf("%s: Verify = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestNewSignerDefaults(t *testing.T) {
+	if ttl := NewSigner("secret", 0).TTL(); ttl != DefaultTTL {
+		t.Errorf("TTL = %v, want %v", ttl, DefaultTTL)
+	}
+
+	// Without a secret each signer gets its own random key
+	first, second := NewSigner("", time.Hour), NewSigner("", time.Hour)
+	now := time.Now()
+	token, _ := first.Mint(1, now)
+	if err := first.Verify(token, 1, now); err != nil {
+		t.Errorf("random key signer rejected its own token: %v", err)
+	}
+	if err := second.Verify(token, 1, now); err != ErrInvalidToken {
+		t.Errorf("second random key signer: Verify = %v, want %v", err, ErrInvalidToken)
+	}
+}
@@ -0,0 +1,150 @@
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Finding kinds reported by the default rules and the profanity list
+const (
+	KindEmail      = "email"
+	KindPhone      = "phone"
+	KindCreditCard = "credit_card"
+	KindProfanity  = "profanity"
+)
+
+// Rule is a named pattern; matches are reported with its kind
+type Rule struct {
+	Kind    string `json:"kind"`
+	Pattern string `json:"pattern"`
+}
+
+// Config lists the rules and profanity a scanner looks for
+type Config struct {
+	Rules     []Rule   `json:"rules"`     // Replaces the default rules when set
+	Profanity []string `json:"profanity"` // Words matched whole and case-insensitively
+}
+
+// Finding is a match of a rule in scanned text
+type Finding struct {
+	Kind  string `json:"kind"`
+	Match string `json:"match"`
+}
+
+// DefaultRules flag obvious personal data: email addresses, phone numbers and
+// card-like numbers
+func DefaultRules() []Rule {
+	return []Rule{
+		{Kind: KindEmail, Pattern: `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`},
+		{Kind: KindPhone, Pattern: `\+\d{1,3}(?:[ .-]?\d{2,4}){2,4}\b|(?:\(\d{3}\)|\b\d{3})[ .-]?\d{3}[ .-]\d{4}\b`},
+		{Kind: KindCreditCard, Pattern: `\b\d(?:[ -]?\d){12,18}\b`},
+	}
+}
+
+// DefaultConfig is the configuration used when no config file is given
+func DefaultConfig() Config {
+	return Config{Rules: DefaultRules()}
+}
+
+// LoadConfig reads a JSON config file. Rules omitted from the file fall back
+// to DefaultRules; an empty path returns DefaultConfig.
+func LoadConfig(path string) (Config, error) {
+	if path == "" {
+		return DefaultConfig(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("invalid scanner config JSON: %w", err)
+	}
+	if len(config.Rules) == 0 {
+		config.Rules = DefaultRules()
+	}
+	return config, nil
+}
+
+// compiledRule is a Rule with its pattern compiled
+type compiledRule struct {
+	kind    string
+	pattern *regexp.Regexp
+}
+
+// Scanner reports personal data and profanity found in text
+type Scanner struct {
+	rules []compiledRule
+}
+
+// New compiles config into a scanner
+func New(config Config) (*Scanner, error) {
+	s := &Scanner{}
+	for _, rule := range config.Rules {
+		kind := strings.TrimSpace(rule.Kind)
+		if kind == "" {
+			return nil, fmt.Errorf("rule %q has no kind", rule.Pattern)
+		}
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", kind, err)
+		}
+		s.rules = append(s.rules, compiledRule{kind: kind, pattern: pattern})
+	}
+
+	words := []string{}
+	for _, word := range config.Profanity {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, regexp.QuoteMeta(word))
+		}
+	}
+	if len(words) > 0 {
+		pattern := regexp.MustCompile(`(?i)\b(?:` + strings.Join(words, "|") + `)\b`)
+		s.rules = append(s.rules, compiledRule{kind: KindProfanity, pattern: pattern})
+	}
+	return s, nil
+}
+
+// Scan returns the distinct findings in text, in rule order. Card-like numbers
+// are only reported when they pass the Luhn check.
+func (s *Scanner) Scan(text string) []Finding {
+	findings := []Finding{}
+	seen := make(map[Finding]bool)
+	for _, rule := range s.rules {
+		for _, match := range rule.pattern.FindAllString(text, -1) {
+			if rule.kind == KindCreditCard && !luhnValid(match) {
+				continue
+			}
+			finding := Finding{Kind: rule.kind, Match: match}
+			if !seen[finding] {
+				seen[finding] = true
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings
+}
+
+// luhnValid reports whether the digits in number pass the Luhn checksum
+func luhnValid(number string) bool {
+	sum, digits := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		ch := number[i]
+		if ch < '0' || ch > '9' {
+			continue
+		}
+		digit := int(ch - '0')
+		if digits%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		digits++
+	}
+	return digits > 0 && sum%10 == 0
+}
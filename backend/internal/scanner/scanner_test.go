@@ -0,0 +1,103 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// defaultScanner returns a scanner with the default rules and the given profanity
+func defaultScanner(t *testing.T, profanity ...string) *Scanner {
+	t.Helper()
+	s, err := New(Config{Rules: DefaultRules(), Profanity: profanity})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return s
+}
+
+func TestScanDefaultRules(t *testing.T) {
+	s := defaultScanner(t)
+	tests := []struct {
+		name string
+		text string
+		want []Finding
+	}{
+		{"email", "Write to jane.doe+blog@example.co.uk today", []Finding{{KindEmail, "jane.doe+blog@example.co.uk"}}},
+		{"card with spaces", "Card 4111 1111 1111 1111 on file", []Finding{{KindCreditCard, "4111 1111 1111 1111"}}},
+		{"card with dashes", "Card 5500-0000-0000-0004", []Finding{{KindCreditCard, "5500-0000-0000-0004"}}},
+		{"card failing Luhn", "Order 4111 1111 1111 1112 shipped", []Finding{}},
+		{"phone", "Call (555) 123-4567 or +44 20 7946 0958", []Finding{{KindPhone, "(555) 123-4567"}, {KindPhone, "+44 20 7946 0958"}}},
+		{"duplicates reported once", "a@example.com and a@example.com", []Finding{{KindEmail, "a@example.com"}}},
+		{"clean", "Accessible design helps every reader in 2024.", []Finding{}},
+	}
+	for _, tt := range tests {
+		if got := s.Scan(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Scan = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestScanProfanity(t *testing.T) {
+	s := defaultScanner(t, "Darn", " heck ", "")
+	got := s.Scan("Darn it, what the HECK. Heckle and darning are fine.")
+	want := []Finding{{KindProfanity, "Darn"}, {KindProfanity, "HECK"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewRejectsInvalidRules(t *testing.T) {
+	for name, rule := range map[string]Rule{
+		"no kind":     {Kind: " ", Pattern: `\d+`},
+		"bad pattern": {Kind: "id", Pattern: `(`},
+	} {
+		if _, err := New(Config{Rules: []Rule{rule}}); err == nil {
+			t.Errorf("%s: New accepted %+v", name, rule)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	if config, err := LoadConfig(""); err != nil || !reflect.DeepEqual(config, DefaultConfig()) {
+		t.Errorf("LoadConfig(\"\") = %+v, %v; want the defaults", config, err)
+	}
+
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		return path
+	}
+
+	// Custom rules replace the defaults
+	config, err := LoadConfig(write("rules.json", `{"rules": [{"kind": "employee_id", "pattern": "EMP-\\d{4}"}], "profanity": ["darn"]}`))
+	if err != nil {
+		t.Fatalf("load rules: %v", err)
+	}
+	s, err := New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	got := s.Scan("EMP-1234 said darn to a@example.com")
+	want := []Finding{{"employee_id", "EMP-1234"}, {KindProfanity, "darn"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("custom rules: Scan = %+v, want %+v", got, want)
+	}
+
+	// Profanity alone keeps the default rules
+	config, err = LoadConfig(write("profanity.json", `{"profanity": ["darn"]}`))
+	if err != nil || !reflect.DeepEqual(config.Rules, DefaultRules()) {
+		t.Errorf("profanity only: rules = %+v, %v; want the defaults", config.Rules, err)
+	}
+
+	if _, err := LoadConfig(write("broken.json", `{"rules": [`)); err == nil {
+		t.Error("invalid JSON accepted")
+	}
+	if _, err := LoadConfig(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("missing file accepted")
+	}
+}
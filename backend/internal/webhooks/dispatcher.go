@@ -0,0 +1,175 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Blog lifecycle event types
+const (
+	EventBlogCreated   = "blog.created"
+	EventBlogUpdated   = "blog.updated"
+	EventBlogDeleted   = "blog.deleted"
+	EventBlogPublished = "blog.published"
+)
+
+// Headers sent with every webhook delivery
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+const (
+	// queueSize is the number of events buffered before new events are dropped
+	queueSize = 256
+
+	// maxAttempts is the number of delivery attempts per URL
+	maxAttempts = 4
+
+	// initialBackoff is the delay before the first retry, doubled on each attempt
+	initialBackoff = time.Second
+
+	// deliveryTimeout bounds a single delivery attempt
+	deliveryTimeout = 10 * time.Second
+)
+
+// Event is the JSON payload delivered to webhook receivers
+type Event struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+// Dispatcher delivers signed events to configured URLs in the background
+type Dispatcher struct {
+	urls    []string
+	secret  []byte
+	client  *http.Client
+	queue   chan Event
+	backoff time.Duration
+}
+
+// NewDispatcher creates a dispatcher and starts its background worker. With no
+// URLs it is a no-op.
+func NewDispatcher(urls []string, secret string) *Dispatcher {
+	d := &Dispatcher{
+		urls:    urls,
+		secret:  []byte(secret),
+		client:  &http.Client{Timeout: deliveryTimeout},
+		queue:   make(chan Event, queueSize),
+		backoff: initialBackoff,
+	}
+	if len(urls) > 0 {
+		go d.run()
+	}
+	return d
+}
+
+// ParseURLs splits a comma-separated WEBHOOK_URLS value
+func ParseURLs(value string) []string {
+	var urls []string
+	for _, url := range strings.Split(value, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// Dispatch queues an event without blocking the caller
+func (d *Dispatcher) Dispatch(eventType string, data interface{}) {
+	if d == nil || len(d.urls) == 0 {
+		return
+	}
+
+	event := Event{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+
+	select {
+	case d.queue <- event:
+	default:
+		log.Printf("Webhook queue full, dropping %s event %s", event.Type, event.ID)
+	}
+}
+
+// Sign returns the hex HMAC-SHA256 signature of body, formatted as "sha256=<hex>"
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature matches body under secret
+func Verify(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// run delivers queued events until the queue is closed
+func (d *Dispatcher) run() {
+	for event := range d.queue {
+		body, err := json.Marshal(event)
+		if err != nil {
+			log.Printf("Failed to encode webhook event %s: %v", event.ID, err)
+			continue
+		}
+		for _, url := range d.urls {
+			go d.deliver(url, event, body)
+		}
+	}
+}
+
+// deliver posts an event to a single URL, retrying with exponential backoff
+func (d *Dispatcher) deliver(url string, event Event, body []byte) {
+	backoff := d.backoff
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err := d.post(url, event, body)
+		if err == nil {
+			return
+		}
+		if attempt == maxAttempts {
+			log.Printf("Webhook %s delivery %s to %s failed after %d attempts: %v", event.Type, event.ID, url, attempt, err)
+			return
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post performs a single signed delivery attempt
+func (d *Dispatcher) post(url string, event Event, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event.Type)
+	req.Header.Set(DeliveryHeader, event.ID)
+	req.Header.Set(SignatureHeader, Sign(d.secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
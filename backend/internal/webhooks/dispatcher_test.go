@@ -0,0 +1,142 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// delivery is a webhook request seen by a mock receiver
+type delivery struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver starts a mock webhook receiver answering with the statuses in
+// order, then 200, and returns it with a channel of the deliveries it saw
+func receiver(t *testing.T, statuses ...int) (*httptest.Server, <-chan delivery) {
+	t.Helper()
+	deliveries := make(chan delivery, 16)
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		deliveries <- delivery{header: r.Header.Clone(), body: body}
+		mu.Lock()
+		defer mu.Unlock()
+		status := http.StatusOK
+		if len(statuses) > 0 {
+			status, statuses = statuses[0], statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, deliveries
+}
+
+// next waits for the next delivery
+func next(t *testing.T, deliveries <-chan delivery) delivery {
+	t.Helper()
+	select {
+	case d := <-deliveries:
+		return d
+	case <-time.After(5 * time.Second):
+		t.Fatal("no webhook delivery received")
+		return delivery{}
+	}
+}
+
+func TestDispatchSignsEvents(t *testing.T) {
+	server, deliveries := receiver(t)
+	secret := "shared-secret"
+	d := NewDispatcher([]string{server.URL}, secret)
+
+	d.Dispatch(EventBlogCreated, map[string]interface{}{"slug": "my-post"})
+	got := next(t, deliveries)
+
+	signature := got.header.Get(SignatureHeader)
+	if !Verify([]byte(secret), got.body, signature) {
+		t.Errorf("signature %q does not verify against the body", signature)
+	}
+	if Verify([]byte("wrong-secret"), got.body, signature) {
+		t.Error("signature verifies under the wrong secret")
+	}
+	if Verify([]byte(secret), append(got.body, ' '), signature) {
+		t.Error("signature verifies a tampered body")
+	}
+
+	var event struct {
+		ID   string            `json:"id"`
+		Type string            `json:"type"`
+		Data map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal(got.body, &event); err != nil {
+		t.Fatalf("decode event %q: %v", got.body, err)
+	}
+	if event.Type != EventBlogCreated || event.Data["slug"] != "my-post" {
+		t.Errorf("event = %+v, want blog.created for my-post", event)
+	}
+	if got.header.Get(EventHeader) != EventBlogCreated || got.header.Get(DeliveryHeader) != event.ID {
+		t.Errorf("headers: event %q, delivery %q; want %q, %q",
+			got.header.Get(EventHeader), got.header.Get(DeliveryHeader), EventBlogCreated, event.ID)
+	}
+}
+
+func TestDispatchRetriesFailedDeliveries(t *testing.T) {
+	server, deliveries := receiver(t, http.StatusInternalServerError, http.StatusBadGateway)
+	d := NewDispatcher([]string{server.URL}, "secret")
+	d.backoff = time.Millisecond
+
+	d.Dispatch(EventBlogUpdated, nil)
+	first := next(t, deliveries)
+	for attempt := 2; attempt <= 3; attempt++ {
+		retry := next(t, deliveries)
+		if retry.header.Get(DeliveryHeader) != first.header.Get(DeliveryHeader) || string(retry.body) != string(first.body) {
+			t.Errorf("attempt %d is not the same delivery", attempt)
+		}
+	}
+	select {
+	case <-deliveries:
+		t.Error("delivery retried after it succeeded")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestDispatchDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	// Overflowing the queue logs every dropped event
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	d := NewDispatcher([]string{server.URL}, "secret")
+	start := time.Now()
+	for i := 0; i < queueSize*2; i++ {
+		d.Dispatch(EventBlogDeleted, nil)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("dispatching to a hung receiver took %v", elapsed)
+	}
+
+	// Without URLs, and on a nil dispatcher, dispatching is a no-op
+	NewDispatcher(nil, "").Dispatch(EventBlogCreated, nil)
+	var nilDispatcher *Dispatcher
+	nilDispatcher.Dispatch(EventBlogCreated, nil)
+}
+
+func TestParseURLs(t *testing.T) {
+	urls := ParseURLs(" https://a.example.com/hook, ,https://b.example.com/hook ")
+	if len(urls) != 2 || urls[0] != "https://a.example.com/hook" || urls[1] != "https://b.example.com/hook" {
+		t.Errorf("ParseURLs = %q", urls)
+	}
+}